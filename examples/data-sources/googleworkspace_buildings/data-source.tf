@@ -0,0 +1,14 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/admin.directory.resource.calendar.readonly",
+  ]
+}
+
+data "googleworkspace_buildings" "all" {}
+
+data "googleworkspace_calendar_resources" "hq-rooms" {
+  building_id = data.googleworkspace_buildings.all.ids_by_name["Headquarters"]
+}
\ No newline at end of file
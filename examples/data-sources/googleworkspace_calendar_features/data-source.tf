@@ -0,0 +1,14 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/admin.directory.resource.calendar.readonly",
+  ]
+}
+
+data "googleworkspace_calendar_features" "all" {}
+
+output "has_whiteboard_feature" {
+  value = contains(data.googleworkspace_calendar_features.all.names, "Whiteboard")
+}
\ No newline at end of file
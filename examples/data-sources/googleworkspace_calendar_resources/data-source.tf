@@ -0,0 +1,18 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/admin.directory.resource.calendar.readonly",
+  ]
+}
+
+data "googleworkspace_calendar_resources" "large-rooms" {
+  building_id       = "hq"
+  min_capacity      = 10
+  resource_category = "CONFERENCE_ROOM"
+}
+
+output "large_room_emails" {
+  value = data.googleworkspace_calendar_resources.large-rooms.calendar_resources[*].email
+}
\ No newline at end of file
@@ -7,4 +7,8 @@ data "googleworkspace_chrome_policy_schema" "example" {
 
 output "field_descriptions" {
   value = data.googleworkspace_chrome_policy_schema.example.field_descriptions
+}
+
+output "field_types" {
+  value = { for field in data.googleworkspace_chrome_policy_schema.example.fields : field.field => field.type }
 }
\ No newline at end of file
@@ -0,0 +1,18 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+data "googleworkspace_org_unit" "sales" {
+  org_unit_path = "/corp/sales"
+}
+
+data "googleworkspace_chrome_resolved_policies" "sales" {
+  org_unit_id   = data.googleworkspace_org_unit.sales.org_unit_id
+  schema_filter = "chrome.users.*"
+}
+
+output "inherited_policies" {
+  value = {
+    for policy in data.googleworkspace_chrome_resolved_policies.sales.policies : policy.schema_name => policy.source_target_resource
+    if policy.inherited
+  }
+}
\ No newline at end of file
@@ -0,0 +1,12 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+data "googleworkspace_customer" "current" {}
+
+output "customer_id" {
+  value = data.googleworkspace_customer.current.customer_id
+}
+
+output "primary_domain" {
+  value = data.googleworkspace_customer.current.customer_domain
+}
\ No newline at end of file
@@ -0,0 +1,16 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/admin.datatransfer.readonly",
+  ]
+}
+
+data "googleworkspace_data_transfer" "offboarding" {
+  transfer_id = "AKrEtIYfG3ZKXDLp-ZXBoTRC3qObGZO7jWMY7xMCAeIJ"
+}
+
+output "transfer_status" {
+  value = data.googleworkspace_data_transfer.offboarding.overall_transfer_status_code
+}
\ No newline at end of file
@@ -0,0 +1,15 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/admin.directory.customer",
+    "https://www.googleapis.com/auth/admin.datatransfer.readonly",
+  ]
+}
+
+data "googleworkspace_data_transfer_applications" "all" {}
+
+output "drive_application_id" {
+  value = data.googleworkspace_data_transfer_applications.all.ids_by_name["Drive and Docs"]
+}
\ No newline at end of file
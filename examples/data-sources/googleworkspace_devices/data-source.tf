@@ -0,0 +1,26 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/cloud-identity.devices.readonly",
+  ]
+}
+
+data "googleworkspace_devices" "company_owned" {
+  owner_type = "COMPANY"
+}
+
+output "unencrypted_company_devices" {
+  value = [
+    for device in data.googleworkspace_devices.company_owned.devices : device.serial_number
+    if device.encryption_state == "NOT_ENCRYPTED"
+  ]
+}
+
+output "compromised_company_devices" {
+  value = [
+    for device in data.googleworkspace_devices.company_owned.devices : device.serial_number
+    if device.compromised_state == "COMPROMISED"
+  ]
+}
\ No newline at end of file
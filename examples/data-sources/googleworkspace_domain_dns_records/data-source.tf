@@ -0,0 +1,13 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+data "googleworkspace_domain_dns_records" "example" {
+  domain_name         = "example.com"
+  verification_method = "DNS_TXT"
+}
+
+output "mx_records" {
+  value = [
+    for r in data.googleworkspace_domain_dns_records.example.records : "${r.priority} ${r.value}" if r.type == "MX"
+  ]
+}
\ No newline at end of file
@@ -0,0 +1,28 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/admin.directory.user",
+    "https://www.googleapis.com/auth/gmail.metadata",
+  ]
+}
+
+resource "googleworkspace_user" "dwight" {
+  primary_email = "dwight.schrute@example.com"
+  password      = "34819d7beeabb9260a5c854bc85b3e44"
+  hash_function = "MD5"
+
+  name {
+    family_name = "Schrute"
+    given_name  = "Dwight"
+  }
+}
+
+data "googleworkspace_gmail_profile" "dwight" {
+  primary_email = googleworkspace_user.dwight.primary_email
+}
+
+output "dwight_mailbox_history_id" {
+  value = data.googleworkspace_gmail_profile.dwight.history_id
+}
\ No newline at end of file
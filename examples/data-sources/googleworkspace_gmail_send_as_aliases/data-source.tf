@@ -0,0 +1,13 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+data "googleworkspace_gmail_send_as_aliases" "example" {
+  primary_email = "user@example.com"
+}
+
+output "unverified_aliases" {
+  value = [
+    for alias in data.googleworkspace_gmail_send_as_aliases.example.send_as : alias.send_as_email
+    if alias.verification_status == "pending"
+  ]
+}
\ No newline at end of file
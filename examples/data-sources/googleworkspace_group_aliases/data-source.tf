@@ -0,0 +1,14 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+data "googleworkspace_group_aliases" "sales" {
+  email = "sales@example.com"
+}
+
+output "sales_addresses" {
+  value = concat(
+    [data.googleworkspace_group_aliases.sales.email],
+    data.googleworkspace_group_aliases.sales.aliases,
+    data.googleworkspace_group_aliases.sales.non_editable_aliases,
+  )
+}
\ No newline at end of file
@@ -0,0 +1,23 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+data "googleworkspace_group" "admins" {
+  email = "admins@example.com"
+}
+
+data "googleworkspace_group_membership_check" "dwight" {
+  group_id     = data.googleworkspace_group.admins.id
+  member_email = "dwight.schrute@example.com"
+}
+
+resource "googleworkspace_org_unit" "restricted" {
+  name                 = "restricted"
+  parent_org_unit_path = "/"
+
+  lifecycle {
+    precondition {
+      condition     = data.googleworkspace_group_membership_check.dwight.is_member
+      error_message = "dwight.schrute@example.com must be a member of the admins group."
+    }
+  }
+}
\ No newline at end of file
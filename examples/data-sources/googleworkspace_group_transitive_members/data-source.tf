@@ -0,0 +1,17 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+data "googleworkspace_group" "sales" {
+  email = "sales@example.com"
+}
+
+data "googleworkspace_group_transitive_members" "sales" {
+  group_id = data.googleworkspace_group.sales.id
+}
+
+output "sales_indirect_members" {
+  value = [
+    for member in data.googleworkspace_group_transitive_members.sales.members : member.email
+    if member.relation_type == "INDIRECT"
+  ]
+}
\ No newline at end of file
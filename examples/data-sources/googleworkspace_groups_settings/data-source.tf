@@ -0,0 +1,13 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+data "googleworkspace_groups_settings" "engineering" {
+  query = "email:eng*"
+}
+
+output "groups_anyone_can_post" {
+  value = [
+    for settings in data.googleworkspace_groups_settings.engineering.groups_settings :
+    settings.email if settings.who_can_post_message == "ANYONE_CAN_POST"
+  ]
+}
\ No newline at end of file
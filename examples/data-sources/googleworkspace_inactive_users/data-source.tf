@@ -0,0 +1,12 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+data "googleworkspace_inactive_users" "stale" {
+  inactive_days     = 90
+  exclude_suspended = true
+  query             = "orgUnitPath=/corp"
+}
+
+output "stale_users" {
+  value = data.googleworkspace_inactive_users.stale.users[*].primary_email
+}
\ No newline at end of file
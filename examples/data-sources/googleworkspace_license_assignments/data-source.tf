@@ -0,0 +1,18 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/admin.directory.customer",
+    "https://www.googleapis.com/auth/apps.licensing",
+  ]
+}
+
+data "googleworkspace_license_assignments" "business-starter" {
+  product_id = "Google-Apps"
+  sku_id     = "1010020027"
+}
+
+output "business_starter_users" {
+  value = data.googleworkspace_license_assignments.business-starter.user_ids
+}
\ No newline at end of file
@@ -0,0 +1,18 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/admin.directory.customer",
+    "https://www.googleapis.com/auth/apps.order.readonly",
+  ]
+}
+
+data "googleworkspace_license_skus" "subscribed" {}
+
+output "available_seats" {
+  value = {
+    for sku in data.googleworkspace_license_skus.subscribed.skus :
+    sku.sku_id => max(sku.number_of_seats, sku.maximum_number_of_seats) - sku.licensed_number_of_seats
+  }
+}
\ No newline at end of file
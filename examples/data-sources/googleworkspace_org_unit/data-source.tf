@@ -3,4 +3,12 @@
 
 data "googleworkspace_org_unit" "org" {
   org_unit_id = "id:01ab2c3d4efg56h"
+}
+
+data "googleworkspace_org_unit" "by_path" {
+  org_unit_path = "/corp/sales"
+}
+
+output "org_unit_path" {
+  value = data.googleworkspace_org_unit.org.org_unit_path
 }
\ No newline at end of file
@@ -0,0 +1,18 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+data "googleworkspace_org_unit_children" "corp" {
+  org_unit_path = "/corp"
+}
+
+resource "googleworkspace_chrome_policy" "per_child" {
+  for_each = { for ou in data.googleworkspace_org_unit_children.corp.children : ou.name => ou }
+
+  org_unit_id = each.value.org_unit_id
+  policies {
+    schema_name = "chrome.users.MaxConnectionsPerProxy"
+    schema_values = {
+      maxConnectionsPerProxy = jsonencode(34)
+    }
+  }
+}
\ No newline at end of file
@@ -0,0 +1,18 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+data "googleworkspace_org_unit_users" "sales" {
+  org_unit_path         = "/sales"
+  include_sub_org_units = true
+}
+
+resource "googleworkspace_group_members" "sales" {
+  group_id = "sales@example.com"
+
+  dynamic "members" {
+    for_each = data.googleworkspace_org_unit_users.sales.users
+    content {
+      email = members.value["primary_email"]
+    }
+  }
+}
\ No newline at end of file
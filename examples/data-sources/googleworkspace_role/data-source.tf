@@ -7,4 +7,13 @@ data "googleworkspace_role" "group-admin" {
 
 output "is_system_role" {
   value = data.googleworkspace_role.group-admin.is_system_role
+}
+
+# roles can also be looked up by their ID
+data "googleworkspace_role" "by-id" {
+  role_id = data.googleworkspace_role.group-admin.role_id
+}
+
+output "group_admin_privileges" {
+  value = [for priv in data.googleworkspace_role.by-id.privileges : priv.privilege_name]
 }
\ No newline at end of file
@@ -7,4 +7,8 @@ data "googleworkspace_schema" "birthday" {
 
 output "schema_display_name" {
   value = data.googleworkspace_schema.birthday.display_name
+}
+
+output "schema_field_types" {
+  value = data.googleworkspace_schema.birthday.field_types
 }
\ No newline at end of file
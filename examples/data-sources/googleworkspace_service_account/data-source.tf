@@ -0,0 +1,22 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/admin.directory.rolemanagement",
+    "https://www.googleapis.com/auth/cloud-platform",
+  ]
+}
+
+data "googleworkspace_service_account" "automation" {
+  email = "automation@my-project.iam.gserviceaccount.com"
+}
+
+data "googleworkspace_role" "groups-admin" {
+  name = "_GROUPS_ADMIN_ROLE"
+}
+
+resource "googleworkspace_role_assignment" "automation-ra" {
+  role_id     = data.googleworkspace_role.groups-admin.id
+  assigned_to = data.googleworkspace_service_account.automation.unique_id
+}
\ No newline at end of file
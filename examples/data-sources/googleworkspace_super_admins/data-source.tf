@@ -0,0 +1,19 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+locals {
+  expected_super_admins = ["breakglass-1@example.com", "breakglass-2@example.com"]
+}
+
+data "googleworkspace_super_admins" "all" {
+  include_delegated_admins = true
+}
+
+output "super_admins" {
+  value = data.googleworkspace_super_admins.all.super_admins[*].primary_email
+
+  precondition {
+    condition     = length(setsubtract(data.googleworkspace_super_admins.all.super_admins[*].primary_email, local.expected_super_admins)) == 0
+    error_message = "Unexpected super admins exist."
+  }
+}
\ No newline at end of file
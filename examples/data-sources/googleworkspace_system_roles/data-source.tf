@@ -0,0 +1,22 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+data "googleworkspace_system_roles" "built-in" {}
+
+data "googleworkspace_role" "custom" {
+  name = "custom-helpdesk"
+}
+
+locals {
+  built_in_privileges = toset(flatten([
+    for role in data.googleworkspace_system_roles.built-in.roles : [
+      for priv in role.privileges : "${priv.service_id}/${priv.privilege_name}"
+    ]
+  ]))
+
+  # privileges of the custom role that no built-in role grants
+  custom_only_privileges = [
+    for priv in data.googleworkspace_role.custom.privileges : priv
+    if !contains(local.built_in_privileges, "${priv.service_id}/${priv.privilege_name}")
+  ]
+}
\ No newline at end of file
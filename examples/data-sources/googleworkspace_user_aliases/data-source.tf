@@ -0,0 +1,11 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+data "googleworkspace_user_aliases" "example" {
+  primary_email = "user@example.com"
+}
+
+resource "googleworkspace_gmail_send_as_alias" "example" {
+  primary_email = data.googleworkspace_user_aliases.example.primary_email
+  send_as_email = data.googleworkspace_user_aliases.example.aliases[0]
+}
\ No newline at end of file
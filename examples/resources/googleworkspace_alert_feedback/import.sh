@@ -0,0 +1,4 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+terraform import googleworkspace_alert_feedback.benign 6c7f3f3a-8a5e-4b7e-9d1c-2f0e4a1b3c5d/a1b2c3d4-e5f6-7a8b-9c0d-1e2f3a4b5c6d
\ No newline at end of file
@@ -0,0 +1,14 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/admin.directory.customer",
+    "https://www.googleapis.com/auth/apps.alerts",
+  ]
+}
+
+resource "googleworkspace_alert_feedback" "benign" {
+  alert_id = "6c7f3f3a-8a5e-4b7e-9d1c-2f0e4a1b3c5d"
+  type     = "NOT_USEFUL"
+}
\ No newline at end of file
@@ -0,0 +1,18 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/admin.directory.customer",
+    "https://www.googleapis.com/auth/apps.alerts",
+  ]
+}
+
+variable "triage_cutoff" {
+  type        = string
+  description = "Known-benign alerts created before this time are deleted, e.g. 2022-06-01T00:00:00Z"
+}
+
+resource "googleworkspace_alerts_deletion" "benign_logins" {
+  filter = "source = \"Google identity\" AND type = \"Suspicious login\" AND createTime < \"${var.triage_cutoff}\""
+}
\ No newline at end of file
@@ -0,0 +1,4 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+terraform import googleworkspace_calendar_acl.example executive@example.com/primary/user:assistant@example.com
\ No newline at end of file
@@ -0,0 +1,16 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/calendar",
+  ]
+}
+
+# let an assistant make changes to events and manage sharing of an executive's calendar
+resource "googleworkspace_calendar_acl" "example" {
+  user_email  = "executive@example.com"
+  scope_type  = "user"
+  scope_value = "assistant@example.com"
+  role        = "owner"
+}
\ No newline at end of file
@@ -0,0 +1,4 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+terraform import googleworkspace_chat_space.sales spaces/AAAAAbCdEfG
\ No newline at end of file
@@ -0,0 +1,16 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/chat.spaces",
+    "https://www.googleapis.com/auth/chat.delete",
+  ]
+}
+
+resource "googleworkspace_chat_space" "sales" {
+  display_name        = "Sales"
+  description         = "Announcements and questions for the sales team"
+  guidelines          = "Keep customer data out of this space."
+  space_history_state = "HISTORY_ON"
+}
\ No newline at end of file
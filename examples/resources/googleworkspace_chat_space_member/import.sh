@@ -0,0 +1,4 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+terraform import googleworkspace_chat_space_member.sales spaces/AAAAAbCdEfG/members/01234567890123456789
\ No newline at end of file
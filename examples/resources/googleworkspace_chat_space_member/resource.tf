@@ -0,0 +1,30 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/admin.directory.group",
+    "https://www.googleapis.com/auth/chat.delete",
+    "https://www.googleapis.com/auth/chat.memberships",
+    "https://www.googleapis.com/auth/chat.spaces",
+  ]
+}
+
+resource "googleworkspace_group" "sales" {
+  email = "sales@example.com"
+}
+
+resource "googleworkspace_chat_space" "sales" {
+  display_name = "Sales"
+}
+
+resource "googleworkspace_chat_space_member" "sales" {
+  space    = googleworkspace_chat_space.sales.name
+  group_id = googleworkspace_group.sales.id
+}
+
+resource "googleworkspace_chat_space_member" "manager" {
+  space = googleworkspace_chat_space.sales.name
+  email = "michael.scott@example.com"
+  role  = "ROLE_MANAGER"
+}
\ No newline at end of file
@@ -0,0 +1,4 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+terraform import googleworkspace_chrome_print_server.office customers/C01234abc/chrome/printServers/0123456789abcdef
\ No newline at end of file
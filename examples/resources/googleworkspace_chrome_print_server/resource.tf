@@ -0,0 +1,22 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/admin.directory.customer",
+    "https://www.googleapis.com/auth/admin.directory.orgunit",
+    "https://www.googleapis.com/auth/admin.chrome.printers",
+  ]
+}
+
+resource "googleworkspace_org_unit" "office" {
+  name                 = "office"
+  parent_org_unit_path = "/"
+}
+
+resource "googleworkspace_chrome_print_server" "office" {
+  display_name = "Office Print Server"
+  description  = "CUPS server of the office"
+  uri          = "ipps://print.example.com:631"
+  org_unit_id  = googleworkspace_org_unit.office.id
+}
\ No newline at end of file
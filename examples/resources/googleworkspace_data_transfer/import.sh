@@ -0,0 +1,4 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+terraform import googleworkspace_data_transfer.offboarding AKrEtIYfG3ZKXDLp-ZXBoTRC3qObGZO7jWMY7xMCAeIJ
\ No newline at end of file
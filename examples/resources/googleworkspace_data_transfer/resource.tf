@@ -0,0 +1,47 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/admin.directory.customer",
+    "https://www.googleapis.com/auth/admin.directory.user",
+    "https://www.googleapis.com/auth/admin.datatransfer",
+  ]
+}
+
+data "googleworkspace_user" "departing" {
+  primary_email = "michael.scott@example.com"
+}
+
+data "googleworkspace_user" "manager" {
+  primary_email = "jan.levinson@example.com"
+}
+
+data "googleworkspace_data_transfer_applications" "all" {}
+
+resource "googleworkspace_data_transfer" "offboarding" {
+  old_owner_user_id = data.googleworkspace_user.departing.id
+  new_owner_user_id = data.googleworkspace_user.manager.id
+
+  application_data_transfers {
+    application_id = data.googleworkspace_data_transfer_applications.all.ids_by_name["Drive and Docs"]
+
+    application_transfer_params {
+      key    = "PRIVACY_LEVEL"
+      values = ["SHARED", "PRIVATE"]
+    }
+  }
+
+  application_data_transfers {
+    application_id = data.googleworkspace_data_transfer_applications.all.ids_by_name["Calendar"]
+
+    application_transfer_params {
+      key    = "RELEASE_RESOURCES"
+      values = ["TRUE"]
+    }
+  }
+
+  timeouts {
+    create = "2h"
+  }
+}
\ No newline at end of file
@@ -0,0 +1,24 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/cloud-identity.devices",
+  ]
+}
+
+variable "compromised_device_user_names" {
+  type        = set(string)
+  description = "Device users of the compromised devices, e.g. devices/abc123/deviceUsers/def456"
+}
+
+resource "googleworkspace_device_user_action" "block" {
+  for_each = var.compromised_device_user_names
+
+  device_user_name = each.value
+  action           = "BLOCK"
+
+  triggers = {
+    incident = "INC-1234"
+  }
+}
\ No newline at end of file
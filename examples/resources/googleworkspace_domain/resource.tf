@@ -3,4 +3,10 @@
 
 resource "googleworkspace_domain" "example" {
   domain_name = "example.com"
+}
+
+# once verified, a domain can be made the primary domain
+resource "googleworkspace_domain" "new-primary" {
+  domain_name  = "example.org"
+  make_primary = true
 }
\ No newline at end of file
@@ -0,0 +1,4 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+terraform import googleworkspace_domain_shared_contact.example example.com/1a2b3c4d5e6f
\ No newline at end of file
@@ -0,0 +1,31 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.google.com/m8/feeds/contacts/",
+  ]
+}
+
+resource "googleworkspace_domain_shared_contact" "example" {
+  domain      = "example.com"
+  given_name  = "Jane"
+  family_name = "Doe"
+  notes       = "Account manager at Example Supplies"
+
+  emails {
+    address = "jane.doe@example-supplies.com"
+    primary = true
+  }
+
+  phone_numbers {
+    number  = "+1 555 0100"
+    type    = "mobile"
+    primary = true
+  }
+
+  organizations {
+    name  = "Example Supplies"
+    title = "Account Manager"
+  }
+}
\ No newline at end of file
@@ -1,4 +1,6 @@
 # Copyright (c) HashiCorp, Inc.
 # SPDX-License-Identifier: MPL-2.0
 
-terraform import googleworkspace_group_members.sales groups/01abcde23fg4h5i
\ No newline at end of file
+terraform import googleworkspace_group_members.sales groups/01abcde23fg4h5i
+# or with the group email as group_id
+terraform import googleworkspace_group_members.sales groups/sales@example.com
\ No newline at end of file
@@ -0,0 +1,13 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "googleworkspace_group" "sales" {
+  email = "sales@example.com"
+}
+
+# Only allow users and groups of this customer to become members of the group
+resource "googleworkspace_group_security_settings" "sales" {
+  group_id = googleworkspace_group.sales.id
+
+  member_restriction_query = "member.customer_id == 'C01234567'"
+}
\ No newline at end of file
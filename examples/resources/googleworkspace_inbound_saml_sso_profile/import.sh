@@ -0,0 +1,4 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+terraform import googleworkspace_inbound_saml_sso_profile.okta inboundSamlSsoProfiles/01abcdef23ghij4
\ No newline at end of file
@@ -0,0 +1,17 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "googleworkspace" {
+  customer_id  = "C01234567"
+  oauth_scopes = ["https://www.googleapis.com/auth/cloud-identity.inboundsso"]
+}
+
+resource "googleworkspace_inbound_saml_sso_profile" "okta" {
+  display_name = "Okta"
+
+  idp_config {
+    entity_id                  = "http://www.okta.com/exk1234567890abcdef"
+    single_sign_on_service_uri = "https://example.okta.com/app/google/exk1234567890abcdef/sso/saml"
+    logout_redirect_uri        = "https://example.okta.com/login/signout"
+  }
+}
\ No newline at end of file
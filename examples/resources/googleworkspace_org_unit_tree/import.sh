@@ -0,0 +1,5 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# adopts all org units under the parent org unit path
+terraform import googleworkspace_org_unit_tree.corp /corp
\ No newline at end of file
@@ -0,0 +1,24 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "googleworkspace_org_unit_tree" "corp" {
+  parent_org_unit_path = "/corp"
+
+  org_units {
+    path        = "engineering"
+    description = "Engineering"
+  }
+
+  org_units {
+    path = "engineering/apps"
+  }
+
+  org_units {
+    path        = "engineering/contractors"
+    description = "External contractors"
+  }
+
+  org_units {
+    path = "sales"
+  }
+}
\ No newline at end of file
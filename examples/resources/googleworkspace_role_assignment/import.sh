@@ -1,4 +1,7 @@
 # Copyright (c) HashiCorp, Inc.
 # SPDX-License-Identifier: MPL-2.0
 
-terraform import googleworkspace_role_assignment.dwight 12345678901234567
\ No newline at end of file
+terraform import googleworkspace_role_assignment.dwight 12345678901234567
+
+# or by role id and the unique ID or primary email of the assignee
+terraform import googleworkspace_role_assignment.dwight 01234567890123456/dwight.schrute@example.com
\ No newline at end of file
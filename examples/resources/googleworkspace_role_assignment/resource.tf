@@ -54,4 +54,15 @@ resource "googleworkspace_role_assignment" "org-unit-scope" {
   assigned_to = googleworkspace_user.dwight.id
   scope_type  = "ORG_UNIT"
   org_unit_id = googleworkspace_user.org-unit.id
+}
+
+# service accounts are assigned roles by their unique ID, e.g. to grant API-only delegated admin rights
+
+data "googleworkspace_service_account" "automation" {
+  email = "automation@my-project.iam.gserviceaccount.com"
+}
+
+resource "googleworkspace_role_assignment" "automation-ra" {
+  role_id     = data.googleworkspace_role.groups-admin.id
+  assigned_to = data.googleworkspace_service_account.automation.unique_id
 }
\ No newline at end of file
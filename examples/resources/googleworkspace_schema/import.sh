@@ -1,4 +1,6 @@
 # Copyright (c) HashiCorp, Inc.
 # SPDX-License-Identifier: MPL-2.0
 
-terraform import googleworkspace_schema.birthday Ab0C_DEFGhIJKLmNopQ1Rs==
\ No newline at end of file
+terraform import googleworkspace_schema.birthday Ab0C_DEFGhIJKLmNopQ1Rs==
+# or with the schema name
+terraform import googleworkspace_schema.birthday birthday
\ No newline at end of file
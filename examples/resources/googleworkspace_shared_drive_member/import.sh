@@ -0,0 +1,4 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+terraform import googleworkspace_shared_drive_member.sales drives/0AbCdEfGhIjKlUk9PVA/permissions/01234567890123456789
\ No newline at end of file
@@ -0,0 +1,20 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/admin.directory.group",
+    "https://www.googleapis.com/auth/drive",
+  ]
+}
+
+resource "googleworkspace_group" "sales" {
+  email = "sales@example.com"
+}
+
+resource "googleworkspace_shared_drive_member" "sales" {
+  drive_id = "0AbCdEfGhIjKlUk9PVA"
+  email    = googleworkspace_group.sales.email
+  type     = "group"
+  role     = "fileOrganizer"
+}
\ No newline at end of file
@@ -90,4 +90,20 @@ resource "googleworkspace_user" "dwight" {
   }
 
   recovery_email = "dwightkschrute@example.com"
+}
+
+resource "googleworkspace_user" "jim" {
+  primary_email = "jim.halpert@example.com"
+  password      = "34819d7beeabb9260a5c854bc85b3e44"
+  hash_function = "MD5"
+
+  name {
+    family_name = "Halpert"
+    given_name  = "Jim"
+  }
+
+  # single-valued custom fields can be set as plain values
+  custom_schema_values = {
+    "${googleworkspace_schema.birthday.schema_name}.birthday" = "1978-10-01"
+  }
 }
\ No newline at end of file
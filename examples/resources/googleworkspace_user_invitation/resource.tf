@@ -0,0 +1,10 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "googleworkspace" {
+  oauth_scopes = ["https://www.googleapis.com/auth/cloud-identity.userinvitations"]
+}
+
+resource "googleworkspace_user_invitation" "dwight" {
+  email = "dwight.schrute@example.com"
+}
\ No newline at end of file
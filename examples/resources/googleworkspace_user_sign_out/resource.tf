@@ -0,0 +1,18 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/admin.directory.user",
+    "https://www.googleapis.com/auth/admin.directory.user.security",
+  ]
+}
+
+resource "googleworkspace_user_sign_out" "dwight" {
+  user_key = "dwight.schrute@example.com"
+
+  # bump the incident to sign the user out again
+  triggers = {
+    incident = "INC-1234"
+  }
+}
\ No newline at end of file
@@ -0,0 +1,4 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+terraform import googleworkspace_vault_matter.investigation 12345678-90ab-cdef-1234-567890abcdef
\ No newline at end of file
@@ -0,0 +1,30 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/admin.directory.user",
+    "https://www.googleapis.com/auth/ediscovery",
+  ]
+}
+
+resource "googleworkspace_user" "counsel" {
+  primary_email = "counsel@example.com"
+  password      = "34819d7beeabb9260a5c854bc85b3e44"
+  hash_function = "MD5"
+
+  name {
+    family_name = "Scott"
+    given_name  = "Michael"
+  }
+}
+
+resource "googleworkspace_vault_matter" "investigation" {
+  name        = "Investigation"
+  description = "Legal hold for the investigation"
+
+  collaborators {
+    account_id = googleworkspace_user.counsel.id
+    role       = "OWNER"
+  }
+}
\ No newline at end of file
@@ -0,0 +1,702 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fakeworkspace is an in-memory fake of the parts of the Admin SDK Directory, Groups
+// Settings and Gmail APIs used by the provider's user, group, group member, group settings and
+// send-as alias resources. It lets resource tests and module CI run without a Workspace tenant,
+// by pointing the provider's `custom_endpoint` at it.
+//
+// The fake doesn't check credentials or scopes, ignores partial responses and query filters,
+// and returns every list in a single page.
+package fakeworkspace
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type object map[string]interface{}
+
+// Server is an http.Handler serving the fake APIs
+type Server struct {
+	mu sync.Mutex
+
+	nextId int
+
+	users  map[string]object
+	groups map[string]object
+	// group id -> member id -> member
+	members map[string]map[string]object
+	// group id -> settings
+	groupSettings map[string]object
+	// user id as given in the request path -> send-as email -> send-as alias
+	sendAs map[string]map[string]object
+}
+
+// NewServer returns a fake without any users or groups
+func NewServer() *Server {
+	return &Server{
+		nextId:        100000000000000000,
+		users:         map[string]object{},
+		groups:        map[string]object{},
+		members:       map[string]map[string]object{},
+		groupSettings: map[string]object{},
+		sendAs:        map[string]map[string]object{},
+	}
+}
+
+// NewTestServer starts a fake on a local port, its URL is used as the provider's custom endpoint
+func NewTestServer() *httptest.Server {
+	return httptest.NewServer(NewServer())
+}
+
+type apiError struct {
+	code    int
+	reason  string
+	message string
+}
+
+func (e *apiError) Error() string {
+	return e.message
+}
+
+func notFound(kind, key string) *apiError {
+	return &apiError{code: http.StatusNotFound, reason: "notFound", message: fmt.Sprintf("Resource Not Found: %s %s", kind, key)}
+}
+
+func duplicate(kind, key string) *apiError {
+	return &apiError{code: http.StatusConflict, reason: "duplicate", message: fmt.Sprintf("Entity already exists: %s %s", kind, key)}
+}
+
+func invalid(message string) *apiError {
+	return &apiError{code: http.StatusBadRequest, reason: "invalid", message: message}
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var body object
+	if r.Body != nil && r.Method != http.MethodGet && r.Method != http.MethodDelete {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil && !errors.Is(err, io.EOF) {
+			writeError(w, invalid(fmt.Sprintf("Invalid JSON payload received: %s", err)))
+			return
+		}
+	}
+
+	resp, err := s.route(r.Method, strings.Split(strings.Trim(r.URL.Path, "/"), "/"), body)
+	if err != nil {
+		if apiErr, ok := err.(*apiError); ok {
+			writeError(w, apiErr)
+			return
+		}
+		writeError(w, &apiError{code: http.StatusInternalServerError, reason: "backendError", message: err.Error()})
+		return
+	}
+
+	if resp == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	etag, _ := resp["etag"].(string)
+	if etag == "" {
+		etag = computeEtag(resp)
+	}
+	w.Header().Set("ETag", etag)
+
+	if match := r.Header.Get("If-None-Match"); match != "" && match == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (s *Server) route(method string, path []string, body object) (object, error) {
+	switch {
+	case hasPrefix(path, "admin", "directory", "v1", "users"):
+		return s.routeUsers(method, path[4:], body)
+	case hasPrefix(path, "admin", "directory", "v1", "groups"):
+		return s.routeGroups(method, path[4:], body)
+	case hasPrefix(path, "groups", "v1", "groups") && len(path) == 4:
+		return s.routeGroupSettings(method, path[3], body)
+	case hasPrefix(path, "gmail", "v1", "users") && len(path) >= 6 && path[4] == "settings" && path[5] == "sendAs":
+		return s.routeSendAs(method, path[3], path[6:], body)
+	}
+
+	return nil, &apiError{code: http.StatusNotFound, reason: "notFound", message: fmt.Sprintf("%s /%s is not supported by the fake", method, strings.Join(path, "/"))}
+}
+
+func (s *Server) routeUsers(method string, path []string, body object) (object, error) {
+	if len(path) == 0 {
+		switch method {
+		case http.MethodGet:
+			return list("admin#directory#users", "users", s.users), nil
+		case http.MethodPost:
+			return s.insertUser(body)
+		}
+		return nil, methodNotAllowed(method)
+	}
+
+	user, err := s.findUser(path[0])
+	if err != nil {
+		return nil, err
+	}
+
+	if len(path) == 1 {
+		switch method {
+		case http.MethodGet:
+			return user, nil
+		case http.MethodPut, http.MethodPatch:
+			if email, ok := body["primaryEmail"].(string); ok && !strings.EqualFold(email, user["primaryEmail"].(string)) {
+				if _, err := s.findUser(email); err == nil {
+					return nil, duplicate("user", email)
+				}
+			}
+			delete(body, "password")
+			return s.update(user, body), nil
+		case http.MethodDelete:
+			delete(s.users, user["id"].(string))
+			return nil, nil
+		}
+		return nil, methodNotAllowed(method)
+	}
+
+	switch path[1] {
+	case "makeAdmin":
+		status, _ := body["status"].(bool)
+		return nil, s.setAdmin(user, status)
+	case "aliases":
+		return s.routeAliases(method, user, path[2:], body, "admin#directory#alias")
+	}
+
+	return nil, methodNotAllowed(method)
+}
+
+func (s *Server) insertUser(body object) (object, error) {
+	email, _ := body["primaryEmail"].(string)
+	if email == "" {
+		return nil, invalid("Invalid Input: primary_user_email")
+	}
+	if _, err := s.findUser(email); err == nil {
+		return nil, duplicate("user", email)
+	}
+
+	user := object{}
+	for k, v := range body {
+		user[k] = v
+	}
+	delete(user, "password")
+
+	user["id"] = s.newId()
+	user["kind"] = "admin#directory#user"
+	user["customerId"] = "fake"
+	user["creationTime"] = time.Now().UTC().Format(time.RFC3339)
+	user["isAdmin"] = false
+	user["isDelegatedAdmin"] = false
+	user["isMailboxSetup"] = true
+	user["agreedToTerms"] = true
+	if _, ok := user["orgUnitPath"]; !ok {
+		user["orgUnitPath"] = "/"
+	}
+	if name, ok := user["name"].(map[string]interface{}); ok {
+		name["fullName"] = strings.TrimSpace(fmt.Sprintf("%v %v", name["givenName"], name["familyName"]))
+	}
+
+	s.users[user["id"].(string)] = s.withEtag(user)
+	return user, nil
+}
+
+func (s *Server) setAdmin(user object, status bool) error {
+	user["isAdmin"] = status
+	s.withEtag(user)
+	return nil
+}
+
+func (s *Server) findUser(key string) (object, error) {
+	if user, ok := s.users[key]; ok {
+		return user, nil
+	}
+
+	for _, user := range s.users {
+		if matchesKey(user, "primaryEmail", key) {
+			return user, nil
+		}
+	}
+
+	return nil, notFound("user", key)
+}
+
+func (s *Server) routeGroups(method string, path []string, body object) (object, error) {
+	if len(path) == 0 {
+		switch method {
+		case http.MethodGet:
+			return list("admin#directory#groups", "groups", s.groups), nil
+		case http.MethodPost:
+			return s.insertGroup(body)
+		}
+		return nil, methodNotAllowed(method)
+	}
+
+	group, err := s.findGroup(path[0])
+	if err != nil {
+		return nil, err
+	}
+	groupId := group["id"].(string)
+
+	if len(path) == 1 {
+		switch method {
+		case http.MethodGet:
+			return group, nil
+		case http.MethodPut, http.MethodPatch:
+			if email, ok := body["email"].(string); ok && !strings.EqualFold(email, group["email"].(string)) {
+				if _, err := s.findGroup(email); err == nil {
+					return nil, duplicate("group", email)
+				}
+			}
+			return s.update(group, body), nil
+		case http.MethodDelete:
+			delete(s.groups, groupId)
+			delete(s.members, groupId)
+			delete(s.groupSettings, groupId)
+			return nil, nil
+		}
+		return nil, methodNotAllowed(method)
+	}
+
+	switch path[1] {
+	case "aliases":
+		return s.routeAliases(method, group, path[2:], body, "admin#directory#alias")
+	case "members":
+		return s.routeMembers(method, group, path[2:], body)
+	case "hasMember":
+		if len(path) != 3 || method != http.MethodGet {
+			return nil, methodNotAllowed(method)
+		}
+		_, err := s.findMember(groupId, path[2])
+		return object{"isMember": err == nil}, nil
+	}
+
+	return nil, methodNotAllowed(method)
+}
+
+func (s *Server) insertGroup(body object) (object, error) {
+	email, _ := body["email"].(string)
+	if email == "" {
+		return nil, invalid("Missing required field: email")
+	}
+	if _, err := s.findGroup(email); err == nil {
+		return nil, duplicate("group", email)
+	}
+
+	group := object{}
+	for k, v := range body {
+		group[k] = v
+	}
+
+	group["id"] = s.newId()
+	group["kind"] = "admin#directory#group"
+	group["adminCreated"] = true
+	group["directMembersCount"] = "0"
+	if _, ok := group["name"]; !ok {
+		group["name"] = strings.Split(email, "@")[0]
+	}
+
+	groupId := group["id"].(string)
+	s.groups[groupId] = s.withEtag(group)
+	s.members[groupId] = map[string]object{}
+	s.groupSettings[groupId] = defaultGroupSettings()
+
+	return group, nil
+}
+
+func (s *Server) findGroup(key string) (object, error) {
+	if group, ok := s.groups[key]; ok {
+		return group, nil
+	}
+
+	for _, group := range s.groups {
+		if matchesKey(group, "email", key) {
+			return group, nil
+		}
+	}
+
+	return nil, notFound("group", key)
+}
+
+func (s *Server) routeMembers(method string, group object, path []string, body object) (object, error) {
+	groupId := group["id"].(string)
+
+	if len(path) == 0 {
+		switch method {
+		case http.MethodGet:
+			return list("admin#directory#members", "members", s.members[groupId]), nil
+		case http.MethodPost:
+			return s.insertMember(group, body)
+		}
+		return nil, methodNotAllowed(method)
+	}
+
+	member, err := s.findMember(groupId, path[0])
+	if err != nil {
+		return nil, err
+	}
+
+	switch method {
+	case http.MethodGet:
+		return member, nil
+	case http.MethodPut, http.MethodPatch:
+		delete(body, "email")
+		delete(body, "id")
+		return s.update(member, body), nil
+	case http.MethodDelete:
+		delete(s.members[groupId], member["id"].(string))
+		s.updateMembersCount(group)
+		return nil, nil
+	}
+
+	return nil, methodNotAllowed(method)
+}
+
+func (s *Server) insertMember(group object, body object) (object, error) {
+	groupId := group["id"].(string)
+
+	email, _ := body["email"].(string)
+	if email == "" {
+		return nil, invalid("Missing required field: memberKey")
+	}
+	if _, err := s.findMember(groupId, email); err == nil {
+		return nil, &apiError{code: http.StatusConflict, reason: "duplicate", message: "Member already exists."}
+	}
+
+	member := object{}
+	for k, v := range body {
+		member[k] = v
+	}
+
+	member["kind"] = "admin#directory#member"
+	member["status"] = "ACTIVE"
+	if _, ok := member["role"]; !ok {
+		member["role"] = "MEMBER"
+	}
+	if _, ok := member["delivery_settings"]; !ok {
+		member["delivery_settings"] = "ALL_MAIL"
+	}
+
+	if user, err := s.findUser(email); err == nil {
+		member["id"] = user["id"]
+		member["type"] = "USER"
+	} else if memberGroup, err := s.findGroup(email); err == nil {
+		member["id"] = memberGroup["id"]
+		member["type"] = "GROUP"
+		delete(member, "status")
+	} else {
+		member["id"] = s.newId()
+		if _, ok := member["type"]; !ok {
+			member["type"] = "USER"
+		}
+	}
+
+	s.members[groupId][member["id"].(string)] = s.withEtag(member)
+	s.updateMembersCount(group)
+
+	return member, nil
+}
+
+func (s *Server) findMember(groupId, key string) (object, error) {
+	if member, ok := s.members[groupId][key]; ok {
+		return member, nil
+	}
+
+	for _, member := range s.members[groupId] {
+		if matchesKey(member, "email", key) {
+			return member, nil
+		}
+	}
+
+	return nil, notFound("member", key)
+}
+
+func (s *Server) updateMembersCount(group object) {
+	group["directMembersCount"] = strconv.Itoa(len(s.members[group["id"].(string)]))
+	s.withEtag(group)
+}
+
+func (s *Server) routeAliases(method string, parent object, path []string, body object, kind string) (object, error) {
+	aliases, _ := parent["aliases"].([]interface{})
+
+	switch {
+	case len(path) == 0 && method == http.MethodGet:
+		items := []interface{}{}
+		for _, alias := range aliases {
+			items = append(items, object{"kind": kind, "id": parent["id"], "alias": alias})
+		}
+		return object{"kind": "admin#directory#aliases", "aliases": items}, nil
+	case len(path) == 0 && method == http.MethodPost:
+		alias, _ := body["alias"].(string)
+		if alias == "" {
+			return nil, invalid("Missing required field: alias")
+		}
+		for _, a := range aliases {
+			if strings.EqualFold(a.(string), alias) {
+				return nil, duplicate("alias", alias)
+			}
+		}
+		parent["aliases"] = append(aliases, alias)
+		s.withEtag(parent)
+		return object{"kind": kind, "id": parent["id"], "alias": alias}, nil
+	case len(path) == 1 && method == http.MethodDelete:
+		var remaining []interface{}
+		found := false
+		for _, a := range aliases {
+			if strings.EqualFold(a.(string), path[0]) {
+				found = true
+				continue
+			}
+			remaining = append(remaining, a)
+		}
+		if !found {
+			return nil, notFound("alias", path[0])
+		}
+		if len(remaining) == 0 {
+			delete(parent, "aliases")
+		} else {
+			parent["aliases"] = remaining
+		}
+		s.withEtag(parent)
+		return nil, nil
+	}
+
+	return nil, methodNotAllowed(method)
+}
+
+func (s *Server) routeGroupSettings(method, key string, body object) (object, error) {
+	group, err := s.findGroup(key)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := s.groupSettings[group["id"].(string)]
+
+	switch method {
+	case http.MethodGet:
+	case http.MethodPut, http.MethodPatch:
+		delete(body, "email")
+		for k, v := range body {
+			settings[k] = v
+		}
+	default:
+		return nil, methodNotAllowed(method)
+	}
+
+	// the Groups Settings API only returns the etag as a header
+	resp := object{
+		"kind":  "groupsSettings#groups",
+		"email": group["email"],
+		"name":  group["name"],
+	}
+	if description, ok := group["description"]; ok {
+		resp["description"] = description
+	}
+	for k, v := range settings {
+		resp[k] = v
+	}
+
+	return resp, nil
+}
+
+func (s *Server) routeSendAs(method, userId string, path []string, body object) (object, error) {
+	if _, ok := s.sendAs[userId]; !ok {
+		s.sendAs[userId] = map[string]object{}
+	}
+	aliases := s.sendAs[userId]
+
+	if len(path) == 0 {
+		switch method {
+		case http.MethodGet:
+			items := []interface{}{}
+			for _, key := range sortedKeys(aliases) {
+				items = append(items, aliases[key])
+			}
+			return object{"sendAs": items}, nil
+		case http.MethodPost:
+			email, _ := body["sendAsEmail"].(string)
+			if email == "" {
+				return nil, invalid("Missing required field: sendAsEmail")
+			}
+			if _, ok := aliases[strings.ToLower(email)]; ok {
+				return nil, duplicate("sendAs", email)
+			}
+
+			alias := object{}
+			for k, v := range body {
+				alias[k] = v
+			}
+			alias["verificationStatus"] = "accepted"
+			aliases[strings.ToLower(email)] = alias
+			return alias, nil
+		}
+		return nil, methodNotAllowed(method)
+	}
+
+	alias, ok := aliases[strings.ToLower(path[0])]
+	if !ok {
+		return nil, notFound("sendAs", path[0])
+	}
+
+	switch method {
+	case http.MethodGet:
+		return alias, nil
+	case http.MethodPut, http.MethodPatch:
+		delete(body, "sendAsEmail")
+		for k, v := range body {
+			alias[k] = v
+		}
+		return alias, nil
+	case http.MethodDelete:
+		delete(aliases, strings.ToLower(path[0]))
+		return nil, nil
+	}
+
+	return nil, methodNotAllowed(method)
+}
+
+// update merges the fields of the request into the stored object, the fake doesn't distinguish
+// between update and patch requests as the provider always sends the full object on update
+func (s *Server) update(obj object, body object) object {
+	for k, v := range body {
+		if k == "id" || k == "kind" || k == "etag" {
+			continue
+		}
+		obj[k] = v
+	}
+
+	return s.withEtag(obj)
+}
+
+func (s *Server) newId() string {
+	s.nextId++
+	return strconv.Itoa(s.nextId)
+}
+
+func (s *Server) withEtag(obj object) object {
+	delete(obj, "etag")
+	obj["etag"] = computeEtag(obj)
+	return obj
+}
+
+func computeEtag(obj object) string {
+	b, _ := json.Marshal(obj)
+	return fmt.Sprintf("%q", fmt.Sprintf("%x", sha256.Sum256(b))[:16])
+}
+
+func matchesKey(obj object, field, key string) bool {
+	if v, ok := obj[field].(string); ok && strings.EqualFold(v, key) {
+		return true
+	}
+
+	aliases, _ := obj["aliases"].([]interface{})
+	for _, alias := range aliases {
+		if a, ok := alias.(string); ok && strings.EqualFold(a, key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func list(kind, field string, objects map[string]object) object {
+	items := []interface{}{}
+	for _, key := range sortedKeys(objects) {
+		items = append(items, objects[key])
+	}
+
+	return object{"kind": kind, field: items}
+}
+
+func sortedKeys(objects map[string]object) []string {
+	var keys []string
+	for key := range objects {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func hasPrefix(path []string, prefix ...string) bool {
+	if len(path) < len(prefix) {
+		return false
+	}
+
+	for i, p := range prefix {
+		if path[i] != p {
+			return false
+		}
+	}
+
+	return true
+}
+
+func methodNotAllowed(method string) *apiError {
+	return &apiError{code: http.StatusMethodNotAllowed, reason: "notSupported", message: fmt.Sprintf("%s is not supported by the fake", method)}
+}
+
+func writeError(w http.ResponseWriter, err *apiError) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(err.code)
+	_ = json.NewEncoder(w).Encode(object{
+		"error": object{
+			"code":    err.code,
+			"message": err.message,
+			"errors": []interface{}{
+				object{"domain": "global", "reason": err.reason, "message": err.message},
+			},
+		},
+	})
+}
+
+func defaultGroupSettings() object {
+	return object{
+		"whoCanJoin":                              "CAN_REQUEST_TO_JOIN",
+		"whoCanViewMembership":                    "ALL_IN_DOMAIN_CAN_VIEW",
+		"whoCanViewGroup":                         "ALL_MEMBERS_CAN_VIEW",
+		"allowExternalMembers":                    "false",
+		"whoCanPostMessage":                       "ANYONE_CAN_POST",
+		"allowWebPosting":                         "true",
+		"primaryLanguage":                         "en_US",
+		"isArchived":                              "false",
+		"archiveOnly":                             "false",
+		"messageModerationLevel":                  "MODERATE_NONE",
+		"spamModerationLevel":                     "MODERATE",
+		"replyTo":                                 "REPLY_TO_IGNORE",
+		"includeCustomFooter":                     "false",
+		"sendMessageDenyNotification":             "false",
+		"membersCanPostAsTheGroup":                "false",
+		"includeInGlobalAddressList":              "true",
+		"whoCanLeaveGroup":                        "ALL_MEMBERS_CAN_LEAVE",
+		"whoCanContactOwner":                      "ANYONE_CAN_CONTACT",
+		"favoriteRepliesOnTop":                    "true",
+		"whoCanApproveMembers":                    "ALL_MANAGERS_CAN_APPROVE",
+		"whoCanBanUsers":                          "OWNERS_AND_MANAGERS",
+		"whoCanModerateMembers":                   "OWNERS_AND_MANAGERS",
+		"whoCanModerateContent":                   "OWNERS_AND_MANAGERS",
+		"whoCanAssistContent":                     "NONE",
+		"customRolesEnabledForSettingsToBeMerged": "false",
+		"enableCollaborativeInbox":                "false",
+		"whoCanDiscoverGroup":                     "ALL_IN_DOMAIN_CAN_DISCOVER",
+		"defaultSender":                           "DEFAULT_SELF",
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeworkspace
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func testRequest(t *testing.T, method, url string, body interface{}, headers map[string]string) (*http.Response, map[string]interface{}) {
+	t.Helper()
+
+	var reqBody bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&reqBody).Encode(body); err != nil {
+			t.Fatalf("unable to encode request body: %v", err)
+		}
+	}
+
+	req, err := http.NewRequest(method, url, &reqBody)
+	if err != nil {
+		t.Fatalf("unable to construct request: %v", err)
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("unable to do request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var result map[string]interface{}
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusNotModified {
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			t.Fatalf("unable to decode response body: %v", err)
+		}
+	}
+
+	return resp, result
+}
+
+func TestServer_users(t *testing.T) {
+	ts := NewTestServer()
+	defer ts.Close()
+
+	usersUrl := ts.URL + "/admin/directory/v1/users"
+
+	resp, user := testRequest(t, "POST", usersUrl, map[string]interface{}{
+		"primaryEmail": "jane@example.com",
+		"password":     "secret",
+		"name":         map[string]interface{}{"givenName": "Jane", "familyName": "Doe"},
+	}, nil)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected user to be created, got status %d: %v", resp.StatusCode, user)
+	}
+	if _, ok := user["password"]; ok {
+		t.Errorf("expected the password not to be returned")
+	}
+	if user["name"].(map[string]interface{})["fullName"] != "Jane Doe" {
+		t.Errorf("expected the full name to be set, got %v", user["name"])
+	}
+
+	resp, _ = testRequest(t, "POST", usersUrl, map[string]interface{}{"primaryEmail": "JANE@example.com"}, nil)
+	if resp.StatusCode != http.StatusConflict {
+		t.Errorf("expected a duplicate user to be rejected, got status %d", resp.StatusCode)
+	}
+
+	// users can be retrieved by email or id, and unchanged users aren't returned again
+	userUrl := usersUrl + "/" + user["id"].(string)
+	resp, _ = testRequest(t, "GET", usersUrl+"/jane@example.com", nil, map[string]string{"If-None-Match": user["etag"].(string)})
+	if resp.StatusCode != http.StatusNotModified {
+		t.Errorf("expected status %d, got %d", http.StatusNotModified, resp.StatusCode)
+	}
+
+	resp, updated := testRequest(t, "PATCH", userUrl, map[string]interface{}{"orgUnitPath": "/sales"}, nil)
+	if resp.StatusCode != http.StatusOK || updated["orgUnitPath"] != "/sales" {
+		t.Fatalf("expected user to be updated, got status %d: %v", resp.StatusCode, updated)
+	}
+	if updated["etag"] == user["etag"] {
+		t.Errorf("expected the etag to change on update")
+	}
+
+	resp, _ = testRequest(t, "POST", userUrl+"/makeAdmin", map[string]interface{}{"status": true}, nil)
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("expected status %d, got %d", http.StatusNoContent, resp.StatusCode)
+	}
+	_, user = testRequest(t, "GET", userUrl, nil, nil)
+	if user["isAdmin"] != true {
+		t.Errorf("expected user to be an admin")
+	}
+
+	resp, _ = testRequest(t, "DELETE", userUrl, nil, nil)
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("expected status %d, got %d", http.StatusNoContent, resp.StatusCode)
+	}
+
+	resp, body := testRequest(t, "GET", userUrl, nil, nil)
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, resp.StatusCode)
+	}
+	if body["error"].(map[string]interface{})["errors"].([]interface{})[0].(map[string]interface{})["reason"] != "notFound" {
+		t.Errorf("expected a notFound error, got %v", body)
+	}
+}
+
+func TestServer_groups(t *testing.T) {
+	ts := NewTestServer()
+	defer ts.Close()
+
+	groupsUrl := ts.URL + "/admin/directory/v1/groups"
+
+	_, user := testRequest(t, "POST", ts.URL+"/admin/directory/v1/users", map[string]interface{}{"primaryEmail": "jane@example.com"}, nil)
+	_, group := testRequest(t, "POST", groupsUrl, map[string]interface{}{"email": "team@example.com"}, nil)
+
+	groupUrl := groupsUrl + "/" + group["id"].(string)
+
+	resp, member := testRequest(t, "POST", groupUrl+"/members", map[string]interface{}{"email": "jane@example.com", "role": "OWNER"}, nil)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected member to be created, got status %d: %v", resp.StatusCode, member)
+	}
+	if member["id"] != user["id"] || member["type"] != "USER" {
+		t.Errorf("expected the member to reference the user, got %v", member)
+	}
+
+	resp, _ = testRequest(t, "POST", groupUrl+"/members", map[string]interface{}{"email": "jane@example.com"}, nil)
+	if resp.StatusCode != http.StatusConflict {
+		t.Errorf("expected a duplicate member to be rejected, got status %d", resp.StatusCode)
+	}
+
+	_, hasMember := testRequest(t, "GET", groupUrl+"/hasMember/jane@example.com", nil, nil)
+	if hasMember["isMember"] != true {
+		t.Errorf("expected jane to be a member")
+	}
+
+	_, group = testRequest(t, "GET", groupUrl, nil, nil)
+	if group["directMembersCount"] != "1" {
+		t.Errorf("expected 1 member, got %v", group["directMembersCount"])
+	}
+
+	// group settings are created with the group
+	settingsUrl := ts.URL + "/groups/v1/groups/team@example.com"
+	resp, settings := testRequest(t, "PUT", settingsUrl, map[string]interface{}{"whoCanJoin": "INVITED_CAN_JOIN"}, nil)
+	if resp.StatusCode != http.StatusOK || settings["whoCanJoin"] != "INVITED_CAN_JOIN" {
+		t.Fatalf("expected settings to be updated, got status %d: %v", resp.StatusCode, settings)
+	}
+
+	resp, _ = testRequest(t, "GET", settingsUrl, nil, map[string]string{"If-None-Match": resp.Header.Get("ETag")})
+	if resp.StatusCode != http.StatusNotModified {
+		t.Errorf("expected status %d, got %d", http.StatusNotModified, resp.StatusCode)
+	}
+
+	resp, _ = testRequest(t, "POST", groupUrl+"/aliases", map[string]interface{}{"alias": "crew@example.com"}, nil)
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected alias to be created, got status %d", resp.StatusCode)
+	}
+	resp, _ = testRequest(t, "GET", groupsUrl+"/crew@example.com", nil, nil)
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected group to be found by alias, got status %d", resp.StatusCode)
+	}
+
+	testRequest(t, "DELETE", groupUrl, nil, nil)
+	resp, _ = testRequest(t, "GET", settingsUrl, nil, nil)
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("expected the settings to be deleted with the group, got status %d", resp.StatusCode)
+	}
+}
+
+func TestServer_sendAs(t *testing.T) {
+	ts := NewTestServer()
+	defer ts.Close()
+
+	sendAsUrl := ts.URL + "/gmail/v1/users/me/settings/sendAs"
+
+	resp, alias := testRequest(t, "POST", sendAsUrl, map[string]interface{}{"sendAsEmail": "sales@example.com", "displayName": "Sales"}, nil)
+	if resp.StatusCode != http.StatusOK || alias["verificationStatus"] != "accepted" {
+		t.Fatalf("expected alias to be created, got status %d: %v", resp.StatusCode, alias)
+	}
+
+	_, alias = testRequest(t, "PUT", sendAsUrl+"/sales@example.com", map[string]interface{}{"displayName": "Sales Team"}, nil)
+	if alias["displayName"] != "Sales Team" {
+		t.Errorf("expected alias to be updated, got %v", alias)
+	}
+
+	testRequest(t, "DELETE", sendAsUrl+"/sales@example.com", nil, nil)
+	resp, _ = testRequest(t, "GET", sendAsUrl+"/sales@example.com", nil, nil)
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, resp.StatusCode)
+	}
+}
@@ -0,0 +1,263 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"google.golang.org/api/googleapi"
+)
+
+const (
+	errorInfoType = "type.googleapis.com/google.rpc.ErrorInfo"
+	helpType      = "type.googleapis.com/google.rpc.Help"
+
+	domainWideDelegationUrl = "https://admin.google.com/ac/owl/domainwidedelegation"
+)
+
+// serviceNameRegexp extracts the service from the activation link of errors that only carry a message
+var serviceNameRegexp = regexp.MustCompile(`apis/api/([a-z0-9.-]+\.googleapis\.com)`)
+
+// methodScopes maps the methods of a service to the OAuth scope they require, the first keyword
+// contained in the method's name wins so more specific keywords come first
+var methodScopes = map[string][]struct {
+	keyword string
+	scope   string
+}{
+	"admin.googleapis.com": {
+		{"Transfers", "https://www.googleapis.com/auth/admin.datatransfer"},
+		{"Applications", "https://www.googleapis.com/auth/admin.datatransfer"},
+		{"PrintServers", "https://www.googleapis.com/auth/admin.chrome.printers"},
+		{"Printers", "https://www.googleapis.com/auth/admin.chrome.printers"},
+		{"Chromeosdevices", "https://www.googleapis.com/auth/admin.directory.device.chromeos"},
+		{"Mobiledevices", "https://www.googleapis.com/auth/admin.directory.device.mobile"},
+		{"Buildings", "https://www.googleapis.com/auth/admin.directory.resource.calendar"},
+		{"Features", "https://www.googleapis.com/auth/admin.directory.resource.calendar"},
+		{"Calendars", "https://www.googleapis.com/auth/admin.directory.resource.calendar"},
+		{"RoleAssignments", "https://www.googleapis.com/auth/admin.directory.rolemanagement"},
+		{"Privileges", "https://www.googleapis.com/auth/admin.directory.rolemanagement"},
+		{"Roles", "https://www.googleapis.com/auth/admin.directory.rolemanagement"},
+		{"Schemas", "https://www.googleapis.com/auth/admin.directory.userschema"},
+		{"Members", "https://www.googleapis.com/auth/admin.directory.group"},
+		{"Groups", "https://www.googleapis.com/auth/admin.directory.group"},
+		{"Orgunits", "https://www.googleapis.com/auth/admin.directory.orgunit"},
+		{"Domain", "https://www.googleapis.com/auth/admin.directory.domain"},
+		{"Customers", "https://www.googleapis.com/auth/admin.directory.customer"},
+		{"SignOut", "https://www.googleapis.com/auth/admin.directory.user.security"},
+		{"Tokens", "https://www.googleapis.com/auth/admin.directory.user.security"},
+		{"Asps", "https://www.googleapis.com/auth/admin.directory.user.security"},
+		{"VerificationCodes", "https://www.googleapis.com/auth/admin.directory.user.security"},
+		{"Users", "https://www.googleapis.com/auth/admin.directory.user"},
+		{"Activities", "https://www.googleapis.com/auth/admin.reports.audit.readonly"},
+		{"UserUsageReport", "https://www.googleapis.com/auth/admin.reports.usage.readonly"},
+	},
+	"cloudidentity.googleapis.com": {
+		{"Devices", "https://www.googleapis.com/auth/cloud-identity.devices"},
+		{"Userinvitations", "https://www.googleapis.com/auth/cloud-identity.userinvitations"},
+		{"InboundSso", "https://www.googleapis.com/auth/cloud-identity.inboundsso"},
+		{"Groups", "https://www.googleapis.com/auth/cloud-identity.groups"},
+	},
+	"gmail.googleapis.com": {
+		{"SendAs", "https://www.googleapis.com/auth/gmail.settings.sharing"},
+		{"Delegates", "https://www.googleapis.com/auth/gmail.settings.sharing"},
+		{"Settings", "https://www.googleapis.com/auth/gmail.settings.basic"},
+	},
+}
+
+// serviceScopes maps the services to the OAuth scope they require, for the methods not matched by methodScopes
+var serviceScopes = map[string]string{
+	"alertcenter.googleapis.com":      "https://www.googleapis.com/auth/apps.alerts",
+	"calendar-json.googleapis.com":    "https://www.googleapis.com/auth/calendar",
+	"chat.googleapis.com":             "https://www.googleapis.com/auth/chat.spaces",
+	"chromepolicy.googleapis.com":     "https://www.googleapis.com/auth/chrome.management.policy",
+	"drive.googleapis.com":            "https://www.googleapis.com/auth/drive",
+	"gmail.googleapis.com":            "https://www.googleapis.com/auth/gmail.readonly",
+	"groupssettings.googleapis.com":   "https://www.googleapis.com/auth/apps.groups.settings",
+	"iam.googleapis.com":              "https://www.googleapis.com/auth/cloud-platform",
+	"licensing.googleapis.com":        "https://www.googleapis.com/auth/apps.licensing",
+	"reseller.googleapis.com":         "https://www.googleapis.com/auth/apps.order",
+	"siteverification.googleapis.com": "https://www.googleapis.com/auth/siteverification",
+	"vault.googleapis.com":            "https://www.googleapis.com/auth/ediscovery",
+}
+
+// requiredScope returns the OAuth scope required by the method of the service, or an empty
+// string if it isn't known
+func requiredScope(service, method string) string {
+	for _, m := range methodScopes[service] {
+		if strings.Contains(strings.ToLower(method), strings.ToLower(m.keyword)) {
+			return m.scope
+		}
+	}
+
+	return serviceScopes[service]
+}
+
+// apiErrorDiagnostics converts the error into diagnostics, googleapi errors are broken down into
+// their message, reason, domain and help links, along with a hint on how to fix common errors
+// such as disabled APIs or missing scopes. Other errors are returned as is.
+func apiErrorDiagnostics(err error) diag.Diagnostics {
+	gerr, ok := errwrap.GetType(err, &googleapi.Error{}).(*googleapi.Error)
+	if !ok || gerr == nil {
+		return diag.FromErr(err)
+	}
+
+	summary := gerr.Message
+	if summary == "" {
+		summary = http.StatusText(gerr.Code)
+	}
+	summary = fmt.Sprintf("Error %d: %s", gerr.Code, summary)
+
+	var details []string
+	var reasons []string
+
+	for _, item := range gerr.Errors {
+		if item.Reason != "" {
+			reasons = append(reasons, item.Reason)
+		}
+	}
+
+	var metadata map[string]interface{}
+	var links []string
+	for _, d := range gerr.Details {
+		detail, ok := d.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		switch detail["@type"] {
+		case errorInfoType:
+			if reason, ok := detail["reason"].(string); ok {
+				reasons = append(reasons, reason)
+			}
+			if domain, ok := detail["domain"].(string); ok {
+				details = append(details, fmt.Sprintf("Domain: %s", domain))
+			}
+			metadata, _ = detail["metadata"].(map[string]interface{})
+		case helpType:
+			rawLinks, _ := detail["links"].([]interface{})
+			for _, l := range rawLinks {
+				link, ok := l.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				links = append(links, fmt.Sprintf("%s: %s", link["description"], link["url"]))
+			}
+		}
+	}
+
+	if len(reasons) > 0 {
+		details = append([]string{fmt.Sprintf("Reason: %s", strings.Join(reasons, ", "))}, details...)
+	}
+
+	if service, ok := metadata["service"].(string); ok {
+		details = append(details, fmt.Sprintf("Service: %s", service))
+	}
+
+	for _, link := range links {
+		details = append(details, fmt.Sprintf("Help: %s", link))
+	}
+
+	if hint := apiErrorHint(gerr, reasons, metadata); hint != "" {
+		details = append(details, "", hint)
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Error,
+			Summary:  summary,
+			Detail:   strings.Join(details, "\n"),
+		},
+	}
+}
+
+// isServiceDisabledError returns whether the error is caused by the API not being enabled in the
+// Google Cloud project of the credentials
+func isServiceDisabledError(err error) bool {
+	gerr, ok := errwrap.GetType(err, &googleapi.Error{}).(*googleapi.Error)
+	if !ok || gerr == nil {
+		return false
+	}
+
+	for _, item := range gerr.Errors {
+		if item.Reason == "accessNotConfigured" {
+			return true
+		}
+	}
+
+	for _, d := range gerr.Details {
+		detail, ok := d.(map[string]interface{})
+		if ok && detail["@type"] == errorInfoType && detail["reason"] == "SERVICE_DISABLED" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// apiErrorHint returns how to fix the most common configuration errors
+func apiErrorHint(gerr *googleapi.Error, reasons []string, metadata map[string]interface{}) string {
+	hasReason := func(want ...string) bool {
+		for _, reason := range reasons {
+			for _, w := range want {
+				if reason == w {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	service, _ := metadata["service"].(string)
+	if service == "" {
+		// errors of the older APIs only name the service in the activation link of their message
+		if m := serviceNameRegexp.FindStringSubmatch(gerr.Message); m != nil {
+			service = m[1]
+		}
+	}
+
+	switch {
+	case hasReason("SERVICE_DISABLED", "accessNotConfigured"):
+		if service == "" {
+			return "The required API is not enabled in the Google Cloud project of the provider's credentials. " +
+				"Enable it in the Cloud console under APIs & Services > Library and retry after a few minutes."
+		}
+
+		url, ok := metadata["activationUrl"].(string)
+		if !ok {
+			url = fmt.Sprintf("https://console.cloud.google.com/apis/library/%s", service)
+		}
+
+		return fmt.Sprintf("The %s API is not enabled in the Google Cloud project of the provider's credentials. "+
+			"Enable it in the Cloud console at %s and retry after a few minutes.", service, url)
+	case hasReason("ACCESS_TOKEN_SCOPE_INSUFFICIENT", "insufficientPermissions"):
+		method, _ := metadata["method"].(string)
+		scope := requiredScope(service, method)
+		if scope == "" {
+			return "The credentials are missing an OAuth scope required by this API. Check `oauth_scopes` in the provider " +
+				"configuration and, when using domain-wide delegation, the scopes granted to the service account in the " +
+				"Admin console under Security > Access and data control > API controls > Domain-wide delegation (" +
+				domainWideDelegationUrl + ")."
+		}
+
+		caller := service
+		if method != "" {
+			caller = method
+		}
+
+		return fmt.Sprintf("The credentials are missing the OAuth scope %s required by %s. Add it to `oauth_scopes` in "+
+			"the provider configuration and, when using domain-wide delegation, grant it to the service account's client ID "+
+			"in the Admin console under Security > Access and data control > API controls > Domain-wide delegation (%s). "+
+			"Changes to domain-wide delegation can take a few minutes to apply.", scope, caller, domainWideDelegationUrl)
+	case gerr.Code == http.StatusForbidden && strings.Contains(gerr.Message, "Not Authorized to access this resource/api"):
+		return "The impersonated user or service account lacks the admin privileges for this API, check `impersonated_user_email` " +
+			"and the roles assigned to it."
+	}
+
+	return ""
+}
@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/googleapi"
+)
+
+func TestApiErrorDiagnostics_serviceDisabled(t *testing.T) {
+	err := &googleapi.Error{
+		Code:    403,
+		Message: "Groups Settings API has not been used in project 123 before or it is disabled.",
+		Details: []interface{}{
+			map[string]interface{}{
+				"@type":  errorInfoType,
+				"reason": "SERVICE_DISABLED",
+				"domain": "googleapis.com",
+				"metadata": map[string]interface{}{
+					"service":       "groupssettings.googleapis.com",
+					"activationUrl": "https://console.developers.google.com/apis/api/groupssettings.googleapis.com/overview?project=123",
+				},
+			},
+			map[string]interface{}{
+				"@type": helpType,
+				"links": []interface{}{
+					map[string]interface{}{
+						"description": "Google developers console API activation",
+						"url":         "https://console.developers.google.com/apis/api/groupssettings.googleapis.com/overview?project=123",
+					},
+				},
+			},
+		},
+	}
+
+	diags := apiErrorDiagnostics(err)
+	if len(diags) != 1 {
+		t.Fatalf("expected 1 diagnostic, got %d", len(diags))
+	}
+
+	if diags[0].Summary != "Error 403: "+err.Message {
+		t.Errorf("unexpected summary: %q", diags[0].Summary)
+	}
+
+	for _, expected := range []string{
+		"Reason: SERVICE_DISABLED",
+		"Domain: googleapis.com",
+		"Service: groupssettings.googleapis.com",
+		"Help: Google developers console API activation: https://console.developers.google.com",
+		"The groupssettings.googleapis.com API is not enabled",
+	} {
+		if !strings.Contains(diags[0].Detail, expected) {
+			t.Errorf("expected detail to contain %q, got:\n%s", expected, diags[0].Detail)
+		}
+	}
+}
+
+func TestApiErrorDiagnostics_insufficientScopes(t *testing.T) {
+	err := &googleapi.Error{
+		Code:    403,
+		Message: "Request had insufficient authentication scopes.",
+		Errors: []googleapi.ErrorItem{
+			{Reason: "insufficientPermissions", Message: "Insufficient Permission"},
+		},
+	}
+
+	diags := apiErrorDiagnostics(err)
+	if !strings.Contains(diags[0].Detail, "Reason: insufficientPermissions") {
+		t.Errorf("expected detail to contain the reason, got:\n%s", diags[0].Detail)
+	}
+	if !strings.Contains(diags[0].Detail, "oauth_scopes") {
+		t.Errorf("expected detail to contain a hint about scopes, got:\n%s", diags[0].Detail)
+	}
+}
+
+func TestApiErrorDiagnostics_otherError(t *testing.T) {
+	diags := apiErrorDiagnostics(errors.New("something went wrong"))
+	if len(diags) != 1 || diags[0].Summary != "something went wrong" || diags[0].Detail != "" {
+		t.Errorf("expected the error to be returned as is, got: %#v", diags)
+	}
+}
+
+func TestApiErrorDiagnostics_insufficientScopesMethod(t *testing.T) {
+	err := &googleapi.Error{
+		Code:    403,
+		Message: "Request had insufficient authentication scopes.",
+		Details: []interface{}{
+			map[string]interface{}{
+				"@type":  errorInfoType,
+				"reason": "ACCESS_TOKEN_SCOPE_INSUFFICIENT",
+				"domain": "googleapis.com",
+				"metadata": map[string]interface{}{
+					"service": "admin.googleapis.com",
+					"method":  "ccc.hosted.frontend.directory.v1.DirectoryRoleAssignments.Insert",
+				},
+			},
+		},
+	}
+
+	diags := apiErrorDiagnostics(err)
+	for _, expected := range []string{
+		"missing the OAuth scope https://www.googleapis.com/auth/admin.directory.rolemanagement",
+		"required by ccc.hosted.frontend.directory.v1.DirectoryRoleAssignments.Insert",
+		domainWideDelegationUrl,
+	} {
+		if !strings.Contains(diags[0].Detail, expected) {
+			t.Errorf("expected detail to contain %q, got:\n%s", expected, diags[0].Detail)
+		}
+	}
+}
+
+func TestApiErrorDiagnostics_accessNotConfigured(t *testing.T) {
+	err := &googleapi.Error{
+		Code: 403,
+		Message: "Access Not Configured. Groups Settings API has not been used in project 123 before or it is disabled. " +
+			"Enable it by visiting https://console.developers.google.com/apis/api/groupssettings.googleapis.com/overview?project=123 then retry.",
+		Errors: []googleapi.ErrorItem{
+			{Reason: "accessNotConfigured", Message: "Access Not Configured."},
+		},
+	}
+
+	diags := apiErrorDiagnostics(err)
+	for _, expected := range []string{
+		"The groupssettings.googleapis.com API is not enabled",
+		"https://console.cloud.google.com/apis/library/groupssettings.googleapis.com",
+	} {
+		if !strings.Contains(diags[0].Detail, expected) {
+			t.Errorf("expected detail to contain %q, got:\n%s", expected, diags[0].Detail)
+		}
+	}
+}
+
+func TestRequiredScope(t *testing.T) {
+	cases := map[string]struct {
+		service  string
+		method   string
+		expected string
+	}{
+		"admin method": {
+			service:  "admin.googleapis.com",
+			method:   "ccc.hosted.frontend.directory.v1.DirectoryMembers.Insert",
+			expected: "https://www.googleapis.com/auth/admin.directory.group",
+		},
+		"admin user security method": {
+			service:  "admin.googleapis.com",
+			method:   "ccc.hosted.frontend.directory.v1.DirectoryUsers.SignOut",
+			expected: "https://www.googleapis.com/auth/admin.directory.user.security",
+		},
+		"service without methods": {
+			service:  "groupssettings.googleapis.com",
+			expected: "https://www.googleapis.com/auth/apps.groups.settings",
+		},
+		"unknown service": {
+			service: "example.googleapis.com",
+		},
+	}
+
+	for name, tc := range cases {
+		if got := requiredScope(tc.service, tc.method); got != tc.expected {
+			t.Errorf("%s: expected %q, got %q", name, tc.expected, got)
+		}
+	}
+}
+
+func TestIsServiceDisabledError(t *testing.T) {
+	cases := map[string]struct {
+		err      error
+		expected bool
+	}{
+		"service disabled": {
+			err: &googleapi.Error{
+				Code: 403,
+				Details: []interface{}{
+					map[string]interface{}{"@type": errorInfoType, "reason": "SERVICE_DISABLED"},
+				},
+			},
+			expected: true,
+		},
+		"access not configured": {
+			err: &googleapi.Error{
+				Code:   403,
+				Errors: []googleapi.ErrorItem{{Reason: "accessNotConfigured"}},
+			},
+			expected: true,
+		},
+		"insufficient scopes": {
+			err: &googleapi.Error{
+				Code:   403,
+				Errors: []googleapi.ErrorItem{{Reason: "insufficientPermissions"}},
+			},
+		},
+		"other error": {
+			err: errors.New("something went wrong"),
+		},
+	}
+
+	for name, tc := range cases {
+		if got := isServiceDisabledError(tc.err); got != tc.expected {
+			t.Errorf("%s: expected %t, got %t", name, tc.expected, got)
+		}
+	}
+}
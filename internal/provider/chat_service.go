@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"net/url"
+	"strings"
+)
+
+const chatBasePath = "https://chat.googleapis.com/"
+
+// chatService is a client of the Google Chat API's space management methods, which aren't
+// available in the version of google.golang.org/api/chat/v1 the provider depends on.
+type chatService struct {
+	restService
+}
+
+// chatSpace is a Google Chat space, see https://developers.google.com/chat/api/reference/rest/v1/spaces
+type chatSpace struct {
+	Name                string            `json:"name,omitempty"`
+	DisplayName         string            `json:"displayName,omitempty"`
+	SpaceType           string            `json:"spaceType,omitempty"`
+	SpaceDetails        *chatSpaceDetails `json:"spaceDetails,omitempty"`
+	ExternalUserAllowed bool              `json:"externalUserAllowed,omitempty"`
+	SpaceHistoryState   string            `json:"spaceHistoryState,omitempty"`
+	SpaceUri            string            `json:"spaceUri,omitempty"`
+	CreateTime          string            `json:"createTime,omitempty"`
+}
+
+type chatSpaceDetails struct {
+	Description string `json:"description,omitempty"`
+	Guidelines  string `json:"guidelines,omitempty"`
+}
+
+// chatMembership is a membership of a Google Chat space, either of a user or of a group,
+// see https://developers.google.com/chat/api/reference/rest/v1/spaces.members
+type chatMembership struct {
+	Name        string     `json:"name,omitempty"`
+	State       string     `json:"state,omitempty"`
+	Role        string     `json:"role,omitempty"`
+	Member      *chatUser  `json:"member,omitempty"`
+	GroupMember *chatGroup `json:"groupMember,omitempty"`
+	CreateTime  string     `json:"createTime,omitempty"`
+}
+
+type chatUser struct {
+	Name string `json:"name,omitempty"`
+	Type string `json:"type,omitempty"`
+}
+
+type chatGroup struct {
+	Name string `json:"name,omitempty"`
+}
+
+func (s *chatService) CreateSpace(ctx context.Context, space *chatSpace, requestId string) (*chatSpace, error) {
+	params := url.Values{}
+	if requestId != "" {
+		params.Set("requestId", requestId)
+	}
+
+	var result chatSpace
+	if err := s.do(ctx, "POST", "v1/spaces", params, space, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (s *chatService) GetSpace(ctx context.Context, name string) (*chatSpace, error) {
+	var result chatSpace
+	if err := s.do(ctx, "GET", "v1/"+name, nil, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (s *chatService) PatchSpace(ctx context.Context, name string, space *chatSpace, updateMask []string) (*chatSpace, error) {
+	params := url.Values{}
+	params.Set("updateMask", strings.Join(updateMask, ","))
+
+	var result chatSpace
+	if err := s.do(ctx, "PATCH", "v1/"+name, params, space, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (s *chatService) DeleteSpace(ctx context.Context, name string) error {
+	return s.do(ctx, "DELETE", "v1/"+name, nil, nil, nil)
+}
+
+func (s *chatService) CreateMembership(ctx context.Context, parent string, membership *chatMembership) (*chatMembership, error) {
+	var result chatMembership
+	if err := s.do(ctx, "POST", "v1/"+parent+"/members", nil, membership, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (s *chatService) GetMembership(ctx context.Context, name string) (*chatMembership, error) {
+	var result chatMembership
+	if err := s.do(ctx, "GET", "v1/"+name, nil, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (s *chatService) PatchMembership(ctx context.Context, name string, membership *chatMembership, updateMask []string) (*chatMembership, error) {
+	params := url.Values{}
+	params.Set("updateMask", strings.Join(updateMask, ","))
+
+	var result chatMembership
+	if err := s.do(ctx, "PATCH", "v1/"+name, params, membership, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (s *chatService) DeleteMembership(ctx context.Context, name string) error {
+	return s.do(ctx, "DELETE", "v1/"+name, nil, nil, nil)
+}
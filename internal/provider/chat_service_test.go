@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestChatService_PatchSpace(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PATCH" || r.URL.Path != "/v1/spaces/AAAA" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if mask := r.URL.Query().Get("updateMask"); mask != "display_name,space_details" {
+			t.Errorf("unexpected update mask %q", mask)
+		}
+
+		var space chatSpace
+		if err := json.NewDecoder(r.Body).Decode(&space); err != nil {
+			t.Fatalf("unable to decode request body: %v", err)
+		}
+		space.Name = "spaces/AAAA"
+		json.NewEncoder(w).Encode(space)
+	}))
+	defer ts.Close()
+
+	service := &chatService{restService{client: ts.Client(), BasePath: ts.URL + "/"}}
+
+	space, err := service.PatchSpace(context.Background(), "spaces/AAAA", &chatSpace{
+		DisplayName:  "Sales",
+		SpaceDetails: &chatSpaceDetails{Description: "Sales team"},
+	}, []string{"display_name", "space_details"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if space.Name != "spaces/AAAA" || space.DisplayName != "Sales" || space.SpaceDetails.Description != "Sales team" {
+		t.Errorf("unexpected space: %#v", space)
+	}
+}
+
+func TestChatService_notFound(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error": {"code": 404, "message": "Space not found", "status": "NOT_FOUND"}}`))
+	}))
+	defer ts.Close()
+
+	service := &chatService{restService{client: ts.Client(), BasePath: ts.URL + "/"}}
+
+	_, err := service.GetSpace(context.Background(), "spaces/AAAA")
+	if !isApiErrorWithCode(err, http.StatusNotFound) {
+		t.Errorf("expected a 404 googleapi error, got %v", err)
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	_ "embed"
+	"encoding/json"
+	"log"
+	"sync"
+
+	"google.golang.org/api/chromepolicy/v1"
+)
+
+// chrome_policy_schemas.json holds the definitions of a handful of commonly managed Chrome policy
+// schemas, maintained by hand, so that their policies are validated at plan time without calling the
+// API. It isn't a complete list: schemas that aren't part of it, and policies that don't pass it, are
+// validated against the API. `go run ./scripts/chromepolicyschemas` replaces it with every chrome.*
+// schema of a customer.
+//
+//go:embed chrome_policy_schemas.json
+var chromePolicySchemasSnapshot []byte
+
+var (
+	chromePolicySchemasOnce sync.Once
+	chromePolicySchemas     map[string]*chromepolicy.GoogleChromePolicyV1PolicySchema
+)
+
+// snapshotChromePolicySchema returns the bundled schema definition for the given schema name
+func snapshotChromePolicySchema(schemaName string) (*chromepolicy.GoogleChromePolicyV1PolicySchema, bool) {
+	chromePolicySchemasOnce.Do(func() {
+		chromePolicySchemas = map[string]*chromepolicy.GoogleChromePolicyV1PolicySchema{}
+
+		var snapshot chromepolicy.GoogleChromePolicyV1ListPolicySchemasResponse
+		if err := json.Unmarshal(chromePolicySchemasSnapshot, &snapshot); err != nil {
+			log.Printf("[WARN] unable to load the Chrome policy schema snapshot: %s", err)
+			return
+		}
+
+		for _, schemaDef := range snapshot.PolicySchemas {
+			chromePolicySchemas[schemaDef.SchemaName] = schemaDef
+		}
+	})
+
+	schemaDef, ok := chromePolicySchemas[schemaName]
+	return schemaDef, ok
+}
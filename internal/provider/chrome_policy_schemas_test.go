@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"testing"
+)
+
+func TestChromePolicySchemasSnapshot(t *testing.T) {
+	t.Parallel()
+
+	schemaDef, ok := snapshotChromePolicySchema("chrome.users.MaxConnectionsPerProxy")
+	if !ok {
+		t.Fatal("expected chrome.users.MaxConnectionsPerProxy to be part of the snapshot")
+	}
+
+	if _, ok := snapshotChromePolicySchema("chrome.users.DoesNotExist"); ok {
+		t.Fatal("expected chrome.users.DoesNotExist not to be part of the snapshot")
+	}
+
+	cases := map[string]struct {
+		values map[string]interface{}
+		err    string
+	}{
+		"valid": {
+			values: map[string]interface{}{"maxConnectionsPerProxy": "33"},
+		},
+		"typo in field name": {
+			values: map[string]interface{}{"maxConnectionPerProxy": "33"},
+			err:    "field name (maxConnectionPerProxy) is not found in this schema definition (chrome.users.MaxConnectionsPerProxy)",
+		},
+		"incorrect type": {
+			values: map[string]interface{}{"maxConnectionsPerProxy": "lots"},
+			err:    "value provided for maxConnectionsPerProxy is of incorrect type (expected type: TYPE_INT64)",
+		},
+	}
+
+	for name, tc := range cases {
+		_, diags := decodeChromePolicySchemaValues(schemaDef.SchemaName, schemaDef, tc.values)
+		if tc.err == "" && diags.HasError() {
+			t.Errorf("%s: unexpected error: %s", name, diags[0].Summary)
+		}
+		if tc.err != "" && (!diags.HasError() || diags[0].Summary != tc.err) {
+			t.Errorf("%s: expected error %q, got %v", name, tc.err, diags)
+		}
+	}
+}
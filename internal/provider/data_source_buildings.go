@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+func dataSourceBuildings() *schema.Resource {
+	dsSchema := map[string]*schema.Schema{
+		"buildings": {
+			Description: "A list of buildings.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Description: "The unique ID of the building.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"name": {
+						Description: "The name of the building.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"description": {
+						Description: "A brief description of the building.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"floor_names": {
+						Description: "The names of the floors of the building, in ascending order.",
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"latitude": {
+						Description: "The latitude of the building, in decimal degrees.",
+						Type:        schema.TypeFloat,
+						Computed:    true,
+					},
+					"longitude": {
+						Description: "The longitude of the building, in decimal degrees.",
+						Type:        schema.TypeFloat,
+						Computed:    true,
+					},
+					"address_lines": {
+						Description: "The unstructured address lines of the building.",
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"locality": {
+						Description: "The locality of the building, e.g. its town or city.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"administrative_area": {
+						Description: "The administrative area of the building, e.g. its state or province.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"postal_code": {
+						Description: "The postal code of the building.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"region_code": {
+						Description: "The CLDR region code of the building's country or region.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"etag": {
+						Description: "ETag of the resource.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"ids_by_name": {
+			Description: "The IDs of the buildings, keyed by building name. If several buildings share a name, " +
+				"the one listed last is used.",
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+	addLimitFieldToSchema(dsSchema, "buildings")
+
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Buildings data source in the Terraform Googleworkspace provider. It returns the customer's " +
+			"buildings, e.g. to look up the ID of a building by its name. Buildings requires the " +
+			"`https://www.googleapis.com/auth/admin.directory.resource.calendar.readonly` or " +
+			"`https://www.googleapis.com/auth/admin.directory.resource.calendar` client scope, which isn't one " +
+			"of the provider's default scopes and needs to be added to `oauth_scopes`.",
+
+		ReadContext: dataSourceBuildingsRead,
+
+		Schema: dsSchema,
+	}
+}
+
+func dataSourceBuildingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	buildingsService, diags := GetBuildingsService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	limit := d.Get("limit").(int)
+
+	var result []*directory.Building
+	err := buildingsService.List(client.Customer).MaxResults(dataSourcePageSize(limit, buildingsMaxResults)).Pages(ctx, func(resp *directory.Buildings) error {
+		for _, building := range resp.Buildings {
+			if limit > 0 && len(result) >= limit {
+				return errDataSourceLimitReached
+			}
+
+			result = append(result, building)
+		}
+
+		return nil
+	})
+
+	if err != nil && !errors.Is(err, errDataSourceLimitReached) {
+		return apiErrorDiagnostics(err)
+	}
+
+	if err := d.Set("buildings", flattenBuildings(result)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	idsByName := map[string]interface{}{}
+	for _, building := range result {
+		idsByName[building.BuildingName] = building.BuildingId
+	}
+	d.Set("ids_by_name", idsByName)
+
+	d.SetId("buildings")
+
+	return diags
+}
+
+func flattenBuildings(buildings []*directory.Building) []interface{} {
+	result := []interface{}{}
+
+	for _, building := range buildings {
+		b := map[string]interface{}{
+			"id":          building.BuildingId,
+			"name":        building.BuildingName,
+			"description": building.Description,
+			"floor_names": building.FloorNames,
+			"etag":        building.Etags,
+		}
+
+		if building.Coordinates != nil {
+			b["latitude"] = building.Coordinates.Latitude
+			b["longitude"] = building.Coordinates.Longitude
+		}
+
+		if building.Address != nil {
+			b["address_lines"] = building.Address.AddressLines
+			b["locality"] = building.Address.Locality
+			b["administrative_area"] = building.Address.AdministrativeArea
+			b["postal_code"] = building.Address.PostalCode
+			b["region_code"] = building.Address.RegionCode
+		}
+
+		result = append(result, b)
+	}
+
+	return result
+}
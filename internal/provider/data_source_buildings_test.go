@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceBuildings(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceBuildings(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.googleworkspace_buildings.all", "buildings.#"),
+					resource.TestCheckResourceAttrSet("data.googleworkspace_buildings.all", "ids_by_name.%"),
+					resource.TestCheckResourceAttr("data.googleworkspace_buildings.one", "buildings.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceBuildings() string {
+	return `
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/admin.directory.resource.calendar.readonly",
+  ]
+}
+
+data "googleworkspace_buildings" "all" {}
+
+data "googleworkspace_buildings" "one" {
+  limit = 1
+}
+`
+}
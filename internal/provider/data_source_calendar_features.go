@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+func dataSourceCalendarFeatures() *schema.Resource {
+	dsSchema := map[string]*schema.Schema{
+		"features": {
+			Description: "A list of calendar resource features.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Description: "The name of the feature, which identifies it.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"etag": {
+						Description: "ETag of the resource.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"names": {
+			Description: "The names of the features, e.g. to check a feature exists before a calendar resource " +
+				"references it.",
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+	addLimitFieldToSchema(dsSchema, "features")
+
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Calendar Features data source in the Terraform Googleworkspace provider. It returns the " +
+			"features calendar resources can have, e.g. a whiteboard or a video conferencing system, including the " +
+			"features created outside of Terraform. Calendar Features requires the " +
+			"`https://www.googleapis.com/auth/admin.directory.resource.calendar.readonly` or " +
+			"`https://www.googleapis.com/auth/admin.directory.resource.calendar` client scope, which isn't one " +
+			"of the provider's default scopes and needs to be added to `oauth_scopes`.",
+
+		ReadContext: dataSourceCalendarFeaturesRead,
+
+		Schema: dsSchema,
+	}
+}
+
+func dataSourceCalendarFeaturesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	featuresService, diags := GetCalendarFeaturesService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	limit := d.Get("limit").(int)
+
+	var result []*directory.Feature
+	err := featuresService.List(client.Customer).MaxResults(dataSourcePageSize(limit, calendarFeaturesMaxResults)).Pages(ctx, func(resp *directory.Features) error {
+		for _, feature := range resp.Features {
+			if limit > 0 && len(result) >= limit {
+				return errDataSourceLimitReached
+			}
+
+			result = append(result, feature)
+		}
+
+		return nil
+	})
+
+	if err != nil && !errors.Is(err, errDataSourceLimitReached) {
+		return apiErrorDiagnostics(err)
+	}
+
+	features := []interface{}{}
+	names := []string{}
+	for _, feature := range result {
+		features = append(features, map[string]interface{}{
+			"name": feature.Name,
+			"etag": feature.Etags,
+		})
+		names = append(names, feature.Name)
+	}
+
+	if err := d.Set("features", features); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("names", names)
+
+	d.SetId("calendar_features")
+
+	return diags
+}
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceCalendarFeatures(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceCalendarFeatures(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.googleworkspace_calendar_features.all", "features.#"),
+					resource.TestCheckResourceAttrPair("data.googleworkspace_calendar_features.all", "features.#",
+						"data.googleworkspace_calendar_features.all", "names.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceCalendarFeatures() string {
+	return `
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/admin.directory.resource.calendar.readonly",
+  ]
+}
+
+data "googleworkspace_calendar_features" "all" {}
+`
+}
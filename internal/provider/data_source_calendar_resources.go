@@ -0,0 +1,258 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+func dataSourceCalendarResources() *schema.Resource {
+	dsSchema := map[string]*schema.Schema{
+		"building_id": {
+			Description: "If set, only the calendar resources in this building are returned.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"floor_name": {
+			Description: "If set, only the calendar resources on this floor are returned. " +
+				"Usually set along with `building_id`.",
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"min_capacity": {
+			Description:      "If set, only the calendar resources with at least this capacity are returned.",
+			Type:             schema.TypeInt,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+		},
+		"resource_category": {
+			Description: "If set, only the calendar resources of this category are returned. Valid values are:" +
+				"\n\t- `CONFERENCE_ROOM`" +
+				"\n\t- `OTHER`" +
+				"\n\t- `CATEGORY_UNKNOWN`",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"CONFERENCE_ROOM", "OTHER", "CATEGORY_UNKNOWN"}, false)),
+		},
+		"query": {
+			Description: "Query string restricting the calendar resources that are returned, combined with the " +
+				"other filters, see https://developers.google.com/admin-sdk/directory/v1/guides/manage-calendar-resources#search_resources.",
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"calendar_resources": {
+			Description: "A list of calendar resources.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Description: "The unique ID of the calendar resource.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"name": {
+						Description: "The name of the calendar resource.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"email": {
+						Description: "The email address of the calendar resource, used to book it.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"generated_resource_name": {
+						Description: "The name of the calendar resource as displayed in Calendar, " +
+							"including its building, floor and capacity.",
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"type": {
+						Description: "The type of the calendar resource, e.g. `Conference Room`.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"resource_category": {
+						Description: "The category of the calendar resource.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"description": {
+						Description: "The description of the calendar resource, only visible to admins.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"user_visible_description": {
+						Description: "The description of the calendar resource that users can see.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"building_id": {
+						Description: "The ID of the building the calendar resource is in.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"floor_name": {
+						Description: "The name of the floor the calendar resource is on.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"floor_section": {
+						Description: "The section of the floor the calendar resource is in.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"capacity": {
+						Description: "The capacity of the calendar resource, e.g. the number of seats in a room.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"feature_names": {
+						Description: "The names of the features of the calendar resource.",
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"etag": {
+						Description: "ETag of the resource.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+	addLimitFieldToSchema(dsSchema, "calendar resources")
+
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Calendar Resources data source in the Terraform Googleworkspace provider. It returns the " +
+			"customer's calendar resources, e.g. its rooms, optionally filtered by building, floor and capacity. " +
+			"Calendar Resources requires the `https://www.googleapis.com/auth/admin.directory.resource.calendar.readonly` " +
+			"or `https://www.googleapis.com/auth/admin.directory.resource.calendar` client scope, which isn't one " +
+			"of the provider's default scopes and needs to be added to `oauth_scopes`.",
+
+		ReadContext: dataSourceCalendarResourcesRead,
+
+		Schema: dsSchema,
+	}
+}
+
+func dataSourceCalendarResourcesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	calendarResourcesService, diags := GetCalendarResourcesService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	limit := d.Get("limit").(int)
+
+	calendarsCall := calendarResourcesService.List(client.Customer).MaxResults(dataSourcePageSize(limit, calendarResourcesMaxResults))
+	if query := calendarResourcesQuery(d); query != "" {
+		calendarsCall = calendarsCall.Query(query)
+	}
+
+	var result []*directory.CalendarResource
+	err := calendarsCall.Pages(ctx, func(resp *directory.CalendarResources) error {
+		for _, calendarResource := range resp.Items {
+			if limit > 0 && len(result) >= limit {
+				return errDataSourceLimitReached
+			}
+
+			result = append(result, calendarResource)
+		}
+
+		return nil
+	})
+
+	if err != nil && !errors.Is(err, errDataSourceLimitReached) {
+		return apiErrorDiagnostics(err)
+	}
+
+	if err := d.Set("calendar_resources", flattenCalendarResources(result)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("calendar_resources")
+
+	return diags
+}
+
+// calendarResourcesQuery combines the filters into a single query, filters are ANDed together
+func calendarResourcesQuery(d *schema.ResourceData) string {
+	var filters []string
+
+	if v, ok := d.GetOk("building_id"); ok {
+		filters = append(filters, fmt.Sprintf("buildingId=%s", v.(string)))
+	}
+
+	if v, ok := d.GetOk("floor_name"); ok {
+		filters = append(filters, fmt.Sprintf("floorName=%q", v.(string)))
+	}
+
+	if v, ok := d.GetOk("min_capacity"); ok {
+		filters = append(filters, fmt.Sprintf("capacity>=%d", v.(int)))
+	}
+
+	if v, ok := d.GetOk("resource_category"); ok {
+		filters = append(filters, fmt.Sprintf("resourceCategory=%s", v.(string)))
+	}
+
+	if v, ok := d.GetOk("query"); ok {
+		filters = append(filters, v.(string))
+	}
+
+	return strings.Join(filters, " AND ")
+}
+
+func flattenCalendarResources(calendarResources []*directory.CalendarResource) []interface{} {
+	result := []interface{}{}
+
+	for _, calendarResource := range calendarResources {
+		featureNames := []string{}
+		if features, ok := calendarResource.FeatureInstances.([]interface{}); ok {
+			for _, f := range features {
+				feature, _ := f.(map[string]interface{})["feature"].(map[string]interface{})
+				if name, ok := feature["name"].(string); ok {
+					featureNames = append(featureNames, name)
+				}
+			}
+		}
+
+		result = append(result, map[string]interface{}{
+			"id":                       calendarResource.ResourceId,
+			"name":                     calendarResource.ResourceName,
+			"email":                    calendarResource.ResourceEmail,
+			"generated_resource_name":  calendarResource.GeneratedResourceName,
+			"type":                     calendarResource.ResourceType,
+			"resource_category":        calendarResource.ResourceCategory,
+			"description":              calendarResource.ResourceDescription,
+			"user_visible_description": calendarResource.UserVisibleDescription,
+			"building_id":              calendarResource.BuildingId,
+			"floor_name":               calendarResource.FloorName,
+			"floor_section":            calendarResource.FloorSection,
+			"capacity":                 calendarResource.Capacity,
+			"feature_names":            featureNames,
+			"etag":                     calendarResource.Etags,
+		})
+	}
+
+	return result
+}
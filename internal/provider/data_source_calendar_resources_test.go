@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceCalendarResources(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceCalendarResources(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.googleworkspace_calendar_resources.all", "calendar_resources.#"),
+					resource.TestCheckResourceAttr("data.googleworkspace_calendar_resources.none", "calendar_resources.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceCalendarResources() string {
+	return `
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/admin.directory.resource.calendar.readonly",
+  ]
+}
+
+data "googleworkspace_calendar_resources" "all" {}
+
+data "googleworkspace_calendar_resources" "none" {
+  min_capacity = 100000
+}
+`
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -116,6 +117,95 @@ func dataSourceChromePolicySchema() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"fields": {
+				Description: "The fields of the schema, including nested fields, combining their description with their " +
+					"definition.",
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field": {
+							Description: "The path of the field, nested fields are separated by dots.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "The description of the field.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"type": {
+							Description: "The type of the field, e.g. `TYPE_STRING` or `TYPE_ENUM`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"repeated": {
+							Description: "Whether the field is a list of values.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"input_constraint": {
+							Description: "Any input constraints associated on the values for the field.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"enum_values": {
+							Description: "The valid values of enum fields.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"known_values": {
+							Description: "The described values of the field.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"value": {
+										Description: "The string representation of the value that can be set for the field.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"description": {
+										Description: "Additional description for this value.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"dependencies": {
+							Description: "The fields and values the field's applicability depends on.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"source_field": {
+										Description: "The source field which this field depends on.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"source_field_value": {
+										Description: "The value which the source field must have for this field to be allowed to be set.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"valid_target_resources": {
+				Description: "The types of targets the policy can be applied to, e.g. `ORG_UNIT` or `GROUP`.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"access_restrictions": {
 				Description: "Specific access restrictions related to this policy.",
 				Type:        schema.TypeList,
@@ -177,7 +267,7 @@ func dataSourceChromePolicySchemaRead(ctx context.Context, d *schema.ResourceDat
 
 	policySchema, err := chromePolicySchemasService.Get(fmt.Sprintf("customers/%s/policySchemas/%s", client.Customer, d.Get("schema_name").(string))).Do()
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	d.SetId(policySchema.SchemaName)
@@ -195,6 +285,13 @@ func dataSourceChromePolicySchemaRead(ctx context.Context, d *schema.ResourceDat
 	fieldDescriptions, _ := json.MarshalIndent(policySchema.FieldDescriptions, "", "  ")
 	d.Set("field_descriptions", string(fieldDescriptions))
 
+	if err := d.Set("fields", flattenChromePolicySchemaFields(policySchema)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("valid_target_resources", policySchema.ValidTargetResources); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := d.Set("access_restrictions", policySchema.AccessRestrictions); err != nil {
 		return diag.FromErr(err)
 	}
@@ -268,3 +365,97 @@ func flattenAdditionalTargetKeyNames(as []*chromepolicy.GoogleChromePolicyV1Addi
 	}
 	return result
 }
+
+// flattenChromePolicySchemaFields flattens the field descriptions of the schema, nested ones
+// included, enriching them with the type of the field and the values of enums from the definition
+func flattenChromePolicySchemaFields(policySchema *chromepolicy.GoogleChromePolicyV1PolicySchema) []interface{} {
+	messageTypes := map[string]*chromepolicy.Proto2DescriptorProto{}
+	enumTypes := map[string]*chromepolicy.Proto2EnumDescriptorProto{}
+
+	schemaFieldMap := map[string]*chromepolicy.Proto2FieldDescriptorProto{}
+	if policySchema.Definition != nil {
+		indexPolicyMessageTypes(policySchema.Definition.MessageType, messageTypes)
+		indexPolicyEnumTypes(policySchema.Definition.EnumType, enumTypes)
+		for _, messageType := range messageTypes {
+			indexPolicyEnumTypes(messageType.EnumType, enumTypes)
+		}
+
+		for _, messageType := range policySchema.Definition.MessageType {
+			for _, field := range messageType.Field {
+				if _, ok := schemaFieldMap[field.Name]; !ok {
+					schemaFieldMap[field.Name] = field
+				}
+			}
+		}
+	}
+
+	return flattenChromePolicySchemaFieldDescriptions(policySchema.FieldDescriptions, "", schemaFieldMap, messageTypes, enumTypes)
+}
+
+func flattenChromePolicySchemaFieldDescriptions(fieldDescriptions []*chromepolicy.GoogleChromePolicyV1PolicySchemaFieldDescription, prefix string,
+	fields map[string]*chromepolicy.Proto2FieldDescriptorProto, messageTypes map[string]*chromepolicy.Proto2DescriptorProto,
+	enumTypes map[string]*chromepolicy.Proto2EnumDescriptorProto) []interface{} {
+	var result []interface{}
+
+	for _, fieldDescription := range fieldDescriptions {
+		obj := map[string]interface{}{
+			"field":            prefix + fieldDescription.Field,
+			"description":      fieldDescription.Description,
+			"input_constraint": fieldDescription.InputConstraint,
+		}
+
+		var knownValues []interface{}
+		for _, knownValue := range fieldDescription.KnownValueDescriptions {
+			knownValues = append(knownValues, map[string]interface{}{
+				"value":       knownValue.Value,
+				"description": knownValue.Description,
+			})
+		}
+		obj["known_values"] = knownValues
+
+		var dependencies []interface{}
+		for _, dependency := range fieldDescription.FieldDependencies {
+			dependencies = append(dependencies, map[string]interface{}{
+				"source_field":       dependency.SourceField,
+				"source_field_value": dependency.SourceFieldValue,
+			})
+		}
+		obj["dependencies"] = dependencies
+
+		nestedFields := map[string]*chromepolicy.Proto2FieldDescriptorProto{}
+		if field, ok := fields[fieldDescription.Field]; ok && field != nil {
+			obj["type"] = field.Type
+			obj["repeated"] = field.Label == "LABEL_REPEATED"
+
+			// type names may be fully qualified, e.g. `.chrome.policy.ManagedBookmarksProto`
+			typeNameParts := strings.Split(field.TypeName, ".")
+			typeName := typeNameParts[len(typeNameParts)-1]
+
+			if enumType, ok := enumTypes[typeName]; ok && field.Type == "TYPE_ENUM" {
+				var enumValues []interface{}
+				for _, value := range enumType.Value {
+					enumValues = append(enumValues, value.Name)
+				}
+				obj["enum_values"] = enumValues
+			}
+
+			if messageType, ok := messageTypes[typeName]; ok && field.Type == "TYPE_MESSAGE" {
+				for _, nestedField := range messageType.Field {
+					nestedFields[nestedField.Name] = nestedField
+				}
+			}
+		}
+
+		result = append(result, obj)
+		result = append(result, flattenChromePolicySchemaFieldDescriptions(fieldDescription.NestedFieldDescriptions,
+			prefix+fieldDescription.Field+".", nestedFields, messageTypes, enumTypes)...)
+	}
+
+	return result
+}
+
+func indexPolicyEnumTypes(enumTypes []*chromepolicy.Proto2EnumDescriptorProto, result map[string]*chromepolicy.Proto2EnumDescriptorProto) {
+	for _, enumType := range enumTypes {
+		result[enumType.Name] = enumType
+	}
+}
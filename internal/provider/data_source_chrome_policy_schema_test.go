@@ -26,6 +26,12 @@ func TestAccDataSourceChromePolicySchema(t *testing.T) {
 					resource.TestCheckResourceAttr("data.googleworkspace_chrome_policy_schema.test", "policy_description", "Allows a printer for users in a given organization."),
 					resource.TestCheckResourceAttr("data.googleworkspace_chrome_policy_schema.test", "additional_target_key_names.#", "1"),
 					resource.TestCheckResourceAttr("data.googleworkspace_chrome_policy_schema.test", "additional_target_key_names.0.key", "printer_id"),
+					resource.TestCheckResourceAttr("data.googleworkspace_chrome_policy_schema.test", "valid_target_resources.0", "ORG_UNIT"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.googleworkspace_chrome_policy_schema.test", "fields.*", map[string]string{
+						"field":    "allowForUsers",
+						"type":     "TYPE_BOOL",
+						"repeated": "false",
+					}),
 				),
 			},
 		},
@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/api/chromepolicy/v1"
+)
+
+func dataSourceChromeResolvedPolicies() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Chrome Resolved Policies data source in the Terraform Googleworkspace provider. It returns the " +
+			"effective values of the policies applied to an org unit or group, including the ones inherited from " +
+			"parent org units, along with the target they're set on. Chrome Resolved Policies resides under the " +
+			"`https://www.googleapis.com/auth/chrome.management.policy` client scope.",
+
+		ReadContext: dataSourceChromeResolvedPoliciesRead,
+
+		Schema: map[string]*schema.Schema{
+			"org_unit_id": {
+				Description:  "The ID of the org unit the policies apply to, with or without the `id:` prefix.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"org_unit_id", "group_id"},
+			},
+			"group_id": {
+				Description:  "The ID of the group the policies apply to.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"org_unit_id", "group_id"},
+			},
+			"additional_target_keys": {
+				Description: "Additional keys identifying the target of the policies, e.g. `app_id` for app policies. " +
+					"The keys required by a schema are listed in the `additional_target_key_names` of the " +
+					"`googleworkspace_chrome_policy_schema` data source.",
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"schema_filter": {
+				Description: "The schema filter of the policies to resolve, either a full schema name such as " +
+					"`chrome.users.MaxConnectionsPerProxy` or a namespace wildcard such as `chrome.users.*`.",
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"policies": {
+				Description: "The resolved policies.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"schema_name": {
+							Description: "The full qualified name of the policy schema.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"schema_values": {
+							Description: "JSON encoded map of the effective values of the policy fields, as returned by the API.",
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"source_target_resource": {
+							Description: "The target the policy value is set on, e.g. `orgunits/{org_unit_id}`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"inherited": {
+							Description: "Whether the value is inherited from another target rather than set on the requested one.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceChromeResolvedPoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	chromePolicyService, diags := client.NewChromePolicyService()
+	if diags.HasError() {
+		return diags
+	}
+
+	chromePoliciesService, diags := GetChromePoliciesService(chromePolicyService)
+	if diags.HasError() {
+		return diags
+	}
+
+	targetResource := "groups/" + d.Get("group_id").(string)
+	if v, ok := d.GetOk("org_unit_id"); ok {
+		targetResource = "orgunits/" + strings.TrimPrefix(v.(string), "id:")
+	}
+
+	additionalTargetKeys := map[string]string{}
+	for k, v := range d.Get("additional_target_keys").(map[string]interface{}) {
+		additionalTargetKeys[k] = v.(string)
+	}
+
+	policyTargetKey := &chromepolicy.GoogleChromePolicyV1PolicyTargetKey{
+		TargetResource:       targetResource,
+		AdditionalTargetKeys: additionalTargetKeys,
+	}
+
+	schemaFilter := d.Get("schema_filter").(string)
+	log.Printf("[DEBUG] Resolving Chrome Policies %s for %s", schemaFilter, targetResource)
+
+	var policies []interface{}
+	var pageToken string
+	for {
+		var resp *chromepolicy.GoogleChromePolicyV1ResolveResponse
+		err := retryTimeDuration(ctx, time.Minute, func() error {
+			var retryErr error
+
+			resp, retryErr = chromePoliciesService.Resolve(fmt.Sprintf("customers/%s", client.Customer), &chromepolicy.GoogleChromePolicyV1ResolveRequest{
+				PolicySchemaFilter: schemaFilter,
+				PolicyTargetKey:    policyTargetKey,
+				PageToken:          pageToken,
+			}).Do()
+
+			return retryErr
+		})
+		if err != nil {
+			return apiErrorDiagnostics(err)
+		}
+
+		for _, resolvedPolicy := range resp.ResolvedPolicies {
+			policy, err := flattenChromeResolvedPolicy(resolvedPolicy, targetResource)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			policies = append(policies, policy)
+		}
+
+		pageToken = resp.NextPageToken
+		if pageToken == "" {
+			break
+		}
+	}
+
+	if err := d.Set("policies", policies); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", targetResource, schemaFilter))
+
+	log.Printf("[DEBUG] Finished resolving Chrome Policies %s for %s", schemaFilter, targetResource)
+
+	return diags
+}
+
+func flattenChromeResolvedPolicy(resolvedPolicy *chromepolicy.GoogleChromePolicyV1ResolvedPolicy, targetResource string) (map[string]interface{}, error) {
+	result := map[string]interface{}{}
+
+	if resolvedPolicy.SourceKey != nil {
+		result["source_target_resource"] = resolvedPolicy.SourceKey.TargetResource
+		result["inherited"] = resolvedPolicy.SourceKey.TargetResource != targetResource
+	}
+
+	if resolvedPolicy.Value == nil {
+		return result, nil
+	}
+
+	result["schema_name"] = resolvedPolicy.Value.PolicySchema
+
+	var values map[string]json.RawMessage
+	if len(resolvedPolicy.Value.Value) > 0 {
+		if err := json.Unmarshal(resolvedPolicy.Value.Value, &values); err != nil {
+			return nil, err
+		}
+	}
+
+	schemaValues := map[string]interface{}{}
+	for k, v := range values {
+		schemaValues[k] = string(v)
+	}
+	result["schema_values"] = schemaValues
+
+	return result, nil
+}
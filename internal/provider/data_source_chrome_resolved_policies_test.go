@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceChromeResolvedPolicies(t *testing.T) {
+	t.Parallel()
+
+	ouName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceChromeResolvedPolicies(ouName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.googleworkspace_chrome_resolved_policies.parent", "policies.#", "1"),
+					resource.TestCheckResourceAttr("data.googleworkspace_chrome_resolved_policies.parent", "policies.0.schema_name", "chrome.users.MaxConnectionsPerProxy"),
+					resource.TestCheckResourceAttr("data.googleworkspace_chrome_resolved_policies.parent", "policies.0.schema_values.maxConnectionsPerProxy", "33"),
+					resource.TestCheckResourceAttr("data.googleworkspace_chrome_resolved_policies.parent", "policies.0.inherited", "false"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.googleworkspace_chrome_resolved_policies.child", "policies.*", map[string]string{
+						"schema_name":                          "chrome.users.MaxConnectionsPerProxy",
+						"schema_values.maxConnectionsPerProxy": "33",
+						"inherited":                            "true",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceChromeResolvedPolicies(ouName string) string {
+	return fmt.Sprintf(`
+resource "googleworkspace_org_unit" "parent" {
+  name = "%[1]s"
+  parent_org_unit_path = "/"
+}
+
+resource "googleworkspace_org_unit" "child" {
+  name = "%[1]s-child"
+  parent_org_unit_path = googleworkspace_org_unit.parent.org_unit_path
+}
+
+resource "googleworkspace_chrome_policy" "test" {
+  org_unit_id = googleworkspace_org_unit.parent.id
+  policies {
+    schema_name = "chrome.users.MaxConnectionsPerProxy"
+    schema_values = {
+      maxConnectionsPerProxy = jsonencode(33)
+    }
+  }
+}
+
+data "googleworkspace_chrome_resolved_policies" "parent" {
+  org_unit_id   = googleworkspace_chrome_policy.test.org_unit_id
+  schema_filter = "chrome.users.MaxConnectionsPerProxy"
+}
+
+data "googleworkspace_chrome_resolved_policies" "child" {
+  org_unit_id   = googleworkspace_org_unit.child.id
+  schema_filter = "chrome.users.*"
+
+  depends_on = [googleworkspace_chrome_policy.test]
+}
+`, ouName)
+}
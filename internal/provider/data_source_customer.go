@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCustomer() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Customer data source in the Terraform Googleworkspace provider. It resolves the provider's " +
+			"`customer_id`, which can be the `my_customer` alias, to the customer's actual ID and primary domain. " +
+			"Customer resides under the `https://www.googleapis.com/auth/admin.directory.customer` client scope.",
+
+		ReadContext: dataSourceCustomerRead,
+
+		Schema: map[string]*schema.Schema{
+			"customer_id": {
+				Description: "The unique ID of the customer, e.g. `C01234abc`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"customer_domain": {
+				Description: "The customer's primary domain name.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"alternate_email": {
+				Description: "The customer's secondary contact email address.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"language": {
+				Description: "The customer's ISO 639-2 language code.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"creation_time": {
+				Description: "The customer's creation time.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"etag": {
+				Description: "ETag of the resource.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceCustomerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	customersService, diags := GetCustomersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Getting Customer %q", client.Customer)
+
+	customer, err := customersService.Get(client.Customer).Do()
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	d.Set("customer_id", customer.Id)
+	d.Set("customer_domain", customer.CustomerDomain)
+	d.Set("alternate_email", customer.AlternateEmail)
+	d.Set("language", customer.Language)
+	d.Set("creation_time", customer.CustomerCreationTime)
+	d.Set("etag", customer.Etag)
+	d.SetId(customer.Id)
+
+	log.Printf("[DEBUG] Finished getting Customer %q", d.Id())
+
+	return diags
+}
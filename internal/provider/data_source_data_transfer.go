@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDataTransfer() *schema.Resource {
+	// Generate datasource schema from resource
+	dsSchema := datasourceSchemaFromResourceSchema(resourceDataTransfer().Schema)
+	dsSchema["transfer_id"] = &schema.Schema{
+		Description: "The ID of the transfer.",
+		Type:        schema.TypeString,
+	}
+	addRequiredFieldsToSchema(dsSchema, "transfer_id")
+	delete(dsSchema, "wait_for_completion")
+
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Data Transfer data source in the Terraform Googleworkspace provider. It returns the status " +
+			"of a transfer, overall and of each application, including transfers requested outside of Terraform. " +
+			"Data Transfer requires the `https://www.googleapis.com/auth/admin.datatransfer.readonly` or " +
+			"`https://www.googleapis.com/auth/admin.datatransfer` client scope, which isn't one of the provider's " +
+			"default scopes and needs to be added to `oauth_scopes`.",
+
+		ReadContext: dataSourceDataTransferRead,
+
+		Schema: dsSchema,
+	}
+}
+
+func dataSourceDataTransferRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	dataTransferService, diags := client.NewDataTransferService()
+	if diags.HasError() {
+		return diags
+	}
+
+	transfersService, diags := GetDataTransferTransfersService(dataTransferService)
+	if diags.HasError() {
+		return diags
+	}
+
+	transferId := d.Get("transfer_id").(string)
+	log.Printf("[DEBUG] Getting Data Transfer %q", transferId)
+
+	transfer, err := transfersService.Get(transferId).Do()
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	setDataTransfer(d, transfer)
+
+	log.Printf("[DEBUG] Finished getting Data Transfer %q", transferId)
+
+	return diags
+}
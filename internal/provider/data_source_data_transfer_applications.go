@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	datatransfer "google.golang.org/api/admin/datatransfer/v1"
+)
+
+func dataSourceDataTransferApplications() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Data Transfer Applications data source in the Terraform Googleworkspace provider. It returns " +
+			"the applications whose data can be transferred from one user to another, e.g. Drive and Docs or " +
+			"Calendar, along with the parameters their transfers accept. Data Transfer Applications requires the " +
+			"`https://www.googleapis.com/auth/admin.datatransfer.readonly` or " +
+			"`https://www.googleapis.com/auth/admin.datatransfer` client scope, which isn't one of the provider's " +
+			"default scopes and needs to be added to `oauth_scopes`.",
+
+		ReadContext: dataSourceDataTransferApplicationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"applications": {
+				Description: "The applications whose data can be transferred.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of the application.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the application, e.g. `Drive and Docs`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"transfer_params": {
+							Description: "The parameters the application's transfers accept, with their possible values.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Description: "The key of the parameter, e.g. `PRIVACY_LEVEL`.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"values": {
+										Description: "The values of the parameter, e.g. `PRIVATE` and `SHARED`.",
+										Type:        schema.TypeList,
+										Computed:    true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+						"etag": {
+							Description: "ETag of the resource.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"ids_by_name": {
+				Description: "The IDs of the applications, keyed by application name.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDataTransferApplicationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	customersService, diags := GetCustomersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	dataTransferService, diags := client.NewDataTransferService()
+	if diags.HasError() {
+		return diags
+	}
+
+	applicationsService, diags := GetDataTransferApplicationsService(dataTransferService)
+	if diags.HasError() {
+		return diags
+	}
+
+	// the Data Transfer API doesn't accept the `my_customer` alias
+	customer, err := customersService.Get(client.Customer).Fields("id").Do()
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	log.Printf("[DEBUG] Getting Data Transfer Applications of customer %q", customer.Id)
+
+	applications := []interface{}{}
+	idsByName := map[string]interface{}{}
+	err = applicationsService.List().CustomerId(customer.Id).Pages(ctx, func(resp *datatransfer.ApplicationsListResponse) error {
+		for _, application := range resp.Applications {
+			id := strconv.FormatInt(application.Id, 10)
+
+			var transferParams []interface{}
+			for _, param := range application.TransferParams {
+				transferParams = append(transferParams, map[string]interface{}{
+					"key":    param.Key,
+					"values": param.Value,
+				})
+			}
+
+			applications = append(applications, map[string]interface{}{
+				"id":              id,
+				"name":            application.Name,
+				"transfer_params": transferParams,
+				"etag":            application.Etag,
+			})
+			idsByName[application.Name] = id
+		}
+
+		return nil
+	})
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if err := d.Set("applications", applications); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("ids_by_name", idsByName)
+
+	d.SetId(customer.Id)
+
+	log.Printf("[DEBUG] Finished getting Data Transfer Applications of customer %q", customer.Id)
+
+	return diags
+}
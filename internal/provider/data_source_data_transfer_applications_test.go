@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDataTransferApplications(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDataTransferApplications(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.googleworkspace_data_transfer_applications.test", "applications.*", map[string]string{
+						"name": "Drive and Docs",
+					}),
+					resource.TestCheckResourceAttrSet("data.googleworkspace_data_transfer_applications.test", "ids_by_name.Drive and Docs"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceDataTransferApplications() string {
+	return `
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/admin.directory.customer",
+    "https://www.googleapis.com/auth/admin.datatransfer.readonly",
+  ]
+}
+
+data "googleworkspace_data_transfer_applications" "test" {}
+`
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDataTransfer(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testUserVals := map[string]interface{}{
+		"domainName": domainName,
+		"oldOwner":   fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"newOwner":   fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":   acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDataTransfer(testUserVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.googleworkspace_data_transfer.test", "id", "googleworkspace_data_transfer.test", "id"),
+					resource.TestCheckResourceAttrPair("data.googleworkspace_data_transfer.test", "old_owner_user_id", "googleworkspace_user.old_owner", "id"),
+					resource.TestCheckResourceAttr("data.googleworkspace_data_transfer.test", "overall_transfer_status_code", "completed"),
+					resource.TestCheckResourceAttr("data.googleworkspace_data_transfer.test", "application_data_transfers.0.application_transfer_status", "completed"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceDataTransfer(testUserVals map[string]interface{}) string {
+	return fmt.Sprintf(`
+%s
+
+data "googleworkspace_data_transfer" "test" {
+  transfer_id = googleworkspace_data_transfer.test.id
+}
+`, testAccResourceDataTransfer_basic(testUserVals))
+}
@@ -0,0 +1,268 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"google.golang.org/api/cloudidentity/v1"
+)
+
+func dataSourceDevices() *schema.Resource {
+	dsSchema := map[string]*schema.Schema{
+		"view": {
+			Description: "The devices that are listed. Valid values are:" +
+				"\n\t- `USER_ASSIGNED_DEVICES`: devices with at least one user registered on them" +
+				"\n\t- `COMPANY_INVENTORY`: devices imported by the admin, including unassigned ones",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "USER_ASSIGNED_DEVICES",
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"USER_ASSIGNED_DEVICES", "COMPANY_INVENTORY"}, false)),
+		},
+		"device_type": {
+			Description: "If set, only the devices of this type are returned. Valid values are:" +
+				"\n\t- `ANDROID`" +
+				"\n\t- `IOS`" +
+				"\n\t- `GOOGLE_SYNC`" +
+				"\n\t- `WINDOWS`" +
+				"\n\t- `MAC_OS`" +
+				"\n\t- `LINUX`" +
+				"\n\t- `CHROME_OS`",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"ANDROID", "IOS", "GOOGLE_SYNC", "WINDOWS", "MAC_OS", "LINUX", "CHROME_OS"}, false)),
+		},
+		"owner_type": {
+			Description: "If set, only the devices with this ownership are returned. Valid values are:" +
+				"\n\t- `COMPANY`" +
+				"\n\t- `BYOD`",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"COMPANY", "BYOD"}, false)),
+		},
+		"encryption_state": {
+			Description: "If set, only the devices with this encryption state are returned. Valid values are:" +
+				"\n\t- `ENCRYPTED`" +
+				"\n\t- `NOT_ENCRYPTED`" +
+				"\n\t- `UNSUPPORTED_BY_DEVICE`",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"ENCRYPTED", "NOT_ENCRYPTED", "UNSUPPORTED_BY_DEVICE"}, false)),
+		},
+		"compromised_state": {
+			Description: "If set, only the devices with this compromised state are returned. Valid values are:" +
+				"\n\t- `COMPROMISED`" +
+				"\n\t- `UNCOMPROMISED`",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"COMPROMISED", "UNCOMPROMISED"}, false)),
+		},
+		"query": {
+			Description: "Query string restricting the devices that are returned, combined with the other " +
+				"filters, see https://support.google.com/a/answer/7549103.",
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"devices": {
+			Description: "A list of devices.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Description: "The resource name of the device, in the format `devices/{device}`.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"device_id": {
+						Description: "The unique identifier of the device, reported by the device itself.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"device_type": {
+						Description: "The type of the device.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"owner_type": {
+						Description: "Whether the device is owned by the company or by a user, `COMPANY` or `BYOD`.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"encryption_state": {
+						Description: "The encryption state of the device.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"compromised_state": {
+						Description: "Whether the device is compromised, e.g. rooted or jailbroken.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"management_state": {
+						Description: "The management state of the device, e.g. `APPROVED` or `BLOCKED`.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"serial_number": {
+						Description: "The serial number of the device.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"asset_tag": {
+						Description: "The asset tag of the device, set by the admin.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"brand": {
+						Description: "The brand of the device.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"manufacturer": {
+						Description: "The manufacturer of the device.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"model": {
+						Description: "The model of the device.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"os_version": {
+						Description: "The version of the device's operating system.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"create_time": {
+						Description: "The time the device was first registered, in RFC 3339 format.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"last_sync_time": {
+						Description: "The time the device last synced with the policy settings, in RFC 3339 format.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+	addLimitFieldToSchema(dsSchema, "devices")
+
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Devices data source in the Terraform Googleworkspace provider. It returns the customer's " +
+			"mobile and endpoint devices managed by Cloud Identity, optionally filtered by type, ownership, " +
+			"encryption state and compromised state. Devices requires the " +
+			"`https://www.googleapis.com/auth/cloud-identity.devices.readonly` or " +
+			"`https://www.googleapis.com/auth/cloud-identity.devices` client scope, which isn't one of the " +
+			"provider's default scopes and needs to be added to `oauth_scopes`.",
+
+		ReadContext: dataSourceDevicesRead,
+
+		Schema: dsSchema,
+	}
+}
+
+func dataSourceDevicesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	cloudIdentityService, diags := client.NewCloudIdentityService()
+	if diags.HasError() {
+		return diags
+	}
+
+	devicesService, diags := GetCloudIdentityDevicesService(cloudIdentityService)
+	if diags.HasError() {
+		return diags
+	}
+
+	limit := d.Get("limit").(int)
+
+	// the filters other than query can't be expressed in the search syntax, so they're applied to each page
+	devicesCall := devicesService.List().Customer(fmt.Sprintf("customers/%s", client.Customer)).
+		View(d.Get("view").(string)).PageSize(dataSourcePageSize(limit, devicesMaxResults))
+	if v, ok := d.GetOk("query"); ok {
+		devicesCall = devicesCall.Filter(v.(string))
+	}
+
+	var result []*cloudidentity.GoogleAppsCloudidentityDevicesV1Device
+	err := devicesCall.Pages(ctx, func(resp *cloudidentity.GoogleAppsCloudidentityDevicesV1ListDevicesResponse) error {
+		for _, device := range resp.Devices {
+			if !deviceMatchesFilters(d, device) {
+				continue
+			}
+
+			if limit > 0 && len(result) >= limit {
+				return errDataSourceLimitReached
+			}
+
+			result = append(result, device)
+		}
+
+		return nil
+	})
+
+	if err != nil && !errors.Is(err, errDataSourceLimitReached) {
+		return apiErrorDiagnostics(err)
+	}
+
+	if err := d.Set("devices", flattenDevices(result)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strings.ToLower(d.Get("view").(string)))
+
+	return diags
+}
+
+func deviceMatchesFilters(d *schema.ResourceData, device *cloudidentity.GoogleAppsCloudidentityDevicesV1Device) bool {
+	filters := map[string]string{
+		"device_type":       device.DeviceType,
+		"owner_type":        device.OwnerType,
+		"encryption_state":  device.EncryptionState,
+		"compromised_state": device.CompromisedState,
+	}
+
+	for key, value := range filters {
+		if v, ok := d.GetOk(key); ok && v.(string) != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+func flattenDevices(devices []*cloudidentity.GoogleAppsCloudidentityDevicesV1Device) []interface{} {
+	result := []interface{}{}
+
+	for _, device := range devices {
+		result = append(result, map[string]interface{}{
+			"name":              device.Name,
+			"device_id":         device.DeviceId,
+			"device_type":       device.DeviceType,
+			"owner_type":        device.OwnerType,
+			"encryption_state":  device.EncryptionState,
+			"compromised_state": device.CompromisedState,
+			"management_state":  device.ManagementState,
+			"serial_number":     device.SerialNumber,
+			"asset_tag":         device.AssetTag,
+			"brand":             device.Brand,
+			"manufacturer":      device.Manufacturer,
+			"model":             device.Model,
+			"os_version":        device.OsVersion,
+			"create_time":       device.CreateTime,
+			"last_sync_time":    device.LastSyncTime,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDevices(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDevices(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.googleworkspace_devices.all", "devices.#"),
+					resource.TestCheckResourceAttrSet("data.googleworkspace_devices.company", "devices.#"),
+					resource.TestCheckResourceAttr("data.googleworkspace_devices.company", "id", "company_inventory"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceDevices() string {
+	return `
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/cloud-identity.devices.readonly",
+  ]
+}
+
+data "googleworkspace_devices" "all" {}
+
+data "googleworkspace_devices" "company" {
+  view       = "COMPANY_INVENTORY"
+  owner_type = "COMPANY"
+  limit      = 10
+}
+`
+}
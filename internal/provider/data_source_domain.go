@@ -13,6 +13,7 @@ import (
 func dataSourceDomain() *schema.Resource {
 	dsSchema := datasourceSchemaFromResourceSchema(resourceDomain().Schema)
 	addRequiredFieldsToSchema(dsSchema, "domain_name")
+	delete(dsSchema, "make_primary")
 
 	return &schema.Resource{
 		// This description is used by the documentation generator and the language server.
@@ -27,5 +28,5 @@ func dataSourceDomain() *schema.Resource {
 
 func dataSourceDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	d.SetId(d.Get("domain_name").(string))
-	return resourceDomainRead(ctx, d, meta)
+	return withoutRemovedFromStateWarning(resourceDomainRead(ctx, d, meta))
 }
@@ -27,5 +27,5 @@ func dataSourceDomainAlias() *schema.Resource {
 
 func dataSourceDomainAliasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	d.SetId(d.Get("domain_alias_name").(string))
-	return resourceDomainAliasRead(ctx, d, meta)
+	return withoutRemovedFromStateWarning(resourceDomainAliasRead(ctx, d, meta))
 }
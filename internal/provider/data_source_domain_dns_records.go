@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"google.golang.org/api/siteverification/v1"
+)
+
+const (
+	domainVerificationMethodTxt   = "DNS_TXT"
+	domainVerificationMethodCname = "DNS_CNAME"
+)
+
+// The records Google documents for setting up Gmail and SPF on a domain
+var domainMxRecords = []map[string]interface{}{
+	{"type": "MX", "name": "@", "priority": 1, "value": "smtp.google.com."},
+}
+
+var domainSpfRecord = map[string]interface{}{
+	"type": "TXT", "name": "@", "priority": 0, "value": "v=spf1 include:_spf.google.com ~all",
+}
+
+func dataSourceDomainDnsRecords() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Domain DNS records data source in the Terraform Googleworkspace provider. Exposes the DNS " +
+			"records Google Workspace expects for a domain or domain alias, so they can be managed with a DNS provider. " +
+			"Domain resides under the `https://www.googleapis.com/auth/admin.directory.domain` client scope, the " +
+			"verification token requires the `https://www.googleapis.com/auth/siteverification` client scope.",
+
+		ReadContext: dataSourceDomainDnsRecordsRead,
+
+		Schema: map[string]*schema.Schema{
+			"domain_name": {
+				Description: "The domain or domain alias name.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"verification_method": {
+				Description: "If set, the domain verification record for this method is included in `records`. " +
+					"Valid values are `DNS_TXT` and `DNS_CNAME`.",
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+					domainVerificationMethodTxt, domainVerificationMethodCname}, false)),
+			},
+			"verified": {
+				Description: "Indicates the verification state of the domain or domain alias.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"verification_token": {
+				Description: "The verification token returned by the Site Verification API, " +
+					"only set if `verification_method` is set.",
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"records": {
+				Description: "The DNS records to create for the domain.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Description: "The record type, one of `MX`, `TXT` or `CNAME`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The record name relative to the domain, `@` for the domain itself.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"priority": {
+							Description: "The priority of `MX` records, `0` for other record types.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"value": {
+							Description: "The record value.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDomainDnsRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	domainsService, diags := GetDomainsService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	domainName := d.Get("domain_name").(string)
+
+	log.Printf("[DEBUG] Getting DNS records for domain %q", domainName)
+
+	var verified bool
+	domain, err := domainsService.Get(client.Customer, domainName).Do()
+	if err != nil {
+		if !isNotFound(err) {
+			return apiErrorDiagnostics(err)
+		}
+
+		// not a domain, look it up as a domain alias
+		domainAliasesService, diags := GetDomainAliasesService(directoryService)
+		if diags.HasError() {
+			return diags
+		}
+
+		domainAlias, err := domainAliasesService.Get(client.Customer, domainName).Do()
+		if err != nil {
+			if isNotFound(err) {
+				return diag.Errorf("no domain or domain alias was found for %s", domainName)
+			}
+
+			return apiErrorDiagnostics(err)
+		}
+
+		verified = domainAlias.Verified
+	} else {
+		verified = domain.Verified
+	}
+
+	records := append([]map[string]interface{}{}, domainMxRecords...)
+	records = append(records, domainSpfRecord)
+
+	verificationToken := ""
+	if method, ok := d.GetOk("verification_method"); ok {
+		siteVerificationService, diags := client.NewSiteVerificationService()
+		if diags.HasError() {
+			return diags
+		}
+
+		webResourceService, diags := GetSiteVerificationWebResourceService(siteVerificationService)
+		if diags.HasError() {
+			return diags
+		}
+
+		resp, err := webResourceService.GetToken(&siteverification.SiteVerificationWebResourceGettokenRequest{
+			Site: &siteverification.SiteVerificationWebResourceGettokenRequestSite{
+				Type:       "INET_DOMAIN",
+				Identifier: domainName,
+			},
+			VerificationMethod: method.(string),
+		}).Do()
+		if err != nil {
+			return apiErrorDiagnostics(err)
+		}
+
+		verificationToken = resp.Token
+
+		record, err := flattenDomainVerificationRecord(method.(string), resp.Token)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		records = append(records, record)
+	}
+
+	d.SetId(domainName)
+	d.Set("verified", verified)
+	d.Set("verification_token", verificationToken)
+	if err := d.Set("records", records); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Finished getting DNS records for domain %q", domainName)
+
+	return nil
+}
+
+// DNS_TXT tokens are the record value, DNS_CNAME tokens are the record name and its target separated by a space
+func flattenDomainVerificationRecord(method, token string) (map[string]interface{}, error) {
+	if method == domainVerificationMethodTxt {
+		return map[string]interface{}{"type": "TXT", "name": "@", "priority": 0, "value": token}, nil
+	}
+
+	parts := strings.Fields(token)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("unexpected %s verification token: %q", method, token)
+	}
+
+	return map[string]interface{}{"type": "CNAME", "name": parts[0], "priority": 0, "value": parts[1]}, nil
+}
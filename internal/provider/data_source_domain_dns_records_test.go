@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDomainDnsRecords(t *testing.T) {
+	domainName := fmt.Sprintf("tf-test-%s.com", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDomainDnsRecords(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.googleworkspace_domain_dns_records.my-domain", "verified", "false"),
+					resource.TestCheckResourceAttr("data.googleworkspace_domain_dns_records.my-domain", "records.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.googleworkspace_domain_dns_records.my-domain", "records.*", map[string]string{
+						"type":     "MX",
+						"name":     "@",
+						"priority": "1",
+						"value":    "smtp.google.com.",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestDomainVerificationRecord(t *testing.T) {
+	record, err := flattenDomainVerificationRecord(domainVerificationMethodTxt, "google-site-verification=abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record["type"] != "TXT" || record["name"] != "@" || record["value"] != "google-site-verification=abc" {
+		t.Errorf("unexpected TXT record: %v", record)
+	}
+
+	record, err = flattenDomainVerificationRecord(domainVerificationMethodCname, "abc123 gv-xyz.dv.googlehosted.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record["type"] != "CNAME" || record["name"] != "abc123" || record["value"] != "gv-xyz.dv.googlehosted.com" {
+		t.Errorf("unexpected CNAME record: %v", record)
+	}
+
+	if _, err := flattenDomainVerificationRecord(domainVerificationMethodCname, "abc123"); err == nil {
+		t.Error("expected an error for a malformed CNAME token")
+	}
+}
+
+func testAccDataSourceDomainDnsRecords(domainName string) string {
+	return fmt.Sprintf(`
+resource "googleworkspace_domain" "my-domain" {
+  domain_name = "%s"
+}
+
+data "googleworkspace_domain_dns_records" "my-domain" {
+  domain_name = googleworkspace_domain.my-domain.domain_name
+}
+`, domainName)
+}
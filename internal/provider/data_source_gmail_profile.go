@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGmailProfile() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Gmail Profile data source in the Terraform Googleworkspace provider. It returns the mailbox " +
+			"profile of a user, e.g. to verify a newly created user's mailbox has been provisioned. Please ensure " +
+			"the Gmail API is enabled for your workspace and that the user has a Gmail license. Gmail Profile " +
+			"requires the `https://www.googleapis.com/auth/gmail.metadata` client scope, which isn't one of the " +
+			"provider's default scopes and needs to be added to `oauth_scopes`.",
+
+		ReadContext: dataSourceGmailProfileRead,
+
+		Schema: map[string]*schema.Schema{
+			"primary_email": {
+				Description: "User's primary email address.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"email_address": {
+				Description: "The user's email address, as returned by Gmail.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"messages_total": {
+				Description: "The total number of messages in the mailbox.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"threads_total": {
+				Description: "The total number of threads in the mailbox.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"history_id": {
+				Description: "The ID of the mailbox's current history record.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceGmailProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	primaryEmail := d.Get("primary_email").(string)
+	gmailService, diags := client.NewGmailService(ctx, primaryEmail)
+	if diags.HasError() {
+		return diags
+	}
+
+	usersService, diags := GetGmailUsersService(gmailService)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Getting Gmail Profile of %q", primaryEmail)
+
+	profile, err := usersService.GetProfile("me").Context(ctx).Do()
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	d.Set("email_address", profile.EmailAddress)
+	d.Set("messages_total", profile.MessagesTotal)
+	d.Set("threads_total", profile.ThreadsTotal)
+	d.Set("history_id", strconv.FormatUint(profile.HistoryId, 10))
+
+	d.SetId(primaryEmail)
+
+	log.Printf("[DEBUG] Finished getting Gmail Profile of %q", primaryEmail)
+
+	return diags
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGmailProfile(t *testing.T) {
+	gmailUser := os.Getenv("GOOGLEWORKSPACE_TEST_GMAIL_USER")
+
+	if gmailUser == "" {
+		t.Skip("GOOGLEWORKSPACE_TEST_GMAIL_USER needs to be set to run this test")
+	}
+
+	data := map[string]interface{}{
+		"gmailUser": gmailUser,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGmailProfile(data),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.googleworkspace_gmail_profile.test", "email_address", gmailUser),
+					resource.TestCheckResourceAttrSet("data.googleworkspace_gmail_profile.test", "messages_total"),
+					resource.TestCheckResourceAttrSet("data.googleworkspace_gmail_profile.test", "threads_total"),
+					resource.TestCheckResourceAttrSet("data.googleworkspace_gmail_profile.test", "history_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGmailProfile(data map[string]interface{}) string {
+	return Nprintf(`
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/gmail.metadata",
+  ]
+}
+
+data "googleworkspace_gmail_profile" "test" {
+  primary_email = "%{gmailUser}"
+}
+`, data)
+}
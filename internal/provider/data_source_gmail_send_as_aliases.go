@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/api/gmail/v1"
+)
+
+func dataSourceGmailSendAsAliases() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Gmail Send As Aliases data source in the Terraform Googleworkspace provider. It returns all " +
+			"send-as aliases of a user, including the user's primary address. Please ensure the Gmail API is " +
+			"enabled for your workspace and that the user has a Gmail license. Gmail Send As Aliases resides under " +
+			"the `https://www.googleapis.com/auth/gmail.settings.basic` client scope.",
+
+		ReadContext: dataSourceGmailSendAsAliasesRead,
+
+		Schema: map[string]*schema.Schema{
+			"primary_email": {
+				Description: "User's primary email address.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"send_as": {
+				Description: "The user's send-as aliases.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"send_as_email": {
+							Description: "The email address that appears in the 'From:' header for mail sent using this alias.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"display_name": {
+							Description: "The name that appears in the 'From:' header for mail sent using this alias.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"reply_to_address": {
+							Description: "The email address included in a 'Reply-To:' header for mail sent using this alias.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"signature": {
+							Description: "The HTML signature included in messages composed with this alias in the Gmail web UI.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"is_primary": {
+							Description: "Whether this address is the primary address used to login to the account.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"is_default": {
+							Description: "Whether this address is selected as the default 'From:' address.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"treat_as_alias": {
+							Description: "Whether Gmail treats this address as an alias for the user's primary email address.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"verification_status": {
+							Description: "Indicates whether this address has been verified for use as a send-as alias.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGmailSendAsAliasesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	primaryEmail := d.Get("primary_email").(string)
+	gmailService, diags := client.NewGmailService(ctx, primaryEmail)
+	if diags.HasError() {
+		return diags
+	}
+
+	sendAsAliasService, diags := GetGmailSendAsAliasService(gmailService)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Getting Gmail Send As Aliases of %q", primaryEmail)
+
+	resp, err := sendAsAliasService.List("me").Do()
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if err := d.Set("send_as", flattenGmailSendAsAliases(resp.SendAs)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(primaryEmail)
+
+	log.Printf("[DEBUG] Finished getting Gmail Send As Aliases of %q", primaryEmail)
+
+	return diags
+}
+
+func flattenGmailSendAsAliases(sendAsAliases []*gmail.SendAs) []interface{} {
+	var result []interface{}
+
+	for _, sendAs := range sendAsAliases {
+		result = append(result, map[string]interface{}{
+			"send_as_email":       sendAs.SendAsEmail,
+			"display_name":        sendAs.DisplayName,
+			"reply_to_address":    sendAs.ReplyToAddress,
+			"signature":           sendAs.Signature,
+			"is_primary":          sendAs.IsPrimary,
+			"is_default":          sendAs.IsDefault,
+			"treat_as_alias":      sendAs.TreatAsAlias,
+			"verification_status": sendAs.VerificationStatus,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGmailSendAsAliases(t *testing.T) {
+	gmailUser := os.Getenv("GOOGLEWORKSPACE_TEST_GMAIL_USER")
+
+	if gmailUser == "" {
+		t.Skip("GOOGLEWORKSPACE_TEST_GMAIL_USER needs to be set to run this test")
+	}
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	data := map[string]interface{}{
+		"domainName": domainName,
+		"userEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":   acctest.RandString(10),
+		"gmailUser":  gmailUser,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGmailSendAsAliases(data),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.googleworkspace_gmail_send_as_aliases.test", "send_as.*", map[string]string{
+						"send_as_email": gmailUser,
+						"is_primary":    "true",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.googleworkspace_gmail_send_as_aliases.test", "send_as.*", map[string]string{
+						"send_as_email": fmt.Sprintf("%s@%s", data["userEmail"], domainName),
+						"is_primary":    "false",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGmailSendAsAliases(data map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_user" "alias" {
+  primary_email = "%{userEmail}@%{domainName}"
+  password = "%{password}"
+
+  name {
+    family_name = "Scott"
+    given_name = "Michael"
+  }
+}
+
+resource "googleworkspace_gmail_send_as_alias" "test" {
+  primary_email = "%{gmailUser}"
+  send_as_email = googleworkspace_user.alias.primary_email
+}
+
+data "googleworkspace_gmail_send_as_aliases" "test" {
+  primary_email = googleworkspace_gmail_send_as_alias.test.primary_email
+}
+`, data)
+}
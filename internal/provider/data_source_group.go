@@ -13,7 +13,12 @@ import (
 func dataSourceGroup() *schema.Resource {
 	// Generate datasource schema from resource
 	dsSchema := datasourceSchemaFromResourceSchema(resourceGroup().Schema)
-	addExactlyOneOfFieldsToSchema(dsSchema, "id", "email")
+	dsSchema["alias"] = &schema.Schema{
+		Description: "One of the group's alias email addresses, to look up the group by. `email` and `id` are set " +
+			"to the group's canonical email address and unique ID.",
+		Type: schema.TypeString,
+	}
+	addExactlyOneOfFieldsToSchema(dsSchema, "id", "email", "alias")
 
 	return &schema.Resource{
 		// This description is used by the documentation generator and the language server.
@@ -45,15 +50,21 @@ func dataSourceGroupRead(ctx context.Context, d *schema.ResourceData, meta inter
 			return diags
 		}
 
-		group, err := groupsService.Get(d.Get("email").(string)).Do()
+		// the API resolves aliases, as well as the canonical email, to the group
+		groupKey := d.Get("email").(string)
+		if alias := d.Get("alias").(string); alias != "" {
+			groupKey = alias
+		}
+
+		group, err := groupsService.Get(groupKey).Do()
 		if err != nil {
-			return diag.FromErr(err)
+			return apiErrorDiagnostics(err)
 		}
 
 		if group == nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
-				Summary:  fmt.Sprintf("No group was returned for %s.", d.Get("email").(string)),
+				Summary:  fmt.Sprintf("No group was returned for %s.", groupKey),
 			})
 
 			return diags
@@ -62,5 +73,5 @@ func dataSourceGroupRead(ctx context.Context, d *schema.ResourceData, meta inter
 		d.SetId(group.Id)
 	}
 
-	return resourceGroupRead(ctx, d, meta)
+	return withoutRemovedFromStateWarning(resourceGroupRead(ctx, d, meta))
 }
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGroupAliases() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Group Aliases data source in the Terraform Googleworkspace provider. It returns the aliases of " +
+			"a group, including the ones managed outside of Terraform, e.g. for mail routing audits. Group Aliases " +
+			"resides under the `https://www.googleapis.com/auth/admin.directory.group` client scope.",
+
+		ReadContext: dataSourceGroupAliasesRead,
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Description:  "The unique ID of the group.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"group_id", "email"},
+			},
+			"email": {
+				Description:  "The group's email address, or one of its aliases.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"group_id", "email"},
+			},
+			"aliases": {
+				Description: "The group's alias email addresses.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"non_editable_aliases": {
+				Description: "The group's non-editable alias email addresses. These are typically outside the " +
+					"group's primary domain or sub-domain.",
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGroupAliasesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	groupsService, diags := GetGroupsService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	groupKey := d.Get("email").(string)
+	if v, ok := d.GetOk("group_id"); ok {
+		groupKey = v.(string)
+	}
+
+	group, err := groupsService.Get(groupKey).Fields("id", "email", "aliases", "nonEditableAliases").Do()
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	d.Set("group_id", group.Id)
+	d.Set("email", group.Email)
+	d.Set("aliases", group.Aliases)
+	d.Set("non_editable_aliases", group.NonEditableAliases)
+	d.SetId(group.Id)
+
+	return diags
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGroupAliases(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testGroupVals := map[string]interface{}{
+		"domainName": domainName,
+		"email":      fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGroupAliases(testGroupVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.googleworkspace_group_aliases.by_email", "aliases.#", "1"),
+					resource.TestCheckResourceAttr("data.googleworkspace_group_aliases.by_email", "aliases.0",
+						Nprintf("%{email}-alias@%{domainName}", testGroupVals)),
+					resource.TestCheckResourceAttrPair("data.googleworkspace_group_aliases.by_email", "group_id",
+						"googleworkspace_group.my-new-group", "id"),
+					resource.TestCheckResourceAttr("data.googleworkspace_group_aliases.by_id", "email",
+						Nprintf("%{email}@%{domainName}", testGroupVals)),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGroupAliases(testGroupVals map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_group" "my-new-group" {
+  email = "%{email}@%{domainName}"
+
+  aliases = ["%{email}-alias@%{domainName}"]
+}
+
+data "googleworkspace_group_aliases" "by_email" {
+  email = googleworkspace_group.my-new-group.email
+}
+
+data "googleworkspace_group_aliases" "by_id" {
+  group_id = googleworkspace_group.my-new-group.id
+}
+`, testGroupVals)
+}
@@ -16,6 +16,10 @@ func dataSourceGroupMember() *schema.Resource {
 	addRequiredFieldsToSchema(dsSchema, "group_id")
 	addExactlyOneOfFieldsToSchema(dsSchema, "member_id", "email")
 
+	// allow_existing_member and wait_for_active only apply to the resource
+	delete(dsSchema, "allow_existing_member")
+	delete(dsSchema, "wait_for_active")
+
 	return &schema.Resource{
 		// This description is used by the documentation generator and the language server.
 		Description: "Group Member data source in the Terraform Googleworkspace provider. Group Member resides under the " +
@@ -51,7 +55,7 @@ func dataSourceGroupMemberRead(ctx context.Context, d *schema.ResourceData, meta
 		groupId := d.Get("group_id").(string)
 		member, err := membersService.Get(groupId, d.Get("email").(string)).Do()
 		if err != nil {
-			return diag.FromErr(err)
+			return apiErrorDiagnostics(err)
 		}
 
 		if member == nil {
@@ -67,5 +71,5 @@ func dataSourceGroupMemberRead(ctx context.Context, d *schema.ResourceData, meta
 		d.SetId(fmt.Sprintf("groups/%s/members/%s", groupId, member.Id))
 	}
 
-	return resourceGroupMemberRead(ctx, d, meta)
+	return withoutRemovedFromStateWarning(resourceGroupMemberRead(ctx, d, meta))
 }
@@ -14,11 +14,14 @@ func dataSourceGroupMembers() *schema.Resource {
 	// Generate datasource schema from resource
 	dsSchema := datasourceSchemaFromResourceSchema(resourceGroupMembers().Schema)
 	addRequiredFieldsToSchema(dsSchema, "group_id")
+	delete(dsSchema, "mode")
+	delete(dsSchema, "ignore_members")
 	dsSchema["include_derived_membership"] = &schema.Schema{
 		Description: "If true, lists indirect group memberships",
 		Type:        schema.TypeBool,
 		Optional:    true,
 	}
+	addLimitFieldToSchema(dsSchema, "members")
 
 	return &schema.Resource{
 		// This description is used by the documentation generator and the language server.
@@ -32,5 +35,5 @@ func dataSourceGroupMembers() *schema.Resource {
 }
 
 func dataSourceGroupMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return resourceGroupMembersRead(ctx, d, meta)
+	return withoutRemovedFromStateWarning(resourceGroupMembersRead(ctx, d, meta))
 }
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGroupMembershipCheck() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Group Membership Check data source in the Terraform Googleworkspace provider. It checks whether a " +
+			"user or group is a member of a group, either directly or through nested groups. Group Membership Check " +
+			"resides under the `https://www.googleapis.com/auth/cloud-identity.groups.readonly` client scope.",
+
+		ReadContext: dataSourceGroupMembershipCheckRead,
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Description: "The unique ID of the group.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"member_email": {
+				Description: "The email address of the user or group to check.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ValidateDiagFunc: func(v interface{}, path cty.Path) diag.Diagnostics {
+					if !isEmail(v.(string)) {
+						return diag.Diagnostics{{
+							Severity:      diag.Error,
+							Summary:       fmt.Sprintf("%q is not a valid email address", v.(string)),
+							AttributePath: path,
+						}}
+					}
+
+					return nil
+				},
+			},
+			"is_member": {
+				Description: "Whether the member is a direct or indirect member of the group.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceGroupMembershipCheckRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	cloudIdentityService, diags := client.NewCloudIdentityService()
+	if diags.HasError() {
+		return diags
+	}
+
+	groupsService, diags := GetCloudIdentityGroupsService(cloudIdentityService)
+	if diags.HasError() {
+		return diags
+	}
+
+	membershipsService, diags := GetCloudIdentityMembershipsService(groupsService)
+	if diags.HasError() {
+		return diags
+	}
+
+	groupId := d.Get("group_id").(string)
+	memberEmail := d.Get("member_email").(string)
+	log.Printf("[DEBUG] Checking membership of %q in Group %q", memberEmail, groupId)
+
+	resp, err := membershipsService.CheckTransitiveMembership(fmt.Sprintf("groups/%s", groupId)).
+		Query(fmt.Sprintf("member_key_id == %s", celStringLiteral(memberEmail))).Do()
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	d.SetId(fmt.Sprintf("groups/%s/members/%s", groupId, memberEmail))
+	d.Set("is_member", resp.HasMembership)
+
+	log.Printf("[DEBUG] Finished checking membership of %q in Group %q", memberEmail, groupId)
+
+	return diags
+}
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGroupMembershipCheck(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testGroupVals := map[string]interface{}{
+		"userEmail":     fmt.Sprintf("tf-test-%s@%s", acctest.RandString(10), domainName),
+		"otherEmail":    fmt.Sprintf("tf-test-%s@%s", acctest.RandString(10), domainName),
+		"groupEmail":    fmt.Sprintf("tf-test-%s@%s", acctest.RandString(10), domainName),
+		"subGroupEmail": fmt.Sprintf("tf-test-%s@%s", acctest.RandString(10), domainName),
+		"password":      acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGroupMembershipCheck(testGroupVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.googleworkspace_group_membership_check.nested", "is_member", "true"),
+					resource.TestCheckResourceAttr(
+						"data.googleworkspace_group_membership_check.other", "is_member", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGroupMembershipCheck(testGroupVals map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_group" "parent-group" {
+  email = "%{groupEmail}"
+}
+
+resource "googleworkspace_group" "sub-group" {
+  email = "%{subGroupEmail}"
+}
+
+resource "googleworkspace_user" "user" {
+  primary_email = "%{userEmail}"
+  password = "%{password}"
+
+  name {
+    family_name = "Scott"
+    given_name = "Michael"
+  }
+}
+
+resource "googleworkspace_user" "other" {
+  primary_email = "%{otherEmail}"
+  password = "%{password}"
+
+  name {
+    family_name = "Schrute"
+    given_name = "Dwight"
+  }
+}
+
+resource "googleworkspace_group_member" "user-sub-member" {
+  group_id = googleworkspace_group.sub-group.id
+  email = googleworkspace_user.user.primary_email
+}
+
+resource "googleworkspace_group_member" "sub-group-member" {
+  group_id = googleworkspace_group.parent-group.id
+  email = googleworkspace_group.sub-group.email
+  type = "GROUP"
+}
+
+data "googleworkspace_group_membership_check" "nested" {
+  group_id     = googleworkspace_group.parent-group.id
+  member_email = googleworkspace_user.user.primary_email
+
+  depends_on = [
+    googleworkspace_group_member.user-sub-member,
+    googleworkspace_group_member.sub-group-member,
+  ]
+}
+
+data "googleworkspace_group_membership_check" "other" {
+  group_id     = googleworkspace_group.parent-group.id
+  member_email = googleworkspace_user.other.primary_email
+
+  depends_on = [
+    googleworkspace_group_member.user-sub-member,
+    googleworkspace_group_member.sub-group-member,
+  ]
+}
+`, testGroupVals)
+}
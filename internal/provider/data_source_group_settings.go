@@ -14,6 +14,9 @@ func dataSourceGroupSettings() *schema.Resource {
 	dsSchema := datasourceSchemaFromResourceSchema(resourceGroupSettings().Schema)
 	addRequiredFieldsToSchema(dsSchema, "email")
 
+	// restore_defaults_on_destroy only applies to the resource
+	delete(dsSchema, "restore_defaults_on_destroy")
+
 	return &schema.Resource{
 		// This description is used by the documentation generator and the language server.
 		Description: "Group Settings data source in the Terraform Googleworkspace provider. Group Settings resides " +
@@ -29,5 +32,5 @@ func dataSourceGroupSettingsRead(ctx context.Context, d *schema.ResourceData, me
 
 	d.SetId(d.Get("email").(string))
 
-	return resourceGroupSettingsRead(ctx, d, meta)
+	return readGroupSettings(ctx, d, meta, false)
 }
@@ -6,10 +6,13 @@ package googleworkspace
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-googleworkspace/internal/fakeworkspace"
 )
 
 func TestAccDataSourceGroupSettings(t *testing.T) {
@@ -54,6 +57,28 @@ func TestAccDataSourceGroupSettings(t *testing.T) {
 	})
 }
 
+// Runs against a fake of the APIs, so it doesn't need a Workspace tenant
+func TestAccDataSourceGroupSettings_notFound(t *testing.T) {
+	t.Parallel()
+
+	ts := fakeworkspace.NewTestServer()
+	defer ts.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeProviderConfig(ts.URL) + `
+data "googleworkspace_group_settings" "my-group-settings" {
+  email = "tf-test-missing@example.com"
+}
+`,
+				ExpectError: regexp.MustCompile("Error 404"),
+			},
+		},
+	})
+}
+
 func testAccDataSourceGroupSettings(testGroupVals map[string]interface{}) string {
 	return Nprintf(`
 resource "googleworkspace_group" "my-group" {
@@ -75,6 +75,37 @@ func TestAccDataSourceGroup_withEmail(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceGroup_withAlias(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testGroupVals := map[string]interface{}{
+		"domainName": domainName,
+		"email":      fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGroup_withAlias(testGroupVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.googleworkspace_group.my-new-group", "email", Nprintf("%{email}@%{domainName}", testGroupVals)),
+					resource.TestCheckResourceAttrPair(
+						"data.googleworkspace_group.my-new-group", "id", "googleworkspace_group.my-new-group", "id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDataSourceGroup_withId(testGroupVals map[string]interface{}) string {
 	return Nprintf(`
 resource "googleworkspace_group" "my-new-group" {
@@ -98,3 +129,17 @@ data "googleworkspace_group" "my-new-group" {
 }
 `, testGroupVals)
 }
+
+func testAccDataSourceGroup_withAlias(testGroupVals map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_group" "my-new-group" {
+  email = "%{email}@%{domainName}"
+
+  aliases = ["%{email}-alias@%{domainName}"]
+}
+
+data "googleworkspace_group" "my-new-group" {
+  alias = tolist(googleworkspace_group.my-new-group.aliases)[0]
+}
+`, testGroupVals)
+}
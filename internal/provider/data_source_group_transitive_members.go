@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"google.golang.org/api/cloudidentity/v1"
+)
+
+func dataSourceGroupTransitiveMembers() *schema.Resource {
+	dsResource := &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Group Transitive Members data source in the Terraform Googleworkspace provider. It returns the " +
+			"flattened membership of a group, including members inherited through nested groups. Group Transitive " +
+			"Members resides under the `https://www.googleapis.com/auth/cloud-identity.groups.readonly` client scope.",
+
+		ReadContext: dataSourceGroupTransitiveMembersRead,
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Description: "The unique ID of the group.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"members": {
+				Description: "The direct and indirect members of the group.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"member": {
+							Description: "The resource name of the member, e.g. `users/123456789` or `groups/123456789`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"email": {
+							Description: "The email address of the member.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"relation_type": {
+							Description: "The relation between the group and the member. Possible values are: " +
+								"\n\t- `DIRECT`: The member is a direct member of the group. " +
+								"\n\t- `INDIRECT`: The member is only a member through nested groups. " +
+								"\n\t- `DIRECT_AND_INDIRECT`: The member is both a direct and an indirect member of the group.",
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"roles": {
+							Description: "The roles of the member in the group, e.g. `MEMBER`, `MANAGER` or `OWNER`.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	addLimitFieldToSchema(dsResource.Schema, "members")
+
+	return dsResource
+}
+
+func dataSourceGroupTransitiveMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	cloudIdentityService, diags := client.NewCloudIdentityService()
+	if diags.HasError() {
+		return diags
+	}
+
+	groupsService, diags := GetCloudIdentityGroupsService(cloudIdentityService)
+	if diags.HasError() {
+		return diags
+	}
+
+	membershipsService, diags := GetCloudIdentityMembershipsService(groupsService)
+	if diags.HasError() {
+		return diags
+	}
+
+	groupId := d.Get("group_id").(string)
+	log.Printf("[DEBUG] Getting Group Transitive Members %q", groupId)
+
+	limit := d.Get("limit").(int)
+
+	var result []*cloudidentity.MemberRelation
+	err := membershipsService.SearchTransitiveMemberships(fmt.Sprintf("groups/%s", groupId)).Pages(ctx,
+		func(resp *cloudidentity.SearchTransitiveMembershipsResponse) error {
+			for _, relation := range resp.Memberships {
+				if limit > 0 && len(result) >= limit {
+					return errDataSourceLimitReached
+				}
+
+				result = append(result, relation)
+			}
+
+			return nil
+		})
+	if err != nil && !errors.Is(err, errDataSourceLimitReached) {
+		return apiErrorDiagnostics(err)
+	}
+
+	if err := d.Set("members", flattenMemberRelations(result)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(groupId)
+
+	log.Printf("[DEBUG] Finished getting Group Transitive Members %q", groupId)
+
+	return diags
+}
+
+func flattenMemberRelations(relations []*cloudidentity.MemberRelation) []interface{} {
+	members := make([]interface{}, len(relations))
+	for i, relation := range relations {
+		email := ""
+		if len(relation.PreferredMemberKey) > 0 {
+			email = relation.PreferredMemberKey[0].Id
+		}
+
+		roles := make([]interface{}, len(relation.Roles))
+		for j, role := range relation.Roles {
+			roles[j] = role.Role
+		}
+
+		members[i] = map[string]interface{}{
+			"member":        relation.Member,
+			"email":         email,
+			"relation_type": relation.RelationType,
+			"roles":         roles,
+		}
+	}
+
+	return members
+}
@@ -5,6 +5,7 @@ package googleworkspace
 
 import (
 	"context"
+	"errors"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,6 +16,18 @@ func dataSourceGroups() *schema.Resource {
 	// Generate datasource schema from resource
 	dsGroupSchema := datasourceSchemaFromResourceSchema(resourceGroup().Schema)
 
+	dsSchema := map[string]*schema.Schema{
+		"groups": {
+			Description: "A list of Group resources.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: dsGroupSchema,
+			},
+		},
+	}
+	addLimitFieldToSchema(dsSchema, "groups")
+
 	return &schema.Resource{
 		// This description is used by the documentation generator and the language server.
 		Description: "Groups data source in the Terraform Googleworkspace provider. Groups resides " +
@@ -22,16 +35,7 @@ func dataSourceGroups() *schema.Resource {
 
 		ReadContext: dataSourceGroupsRead,
 
-		Schema: map[string]*schema.Schema{
-			"groups": {
-				Description: "A list of Group resources.",
-				Type:        schema.TypeList,
-				Computed:    true,
-				Elem: &schema.Resource{
-					Schema: dsGroupSchema,
-				},
-			},
-		},
+		Schema: dsSchema,
 	}
 }
 
@@ -49,16 +53,22 @@ func dataSourceGroupsRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return diags
 	}
 
+	limit := d.Get("limit").(int)
+
 	var result []*directory.Group
-	err := groupsService.List().Customer(client.Customer).Pages(ctx, func(resp *directory.Groups) error {
+	err := groupsService.List().Customer(client.Customer).MaxResults(dataSourcePageSize(limit, groupsMaxResults)).Pages(ctx, func(resp *directory.Groups) error {
 		for _, group := range resp.Groups {
+			if limit > 0 && len(result) >= limit {
+				return errDataSourceLimitReached
+			}
+
 			result = append(result, group)
 		}
 
 		return nil
 	})
 
-	if err != nil {
+	if err != nil && !errors.Is(err, errDataSourceLimitReached) {
 		return handleNotFoundError(err, d, "groups")
 	}
 
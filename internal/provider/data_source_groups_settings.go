@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+func dataSourceGroupsSettings() *schema.Resource {
+	// Generate datasource schema from resource
+	dsGroupSettingsSchema := datasourceSchemaFromResourceSchema(resourceGroupSettings().Schema)
+
+	// restore_defaults_on_destroy only applies to the resource
+	delete(dsGroupSettingsSchema, "restore_defaults_on_destroy")
+
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Groups Settings data source in the Terraform Googleworkspace provider. It returns the settings " +
+			"of a list of groups, or of the groups matching a query, e.g. to audit posting permissions across the " +
+			"tenant. Groups Settings resides under the `https://www.googleapis.com/auth/apps.groups.settings` " +
+			"client scope, looking groups up by query also requires the " +
+			"`https://www.googleapis.com/auth/admin.directory.group` client scope.",
+
+		ReadContext: dataSourceGroupsSettingsRead,
+
+		Schema: map[string]*schema.Schema{
+			"emails": {
+				Description:   "The emails of the groups. When neither `emails` nor `query` is set, all groups are returned.",
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"query"},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"query": {
+				Description: "Query string matching the groups, see " +
+					"https://developers.google.com/admin-sdk/directory/v1/guides/search-groups.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"emails"},
+			},
+			"concurrency": {
+				Description:      "The maximum number of group settings fetched in parallel.",
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          5,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 20)),
+			},
+			"groups_settings": {
+				Description: "The settings of the groups, in the order of `emails` when set.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: dsGroupSettingsSchema,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGroupsSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	groupsSettingsService, diags := client.NewGroupsSettingsService()
+	if diags.HasError() {
+		return diags
+	}
+
+	groupsService, diags := GetGroupsSettingsService(groupsSettingsService)
+	if diags.HasError() {
+		return diags
+	}
+
+	var emails []string
+	if v, ok := d.GetOk("emails"); ok {
+		emails = listOfInterfacestoStrings(v)
+	} else {
+		emails, diags = listGroupEmails(ctx, client, d.Get("query").(string))
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	log.Printf("[DEBUG] Getting Settings of %d groups", len(emails))
+
+	results := make([]interface{}, len(emails))
+	errs := make([]error, len(emails))
+	flattenErrs := make([]error, len(emails))
+
+	// the settings are fetched per group, a semaphore bounds the number of requests in flight
+	sem := make(chan struct{}, d.Get("concurrency").(int))
+	var wg sync.WaitGroup
+	for i, email := range emails {
+		wg.Add(1)
+		go func(i int, email string) {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			group, err := groupsService.Get(email).Context(ctx).Do()
+			if err != nil {
+				errs[i] = fmt.Errorf("error getting settings of group %s: %w", email, err)
+				return
+			}
+
+			results[i], flattenErrs[i] = flattenGroupSettings(group)
+		}(i, email)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return apiErrorDiagnostics(err)
+		}
+	}
+
+	for _, err := range flattenErrs {
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := d.Set("groups_settings", results); err != nil {
+		return diag.FromErr(err)
+	}
+
+	hash := sha1.Sum([]byte(strings.Join(emails, ",")))
+	d.SetId(hex.EncodeToString(hash[:]))
+
+	log.Printf("[DEBUG] Finished getting Settings of %d groups", len(emails))
+
+	return diags
+}
+
+func listGroupEmails(ctx context.Context, client *apiClient, query string) ([]string, diag.Diagnostics) {
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	groupsService, diags := GetGroupsService(directoryService)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	groupsListCall := groupsService.List().Customer(client.Customer).MaxResults(groupsMaxResults).
+		Fields("nextPageToken", "groups(email)")
+	if query != "" {
+		groupsListCall = groupsListCall.Query(query)
+	}
+
+	var emails []string
+	err := groupsListCall.Pages(ctx, func(resp *directory.Groups) error {
+		for _, group := range resp.Groups {
+			emails = append(emails, group.Email)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, apiErrorDiagnostics(err)
+	}
+
+	return emails, diags
+}
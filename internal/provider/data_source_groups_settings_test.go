@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGroupsSettings(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testGroupVals := map[string]interface{}{
+		"domainName": domainName,
+		"email":      fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGroupsSettings(testGroupVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.googleworkspace_groups_settings.by-email", "groups_settings.#", "2"),
+					resource.TestCheckResourceAttr(
+						"data.googleworkspace_groups_settings.by-email", "groups_settings.0.email", Nprintf("%{email}-1@%{domainName}", testGroupVals)),
+					resource.TestCheckResourceAttr(
+						"data.googleworkspace_groups_settings.by-email", "groups_settings.1.email", Nprintf("%{email}-2@%{domainName}", testGroupVals)),
+					resource.TestCheckResourceAttr(
+						"data.googleworkspace_groups_settings.by-email", "groups_settings.1.who_can_post_message", "ALL_MANAGERS_CAN_POST"),
+					resource.TestCheckResourceAttr(
+						"data.googleworkspace_groups_settings.by-query", "groups_settings.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGroupsSettings(testGroupVals map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_group" "group-1" {
+  email = "%{email}-1@%{domainName}"
+}
+
+resource "googleworkspace_group" "group-2" {
+  email = "%{email}-2@%{domainName}"
+}
+
+resource "googleworkspace_group_settings" "group-2" {
+  email                = googleworkspace_group.group-2.email
+  who_can_post_message = "ALL_MANAGERS_CAN_POST"
+}
+
+data "googleworkspace_groups_settings" "by-email" {
+  emails = [
+    googleworkspace_group.group-1.email,
+    googleworkspace_group_settings.group-2.email,
+  ]
+}
+
+data "googleworkspace_groups_settings" "by-query" {
+  query       = "email:%{email}-*"
+  concurrency = 1
+
+  depends_on = [googleworkspace_group.group-1, googleworkspace_group_settings.group-2]
+}
+`, testGroupVals)
+}
@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+func dataSourceInactiveUsers() *schema.Resource {
+	// Generate datasource schema from resource
+	dsUserSchema := datasourceSchemaFromResourceSchema(resourceUser().Schema)
+
+	dsSchema := map[string]*schema.Schema{
+		"inactive_days": {
+			Description:      "The number of days since their last login after which users are considered inactive.",
+			Type:             schema.TypeInt,
+			Required:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+		},
+		"exclude_suspended": {
+			Description: "If true, suspended users aren't returned.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"query": {
+			Description: "Query string restricting the users that are considered, see " +
+				"https://developers.google.com/admin-sdk/directory/v1/guides/search-users.",
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"users": {
+			Description: "A list of User resources.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: dsUserSchema,
+			},
+		},
+	}
+	addLimitFieldToSchema(dsSchema, "users")
+
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Inactive Users data source in the Terraform Googleworkspace provider. It returns the users " +
+			"that haven't logged in for a number of days, e.g. to reclaim their licenses. Users that never logged " +
+			"in are inactive once they were created that many days ago. Inactive Users resides under the " +
+			"`https://www.googleapis.com/auth/admin.directory.user` client scope.",
+
+		ReadContext: dataSourceInactiveUsersRead,
+
+		Schema: dsSchema,
+	}
+}
+
+func dataSourceInactiveUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	usersService, diags := GetUsersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	inactiveDays := d.Get("inactive_days").(int)
+	threshold := time.Now().AddDate(0, 0, -inactiveDays)
+	excludeSuspended := d.Get("exclude_suspended").(bool)
+
+	limit := d.Get("limit").(int)
+
+	usersCall := usersService.List().Customer(client.Customer).Projection("full").MaxResults(usersMaxResults)
+	if query, ok := d.GetOk("query"); ok {
+		usersCall = usersCall.Query(query.(string))
+	}
+
+	var result []*directory.User
+	err := usersCall.Pages(ctx, func(resp *directory.Users) error {
+		for _, user := range resp.Users {
+			if excludeSuspended && user.Suspended {
+				continue
+			}
+
+			inactive, err := isUserInactive(user, threshold)
+			if err != nil {
+				return err
+			}
+
+			if inactive {
+				if limit > 0 && len(result) >= limit {
+					return errDataSourceLimitReached
+				}
+
+				result = append(result, user)
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil && !errors.Is(err, errDataSourceLimitReached) {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("users", flattenUsers(result, client)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("inactive-users-%d", inactiveDays))
+
+	return diags
+}
+
+// isUserInactive returns whether the user last logged in before the threshold. Users that never
+// logged in have a zero last login time, they're inactive if they were created before the threshold.
+func isUserInactive(user *directory.User, threshold time.Time) (bool, error) {
+	lastLoginTime, err := time.Parse(time.RFC3339, user.LastLoginTime)
+	if err != nil {
+		return false, fmt.Errorf("invalid last login time %q of user %s: %w", user.LastLoginTime, user.PrimaryEmail, err)
+	}
+
+	if lastLoginTime.Unix() <= 0 {
+		creationTime, err := time.Parse(time.RFC3339, user.CreationTime)
+		if err != nil {
+			return false, fmt.Errorf("invalid creation time %q of user %s: %w", user.CreationTime, user.PrimaryEmail, err)
+		}
+
+		return creationTime.Before(threshold), nil
+	}
+
+	return lastLoginTime.Before(threshold), nil
+}
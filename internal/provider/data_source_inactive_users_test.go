@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+func TestIsUserInactive(t *testing.T) {
+	threshold := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		user     *directory.User
+		expected bool
+	}{
+		"logged in before threshold": {
+			user: &directory.User{
+				LastLoginTime: "2022-05-01T10:00:00.000Z",
+				CreationTime:  "2021-01-01T10:00:00.000Z",
+			},
+			expected: true,
+		},
+		"logged in after threshold": {
+			user: &directory.User{
+				LastLoginTime: "2022-06-02T10:00:00.000Z",
+				CreationTime:  "2021-01-01T10:00:00.000Z",
+			},
+			expected: false,
+		},
+		"never logged in, created before threshold": {
+			user: &directory.User{
+				LastLoginTime: "1970-01-01T00:00:00.000Z",
+				CreationTime:  "2021-01-01T10:00:00.000Z",
+			},
+			expected: true,
+		},
+		"never logged in, created after threshold": {
+			user: &directory.User{
+				LastLoginTime: "1970-01-01T00:00:00.000Z",
+				CreationTime:  "2022-06-02T10:00:00.000Z",
+			},
+			expected: false,
+		},
+	}
+
+	for tn, tc := range cases {
+		inactive, err := isUserInactive(tc.user, threshold)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tn, err)
+		}
+
+		if inactive != tc.expected {
+			t.Errorf("%s: expected inactive to be %t, got %t", tn, tc.expected, inactive)
+		}
+	}
+}
+
+func TestAccDataSourceInactiveUsers(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testUserVals := map[string]interface{}{
+		"domainName": domainName,
+		"userEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":   acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceInactiveUsers(testUserVals),
+				Check: resource.ComposeTestCheckFunc(
+					// the user was just created, so it isn't inactive yet
+					resource.TestCheckResourceAttr("data.googleworkspace_inactive_users.test", "users.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceInactiveUsers(testUserVals map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_user" "my-new-user" {
+  primary_email = "%{userEmail}@%{domainName}"
+  password = "%{password}"
+
+  name {
+    family_name = "Scott"
+    given_name = "Michael"
+  }
+}
+
+data "googleworkspace_inactive_users" "test" {
+  inactive_days = 1
+  query         = "email:${googleworkspace_user.my-new-user.primary_email}"
+}
+`, testUserVals)
+}
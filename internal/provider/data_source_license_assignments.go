@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/api/licensing/v1"
+)
+
+func dataSourceLicenseAssignments() *schema.Resource {
+	dsSchema := map[string]*schema.Schema{
+		"product_id": {
+			Description: "The ID of the product the licenses are for, e.g. `Google-Apps` for Google Workspace.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"sku_id": {
+			Description: "If set, only the licenses of this SKU of the product are returned, " +
+				"e.g. `1010020027` for Google Workspace Business Starter.",
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"license_assignments": {
+			Description: "A list of license assignments.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"user_id": {
+						Description: "The primary email address of the user the license is assigned to.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"product_id": {
+						Description: "The ID of the product of the license.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"product_name": {
+						Description: "The display name of the product of the license.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"sku_id": {
+						Description: "The ID of the SKU of the license.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"sku_name": {
+						Description: "The display name of the SKU of the license.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"etag": {
+						Description: "ETag of the resource.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"user_ids": {
+			Description: "The primary email addresses of the users the licenses are assigned to.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+	addLimitFieldToSchema(dsSchema, "license assignments")
+
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "License Assignments data source in the Terraform Googleworkspace provider. It returns the " +
+			"users that are assigned a license of a product, or of one of its SKUs, e.g. to report on license " +
+			"utilization. Please ensure the Enterprise License Manager API is enabled for your project. License " +
+			"Assignments requires the `https://www.googleapis.com/auth/apps.licensing` client scope, which isn't " +
+			"one of the provider's default scopes and needs to be added to `oauth_scopes`.",
+
+		ReadContext: dataSourceLicenseAssignmentsRead,
+
+		Schema: dsSchema,
+	}
+}
+
+func dataSourceLicenseAssignmentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	customersService, diags := GetCustomersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	licensingService, diags := client.NewLicensingService()
+	if diags.HasError() {
+		return diags
+	}
+
+	licenseAssignmentsService, diags := GetLicenseAssignmentsService(licensingService)
+	if diags.HasError() {
+		return diags
+	}
+
+	// the Enterprise License Manager API doesn't accept the `my_customer` alias
+	customer, err := customersService.Get(client.Customer).Fields("id").Do()
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	productId := d.Get("product_id").(string)
+	skuId := d.Get("sku_id").(string)
+	limit := d.Get("limit").(int)
+	maxResults := dataSourcePageSize(limit, licenseAssignmentsMaxResults)
+
+	log.Printf("[DEBUG] Getting License Assignments of product %q, sku %q", productId, skuId)
+
+	var result []*licensing.LicenseAssignment
+	collect := func(resp *licensing.LicenseAssignmentList) error {
+		for _, la := range resp.Items {
+			if limit > 0 && len(result) >= limit {
+				return errDataSourceLimitReached
+			}
+
+			result = append(result, la)
+		}
+
+		return nil
+	}
+
+	id := productId
+	if skuId == "" {
+		err = licenseAssignmentsService.ListForProduct(productId, customer.Id).MaxResults(maxResults).Pages(ctx, collect)
+	} else {
+		id = fmt.Sprintf("%s/%s", productId, skuId)
+		err = licenseAssignmentsService.ListForProductAndSku(productId, skuId, customer.Id).MaxResults(maxResults).Pages(ctx, collect)
+	}
+
+	if err != nil && !errors.Is(err, errDataSourceLimitReached) {
+		return apiErrorDiagnostics(err)
+	}
+
+	licenseAssignments := []interface{}{}
+	userIds := []string{}
+	for _, la := range result {
+		licenseAssignments = append(licenseAssignments, map[string]interface{}{
+			"user_id":      la.UserId,
+			"product_id":   la.ProductId,
+			"product_name": la.ProductName,
+			"sku_id":       la.SkuId,
+			"sku_name":     la.SkuName,
+			"etag":         la.Etags,
+		})
+		userIds = append(userIds, la.UserId)
+	}
+
+	if err := d.Set("license_assignments", licenseAssignments); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("user_ids", userIds)
+
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Finished getting License Assignments of product %q, sku %q", productId, skuId)
+
+	return diags
+}
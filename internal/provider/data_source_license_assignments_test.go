@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceLicenseAssignments(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceLicenseAssignments(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.googleworkspace_license_assignments.test", "license_assignments.#", "1"),
+					resource.TestCheckResourceAttr("data.googleworkspace_license_assignments.test", "license_assignments.0.product_id", "Google-Apps"),
+					resource.TestCheckResourceAttrPair("data.googleworkspace_license_assignments.test", "user_ids.0",
+						"data.googleworkspace_license_assignments.test", "license_assignments.0.user_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceLicenseAssignments() string {
+	return `
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/admin.directory.customer",
+    "https://www.googleapis.com/auth/apps.licensing",
+  ]
+}
+
+data "googleworkspace_license_assignments" "test" {
+  product_id = "Google-Apps"
+  limit      = 1
+}
+`
+}
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/api/reseller/v1"
+)
+
+func dataSourceLicenseSkus() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "License SKUs data source in the Terraform Googleworkspace provider. It returns the SKUs the " +
+			"customer is subscribed to along with their seat counts, e.g. to validate the SKU IDs and the available " +
+			"capacity of license assignments. Subscriptions are read from the Reseller API, which only returns the " +
+			"subscriptions of customers managed by a reseller. License SKUs requires the " +
+			"`https://www.googleapis.com/auth/apps.order.readonly` client scope, which isn't one of the provider's " +
+			"default scopes and needs to be added to `oauth_scopes`.",
+
+		ReadContext: dataSourceLicenseSkusRead,
+
+		Schema: map[string]*schema.Schema{
+			"skus": {
+				Description: "The SKUs the customer is subscribed to.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sku_id": {
+							Description: "The ID of the SKU, e.g. `1010020027` for Google Workspace Business Starter.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"sku_name": {
+							Description: "The display name of the SKU.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"subscription_id": {
+							Description: "The ID of the subscription.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"plan_name": {
+							Description: "The name of the subscription's payment plan, e.g. `ANNUAL_MONTHLY_PAY`, " +
+								"`FLEXIBLE` or `TRIAL`.",
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Description: "The status of the subscription, e.g. `ACTIVE` or `SUSPENDED`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"number_of_seats": {
+							Description: "The number of seats purchased for commitment plans.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"maximum_number_of_seats": {
+							Description: "The maximum number of seats that can be assigned for flexible and trial plans.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"licensed_number_of_seats": {
+							Description: "The number of seats assigned to users.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLicenseSkusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	customersService, diags := GetCustomersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	resellerService, diags := client.NewResellerService()
+	if diags.HasError() {
+		return diags
+	}
+
+	subscriptionsService, diags := GetResellerSubscriptionsService(resellerService)
+	if diags.HasError() {
+		return diags
+	}
+
+	// the Reseller API doesn't accept the `my_customer` alias
+	customer, err := customersService.Get(client.Customer).Fields("id").Do()
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	log.Printf("[DEBUG] Getting License SKUs of customer %q", customer.Id)
+
+	var skus []interface{}
+	err = subscriptionsService.List().CustomerId(customer.Id).Pages(ctx, func(resp *reseller.Subscriptions) error {
+		for _, subscription := range resp.Subscriptions {
+			skus = append(skus, flattenLicenseSku(subscription))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if err := d.Set("skus", skus); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(customer.Id)
+
+	log.Printf("[DEBUG] Finished getting License SKUs of customer %q", customer.Id)
+
+	return diags
+}
+
+func flattenLicenseSku(subscription *reseller.Subscription) map[string]interface{} {
+	result := map[string]interface{}{
+		"sku_id":          subscription.SkuId,
+		"sku_name":        subscription.SkuName,
+		"subscription_id": subscription.SubscriptionId,
+		"status":          subscription.Status,
+	}
+
+	if subscription.Plan != nil {
+		result["plan_name"] = subscription.Plan.PlanName
+	}
+
+	if subscription.Seats != nil {
+		result["number_of_seats"] = int(subscription.Seats.NumberOfSeats)
+		result["maximum_number_of_seats"] = int(subscription.Seats.MaximumNumberOfSeats)
+		result["licensed_number_of_seats"] = int(subscription.Seats.LicensedNumberOfSeats)
+	}
+
+	return result
+}
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceLicenseSkus(t *testing.T) {
+	t.Parallel()
+
+	// subscriptions are only returned for customers managed by a reseller
+	skuId := os.Getenv("GOOGLEWORKSPACE_LICENSE_SKU_ID")
+
+	if skuId == "" {
+		t.Skip("GOOGLEWORKSPACE_LICENSE_SKU_ID needs to be set to run this test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceLicenseSkus(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.googleworkspace_license_skus.test", "skus.*", map[string]string{
+						"sku_id": skuId,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceLicenseSkus() string {
+	return `
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/admin.directory.customer",
+    "https://www.googleapis.com/auth/apps.order.readonly",
+  ]
+}
+
+data "googleworkspace_license_skus" "test" {}
+`
+}
@@ -15,11 +15,18 @@ func dataSourceOrgUnit() *schema.Resource {
 	// Generate datasource schema from resource
 	dsSchema := datasourceSchemaFromResourceSchema(resourceOrgUnit().Schema)
 	addExactlyOneOfFieldsToSchema(dsSchema, "org_unit_id", "org_unit_path")
+	dsSchema["org_unit_id"].Description = "The unique ID of the organizational unit, with or without the `id:` prefix."
+
+	// force_destroy only applies to the resource
+	delete(dsSchema, "force_destroy")
+	delete(dsSchema, "force_destroy_target_org_unit_path")
 
 	return &schema.Resource{
 		// This description is used by the documentation generator and the language server.
-		Description: "Org Unit data source in the Terraform Googleworkspace provider. Org Unit resides " +
-			"under the `https://www.googleapis.com/auth/admin.directory.orgunit` client scope.",
+		Description: "Org Unit data source in the Terraform Googleworkspace provider. The org unit can be looked up " +
+			"by either its path or its ID, e.g. to resolve the path of an ID used by Chrome policies and role " +
+			"assignments. Org Unit resides under the `https://www.googleapis.com/auth/admin.directory.orgunit` " +
+			"client scope.",
 
 		ReadContext: dataSourceOrgUnitRead,
 
@@ -28,8 +35,13 @@ func dataSourceOrgUnit() *schema.Resource {
 }
 
 func dataSourceOrgUnitRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	if d.Get("org_unit_id") != "" {
-		d.SetId(d.Get("org_unit_id").(string))
+	if orgUnitId := d.Get("org_unit_id").(string); orgUnitId != "" {
+		// the API only treats the value as an ID rather than a path when it's prefixed
+		if !strings.HasPrefix(orgUnitId, "id:") {
+			orgUnitId = "id:" + orgUnitId
+		}
+
+		d.SetId(orgUnitId)
 	} else {
 		var diags diag.Diagnostics
 
@@ -51,7 +63,7 @@ func dataSourceOrgUnitRead(ctx context.Context, d *schema.ResourceData, meta int
 
 		orgUnit, err := orgUnitsService.Get(client.Customer, ouPath).Do()
 		if err != nil {
-			return diag.FromErr(err)
+			return apiErrorDiagnostics(err)
 		}
 
 		if orgUnit == nil {
@@ -66,5 +78,20 @@ func dataSourceOrgUnitRead(ctx context.Context, d *schema.ResourceData, meta int
 		d.SetId(orgUnit.OrgUnitId)
 	}
 
-	return resourceOrgUnitRead(ctx, d, meta)
+	id := d.Id()
+
+	diags := withoutRemovedFromStateWarning(resourceOrgUnitRead(ctx, d, meta))
+	if diags.HasError() {
+		return diags
+	}
+
+	// the resource's read removes org units that aren't found, which should be an error for the data source
+	if d.Id() == "" {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("No org unit was found for %s.", id),
+		})
+	}
+
+	return diags
 }
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+func dataSourceOrgUnitChildren() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Org Unit Children data source in the Terraform Googleworkspace provider. It returns the " +
+			"immediate child organizational units of an organizational unit. Org Unit Children resides under the " +
+			"`https://www.googleapis.com/auth/admin.directory.orgunit` client scope.",
+
+		ReadContext: dataSourceOrgUnitChildrenRead,
+
+		Schema: map[string]*schema.Schema{
+			"org_unit_id": {
+				Description:  "The unique ID of the organizational unit.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"org_unit_id", "org_unit_path"},
+			},
+			"org_unit_path": {
+				Description:  "The full path of the organizational unit, e.g. `/corp/sales`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"org_unit_id", "org_unit_path"},
+			},
+			"children": {
+				Description: "The immediate child organizational units.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"org_unit_id": {
+							Description: "The unique ID of the organizational unit.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The organizational unit's path name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"org_unit_path": {
+							Description: "The full path to the organizational unit.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "Description of the organizational unit.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOrgUnitChildrenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	orgUnitsService, diags := GetOrgUnitsService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	// the API accepts either the path or the id of the parent org unit
+	orgUnitKey := strings.TrimLeft(d.Get("org_unit_path").(string), "/")
+	if v, ok := d.GetOk("org_unit_id"); ok {
+		orgUnitKey = v.(string)
+		if !strings.HasPrefix(orgUnitKey, "id:") {
+			orgUnitKey = "id:" + orgUnitKey
+		}
+	}
+
+	orgUnit, err := orgUnitsService.Get(client.Customer, orgUnitKey).Fields("orgUnitId", "orgUnitPath").Do()
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	resp, err := orgUnitsService.List(client.Customer).OrgUnitPath(orgUnit.OrgUnitPath).Type("children").
+		Fields("organizationUnits(orgUnitId,name,orgUnitPath,description)").Do()
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if err := d.Set("children", flattenOrgUnitChildren(resp.OrganizationUnits)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("org_unit_id", orgUnit.OrgUnitId)
+	d.Set("org_unit_path", orgUnit.OrgUnitPath)
+	d.SetId(orgUnit.OrgUnitId)
+
+	return diags
+}
+
+func flattenOrgUnitChildren(orgUnits []*directory.OrgUnit) []interface{} {
+	var result []interface{}
+
+	for _, orgUnit := range orgUnits {
+		result = append(result, map[string]interface{}{
+			"org_unit_id":   orgUnit.OrgUnitId,
+			"name":          orgUnit.Name,
+			"org_unit_path": orgUnit.OrgUnitPath,
+			"description":   orgUnit.Description,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceOrgUnitChildren(t *testing.T) {
+	t.Parallel()
+
+	ouName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceOrgUnitChildren(ouName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.googleworkspace_org_unit_children.by_path", "children.#", "1"),
+					resource.TestCheckResourceAttr("data.googleworkspace_org_unit_children.by_path",
+						"children.0.org_unit_path", fmt.Sprintf("/%s/%s-child", ouName, ouName)),
+					resource.TestCheckResourceAttrPair("data.googleworkspace_org_unit_children.by_path", "children.0.org_unit_id",
+						"googleworkspace_org_unit.child", "org_unit_id"),
+					resource.TestCheckResourceAttr("data.googleworkspace_org_unit_children.by_id", "children.#", "1"),
+					resource.TestCheckResourceAttr("data.googleworkspace_org_unit_children.by_id",
+						"org_unit_path", fmt.Sprintf("/%s", ouName)),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceOrgUnitChildren(ouName string) string {
+	return fmt.Sprintf(`
+resource "googleworkspace_org_unit" "parent" {
+  name = "%[1]s"
+  parent_org_unit_path = "/"
+}
+
+resource "googleworkspace_org_unit" "child" {
+  name = "%[1]s-child"
+  parent_org_unit_path = googleworkspace_org_unit.parent.org_unit_path
+}
+
+resource "googleworkspace_org_unit" "grandchild" {
+  name = "%[1]s-grandchild"
+  parent_org_unit_path = googleworkspace_org_unit.child.org_unit_path
+}
+
+data "googleworkspace_org_unit_children" "by_path" {
+  org_unit_path = googleworkspace_org_unit.parent.org_unit_path
+
+  depends_on = [googleworkspace_org_unit.grandchild]
+}
+
+data "googleworkspace_org_unit_children" "by_id" {
+  org_unit_id = googleworkspace_org_unit.parent.org_unit_id
+
+  depends_on = [googleworkspace_org_unit.grandchild]
+}
+`, ouName)
+}
@@ -25,6 +25,14 @@ func TestAccDataSourceOrgUnit_withOrgUnitId(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(
 						"data.googleworkspace_org_unit.my-new-org-unit", "name", ouName),
+					resource.TestCheckResourceAttr(
+						"data.googleworkspace_org_unit.my-new-org-unit", "org_unit_path", "/"+ouName),
+					resource.TestCheckResourceAttr(
+						"data.googleworkspace_org_unit.my-new-org-unit", "parent_org_unit_path", "/"),
+					resource.TestCheckResourceAttrPair("data.googleworkspace_org_unit.without-prefix", "org_unit_id",
+						"googleworkspace_org_unit.my-new-org-unit", "org_unit_id"),
+					resource.TestCheckResourceAttr(
+						"data.googleworkspace_org_unit.without-prefix", "org_unit_path", "/"+ouName),
 				),
 			},
 		},
@@ -61,6 +69,10 @@ resource "googleworkspace_org_unit" "my-new-org-unit" {
 data "googleworkspace_org_unit" "my-new-org-unit" {
   org_unit_id = googleworkspace_org_unit.my-new-org-unit.id
 }
+
+data "googleworkspace_org_unit" "without-prefix" {
+  org_unit_id = trimprefix(googleworkspace_org_unit.my-new-org-unit.org_unit_id, "id:")
+}
 `, ouName)
 }
 
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+func dataSourceOrgUnitUsers() *schema.Resource {
+	// Generate datasource schema from resource
+	dsUserSchema := datasourceSchemaFromResourceSchema(resourceUser().Schema)
+
+	dsSchema := map[string]*schema.Schema{
+		"org_unit_path": {
+			Description: "The full path of the organizational unit, e.g. `/corp/sales`.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"include_sub_org_units": {
+			Description: "If true, users in the sub-organizational units are returned as well.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"users": {
+			Description: "A list of User resources.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: dsUserSchema,
+			},
+		},
+	}
+	addLimitFieldToSchema(dsSchema, "users")
+
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Org Unit Users data source in the Terraform Googleworkspace provider. It returns the users " +
+			"that belong to an organizational unit. Org Unit Users resides under the " +
+			"`https://www.googleapis.com/auth/admin.directory.user` client scope.",
+
+		ReadContext: dataSourceOrgUnitUsersRead,
+
+		Schema: dsSchema,
+	}
+}
+
+func dataSourceOrgUnitUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	usersService, diags := GetUsersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	orgUnitPath := d.Get("org_unit_path").(string)
+	includeSubOrgUnits := d.Get("include_sub_org_units").(bool)
+
+	limit := d.Get("limit").(int)
+
+	var result []*directory.User
+	err := usersService.List().Customer(client.Customer).Projection("full").MaxResults(usersMaxResults).
+		Query(fmt.Sprintf("orgUnitPath=%s", celStringLiteral(orgUnitPath))).Pages(ctx, func(resp *directory.Users) error {
+		for _, user := range resp.Users {
+			// the query matches users in sub-organizational units as well
+			if includeSubOrgUnits || strings.EqualFold(user.OrgUnitPath, orgUnitPath) {
+				if limit > 0 && len(result) >= limit {
+					return errDataSourceLimitReached
+				}
+
+				result = append(result, user)
+			}
+		}
+
+		return nil
+	})
+
+	// a missing org unit is an error, as for the other data sources
+	if err != nil && !errors.Is(err, errDataSourceLimitReached) {
+		return apiErrorDiagnostics(err)
+	}
+
+	if err := d.Set("users", flattenUsers(result, client)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(orgUnitPath)
+
+	return diags
+}
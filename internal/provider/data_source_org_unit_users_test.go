@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceOrgUnitUsers(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testOrgUnitVals := map[string]interface{}{
+		"ouName":       fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"userEmail":    fmt.Sprintf("tf-test-%s@%s", acctest.RandString(10), domainName),
+		"subUserEmail": fmt.Sprintf("tf-test-%s@%s", acctest.RandString(10), domainName),
+		"password":     acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceOrgUnitUsers(testOrgUnitVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.googleworkspace_org_unit_users.direct", "users.#", "1"),
+					resource.TestCheckResourceAttr("data.googleworkspace_org_unit_users.direct",
+						"users.0.primary_email", testOrgUnitVals["userEmail"].(string)),
+					resource.TestCheckResourceAttr("data.googleworkspace_org_unit_users.recursive", "users.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceOrgUnitUsers(testOrgUnitVals map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_org_unit" "parent" {
+  name = "%{ouName}"
+  parent_org_unit_path = "/"
+}
+
+resource "googleworkspace_org_unit" "child" {
+  name = "%{ouName}-child"
+  parent_org_unit_path = googleworkspace_org_unit.parent.org_unit_path
+}
+
+resource "googleworkspace_user" "user" {
+  primary_email = "%{userEmail}"
+  password = "%{password}"
+  org_unit_path = googleworkspace_org_unit.parent.org_unit_path
+
+  name {
+    family_name = "Scott"
+    given_name = "Michael"
+  }
+}
+
+resource "googleworkspace_user" "sub-user" {
+  primary_email = "%{subUserEmail}"
+  password = "%{password}"
+  org_unit_path = googleworkspace_org_unit.child.org_unit_path
+
+  name {
+    family_name = "Schrute"
+    given_name = "Dwight"
+  }
+}
+
+data "googleworkspace_org_unit_users" "direct" {
+  org_unit_path = googleworkspace_org_unit.parent.org_unit_path
+
+  depends_on = [googleworkspace_user.user, googleworkspace_user.sub-user]
+}
+
+data "googleworkspace_org_unit_users" "recursive" {
+  org_unit_path         = googleworkspace_org_unit.parent.org_unit_path
+  include_sub_org_units = true
+
+  depends_on = [googleworkspace_user.user, googleworkspace_user.sub-user]
+}
+`, testOrgUnitVals)
+}
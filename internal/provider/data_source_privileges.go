@@ -78,7 +78,7 @@ func dataSourcePrivilegesRead(ctx context.Context, d *schema.ResourceData, meta
 
 	privileges, err := privilegesService.List(client.Customer).Do()
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	d.SetId(privileges.Etag)
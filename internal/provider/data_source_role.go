@@ -6,6 +6,7 @@ package googleworkspace
 import (
 	"context"
 	"errors"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,7 +16,11 @@ import (
 
 func dataSourceRole() *schema.Resource {
 	rSchema := datasourceSchemaFromResourceSchema(resourceRole().Schema)
-	addRequiredFieldsToSchema(rSchema, "name")
+	rSchema["role_id"] = &schema.Schema{
+		Description: "ID of the role.",
+		Type:        schema.TypeString,
+	}
+	addExactlyOneOfFieldsToSchema(rSchema, "role_id", "name")
 
 	return &schema.Resource{
 		Description: "Role data source in the Terraform Googleworkspace provider. Role resides " +
@@ -40,6 +45,17 @@ func dataSourceRoleRead(ctx context.Context, d *schema.ResourceData, meta interf
 		return diags
 	}
 
+	if roleId := d.Get("role_id").(string); roleId != "" {
+		role, err := rolesService.Get(client.Customer, roleId).Do()
+		if err != nil {
+			return apiErrorDiagnostics(err)
+		}
+
+		d.Set("role_id", roleId)
+
+		return setRole(d, role)
+	}
+
 	name := d.Get("name").(string)
 	var role *directory.Role
 	if err := rolesService.List(client.Customer).Pages(ctx, func(roles *directory.Roles) error {
@@ -51,13 +67,15 @@ func dataSourceRoleRead(ctx context.Context, d *schema.ResourceData, meta interf
 		}
 		return nil
 	}); role == nil && err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	if role == nil {
 		return diag.Errorf("No role with name %q", name)
 	}
 
+	d.Set("role_id", strconv.FormatInt(role.RoleId, 10))
+
 	if diags := setRole(d, role); diags.HasError() {
 		return diags
 	}
@@ -31,6 +31,30 @@ func TestAccDataSourceRole(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceRole_byId(t *testing.T) {
+	t.Parallel()
+
+	name := "_GROUPS_ADMIN_ROLE"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceRole_byId(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.googleworkspace_role.by-id", "name", name),
+					resource.TestCheckResourceAttrPair("data.googleworkspace_role.by-id", "role_id",
+						"data.googleworkspace_role.test", "role_id"),
+					resource.TestCheckResourceAttr("data.googleworkspace_role.by-id", "is_system_role", "true"),
+					resource.TestCheckResourceAttr("data.googleworkspace_role.by-id", "is_super_admin_role", "false"),
+					resource.TestCheckResourceAttr("data.googleworkspace_role.by-id", "privileges.#", "6"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDataSourceRole(name string) string {
 	return fmt.Sprintf(`
 data "googleworkspace_role" "test" {
@@ -38,3 +62,11 @@ data "googleworkspace_role" "test" {
 }
 `, name)
 }
+
+func testAccDataSourceRole_byId(name string) string {
+	return testAccDataSourceRole(name) + `
+data "googleworkspace_role" "by-id" {
+  role_id = data.googleworkspace_role.test.role_id
+}
+`
+}
@@ -6,6 +6,7 @@ package googleworkspace
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -16,6 +17,40 @@ func dataSourceSchema() *schema.Resource {
 	dsSchema := datasourceSchemaFromResourceSchema(resourceSchema().Schema)
 	addExactlyOneOfFieldsToSchema(dsSchema, "schema_id", "schema_name")
 
+	// fields is a set, these index its definitions by field name for plan-time checks of custom_schemas
+	dsSchema["field_names"] = &schema.Schema{
+		Description: "The names of the schema's fields, sorted.",
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+	dsSchema["field_types"] = &schema.Schema{
+		Description: "The types of the schema's fields, keyed by field name.",
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+	dsSchema["multi_valued_fields"] = &schema.Schema{
+		Description: "The names of the schema's multi-valued fields, sorted.",
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+	dsSchema["field_read_access_types"] = &schema.Schema{
+		Description: "The read access types of the schema's fields, keyed by field name.",
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+
 	return &schema.Resource{
 		// This description is used by the documentation generator and the language server.
 		Description: "Schema data source in the Terraform Googleworkspace provider. Schema resides " +
@@ -48,7 +83,7 @@ func dataSourceSchemaRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 		schema, err := schemasService.Get(client.Customer, d.Get("schema_name").(string)).Do()
 		if err != nil {
-			return diag.FromErr(err)
+			return apiErrorDiagnostics(err)
 		}
 
 		if schema == nil {
@@ -63,5 +98,33 @@ func dataSourceSchemaRead(ctx context.Context, d *schema.ResourceData, meta inte
 		d.SetId(schema.SchemaId)
 	}
 
-	return resourceSchemaRead(ctx, d, meta)
+	diags := withoutRemovedFromStateWarning(resourceSchemaRead(ctx, d, meta))
+	if diags.HasError() {
+		return diags
+	}
+
+	fieldNames := []string{}
+	fieldTypes := map[string]interface{}{}
+	multiValuedFields := []string{}
+	fieldReadAccessTypes := map[string]interface{}{}
+	for _, f := range d.Get("fields").(*schema.Set).List() {
+		field := f.(map[string]interface{})
+		name := field["field_name"].(string)
+
+		fieldNames = append(fieldNames, name)
+		fieldTypes[name] = field["field_type"]
+		fieldReadAccessTypes[name] = field["read_access_type"]
+		if field["multi_valued"].(bool) {
+			multiValuedFields = append(multiValuedFields, name)
+		}
+	}
+	sort.Strings(fieldNames)
+	sort.Strings(multiValuedFields)
+
+	d.Set("field_names", fieldNames)
+	d.Set("field_types", fieldTypes)
+	d.Set("multi_valued_fields", multiValuedFields)
+	d.Set("field_read_access_types", fieldReadAccessTypes)
+
+	return diags
 }
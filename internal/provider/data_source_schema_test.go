@@ -49,6 +49,10 @@ func TestAccDataSourceSchema_withName(t *testing.T) {
 						"data.googleworkspace_schema.my-schema", "fields.0.field_name", "birthday"),
 					resource.TestCheckResourceAttr(
 						"data.googleworkspace_schema.my-schema", "fields.0.field_type", "DATE"),
+					resource.TestCheckResourceAttr(
+						"data.googleworkspace_schema.my-schema", "field_names.0", "birthday"),
+					resource.TestCheckResourceAttr(
+						"data.googleworkspace_schema.my-schema", "field_types.birthday", "DATE"),
 				),
 			},
 		},
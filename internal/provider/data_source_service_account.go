@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceServiceAccount() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Service Account data source in the Terraform Googleworkspace provider. It resolves the email " +
+			"of a Google Cloud service account to its unique ID, which is the ID admin roles are assigned to with " +
+			"`googleworkspace_role_assignment`, e.g. to grant a service account delegated admin rights for API-only " +
+			"access. Please ensure the IAM API is enabled for your project and that the impersonated user or service " +
+			"account can get the service account. Service Account requires the " +
+			"`https://www.googleapis.com/auth/cloud-platform` client scope, which isn't one of the provider's default " +
+			"scopes and needs to be added to `oauth_scopes`.",
+
+		ReadContext: dataSourceServiceAccountRead,
+
+		Schema: map[string]*schema.Schema{
+			"email": {
+				Description: "The email address of the service account.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"unique_id": {
+				Description: "The unique, stable numeric ID of the service account, also known as its OAuth 2 client ID.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"display_name": {
+				Description: "The display name of the service account.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"project_id": {
+				Description: "The ID of the project that owns the service account.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"disabled": {
+				Description: "Whether the service account is disabled.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceServiceAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	iamService, diags := client.NewIamService()
+	if diags.HasError() {
+		return diags
+	}
+
+	serviceAccountsService, diags := GetIamServiceAccountsService(iamService)
+	if diags.HasError() {
+		return diags
+	}
+
+	email := d.Get("email").(string)
+	log.Printf("[DEBUG] Getting Service Account %q", email)
+
+	// the "-" wildcard lets the project be inferred from the service account
+	sa, err := serviceAccountsService.Get(fmt.Sprintf("projects/-/serviceAccounts/%s", email)).Context(ctx).Do()
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	d.SetId(sa.UniqueId)
+	d.Set("unique_id", sa.UniqueId)
+	d.Set("display_name", sa.DisplayName)
+	d.Set("project_id", sa.ProjectId)
+	d.Set("disabled", sa.Disabled)
+
+	log.Printf("[DEBUG] Finished getting Service Account %q", email)
+
+	return diags
+}
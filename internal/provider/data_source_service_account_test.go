@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceServiceAccount(t *testing.T) {
+	serviceAccount := os.Getenv("GOOGLEWORKSPACE_IMPERSONATED_SERVICE_ACCOUNT")
+
+	if serviceAccount == "" {
+		t.Skip("GOOGLEWORKSPACE_IMPERSONATED_SERVICE_ACCOUNT needs to be set to run this test")
+	}
+
+	data := map[string]interface{}{
+		"serviceAccount": serviceAccount,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceServiceAccount(data),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.googleworkspace_service_account.test", "unique_id"),
+					resource.TestCheckResourceAttrSet("data.googleworkspace_service_account.test", "project_id"),
+					resource.TestCheckResourceAttrPair("data.googleworkspace_service_account.test", "id",
+						"data.googleworkspace_service_account.test", "unique_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceServiceAccount(data map[string]interface{}) string {
+	return Nprintf(`
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/cloud-platform",
+  ]
+}
+
+data "googleworkspace_service_account" "test" {
+  email = "%{serviceAccount}"
+}
+`, data)
+}
@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+func dataSourceSuperAdmins() *schema.Resource {
+	adminSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "The unique ID of the user.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"primary_email": {
+			Description: "The user's primary email address.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"suspended": {
+			Description: "Indicates if the user is suspended.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"last_login_time": {
+			Description: "The last time the user logged into the user's account.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+
+	delegatedAdminSchema := map[string]*schema.Schema{
+		"roles": {
+			Description: "The roles assigned to the user.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"role_id": {
+						Description: "The ID of the role.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"role_name": {
+						Description: "The name of the role.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"scope_type": {
+						Description: "The scope the role is assigned in, `CUSTOMER` or `ORG_UNIT`.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"org_unit_id": {
+						Description: "The ID of the org unit the role is restricted to, if `scope_type` is `ORG_UNIT`.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+	for k, v := range adminSchema {
+		delegatedAdminSchema[k] = v
+	}
+
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Super Admins data source in the Terraform Googleworkspace provider. It returns the users with " +
+			"super admin privileges and, optionally, the delegated admins along with their roles, e.g. to audit " +
+			"break-glass accounts. Super Admins resides under the " +
+			"`https://www.googleapis.com/auth/admin.directory.user` client scope, listing delegated admins also " +
+			"requires the `https://www.googleapis.com/auth/admin.directory.rolemanagement` client scope.",
+
+		ReadContext: dataSourceSuperAdminsRead,
+
+		Schema: map[string]*schema.Schema{
+			"include_delegated_admins": {
+				Description: "If true, the delegated admins are returned as well.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"super_admins": {
+				Description: "The users with super admin privileges.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: adminSchema,
+				},
+			},
+			"delegated_admins": {
+				Description: "The users with delegated admin privileges, if `include_delegated_admins` is true.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: delegatedAdminSchema,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSuperAdminsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	usersService, diags := GetUsersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Getting Super Admins")
+
+	superAdmins, err := listAdminUsers(ctx, usersService, client.Customer, "isAdmin=true")
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	var result []interface{}
+	for _, user := range superAdmins {
+		result = append(result, flattenAdminUser(user))
+	}
+
+	if err := d.Set("super_admins", result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("include_delegated_admins").(bool) {
+		delegatedAdmins, diags := getDelegatedAdmins(ctx, directoryService, usersService, client.Customer)
+		if diags.HasError() {
+			return diags
+		}
+
+		if err := d.Set("delegated_admins", delegatedAdmins); err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		d.Set("delegated_admins", nil)
+	}
+
+	d.SetId(client.Customer)
+
+	log.Printf("[DEBUG] Finished getting Super Admins")
+
+	return diags
+}
+
+func getDelegatedAdmins(ctx context.Context, directoryService *directory.Service, usersService *directory.UsersService, customer string) ([]interface{}, diag.Diagnostics) {
+	rolesService, diags := GetRolesService(directoryService)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	roleAssignmentsService, diags := GetRoleAssignmentsService(directoryService)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	delegatedAdmins, err := listAdminUsers(ctx, usersService, customer, "isDelegatedAdmin=true")
+	if err != nil {
+		return nil, apiErrorDiagnostics(err)
+	}
+
+	roleNames := map[string]string{}
+	err = rolesService.List(customer).Pages(ctx, func(roles *directory.Roles) error {
+		for _, role := range roles.Items {
+			roleNames[strconv.FormatInt(role.RoleId, 10)] = role.RoleName
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, apiErrorDiagnostics(err)
+	}
+
+	// role assignments are listed once for the customer rather than once per delegated admin
+	userRoles := map[string][]interface{}{}
+	err = roleAssignmentsService.List(customer).Pages(ctx, func(roleAssignments *directory.RoleAssignments) error {
+		for _, ra := range roleAssignments.Items {
+			roleId := strconv.FormatInt(ra.RoleId, 10)
+			userRoles[ra.AssignedTo] = append(userRoles[ra.AssignedTo], map[string]interface{}{
+				"role_id":     roleId,
+				"role_name":   roleNames[roleId],
+				"scope_type":  ra.ScopeType,
+				"org_unit_id": ra.OrgUnitId,
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, apiErrorDiagnostics(err)
+	}
+
+	var result []interface{}
+	for _, user := range delegatedAdmins {
+		delegatedAdmin := flattenAdminUser(user)
+		delegatedAdmin["roles"] = userRoles[user.Id]
+
+		result = append(result, delegatedAdmin)
+	}
+
+	return result, nil
+}
+
+func listAdminUsers(ctx context.Context, usersService *directory.UsersService, customer, query string) ([]*directory.User, error) {
+	var result []*directory.User
+	err := usersService.List().Customer(customer).Query(query).MaxResults(usersMaxResults).
+		Fields("nextPageToken", "users(id,primaryEmail,suspended,lastLoginTime)").Pages(ctx, func(resp *directory.Users) error {
+		result = append(result, resp.Users...)
+
+		return nil
+	})
+
+	return result, err
+}
+
+func flattenAdminUser(user *directory.User) map[string]interface{} {
+	return map[string]interface{}{
+		"id":              user.Id,
+		"primary_email":   user.PrimaryEmail,
+		"suspended":       user.Suspended,
+		"last_login_time": user.LastLoginTime,
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSuperAdmins(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	data := map[string]interface{}{
+		"domainName":     domainName,
+		"adminEmail":     fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"delegatedEmail": fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":       acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSuperAdmins(data),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.googleworkspace_super_admins.test", "super_admins.*", map[string]string{
+						"primary_email": Nprintf("%{adminEmail}@%{domainName}", data),
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.googleworkspace_super_admins.test", "delegated_admins.*", map[string]string{
+						"primary_email":     Nprintf("%{delegatedEmail}@%{domainName}", data),
+						"roles.#":           "1",
+						"roles.0.role_name": "_GROUPS_ADMIN_ROLE",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSuperAdmins(data map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_user" "admin" {
+  primary_email = "%{adminEmail}@%{domainName}"
+  password = "%{password}"
+  is_admin = true
+
+  name {
+    family_name = "Scott"
+    given_name = "Michael"
+  }
+}
+
+resource "googleworkspace_user" "delegated" {
+  primary_email = "%{delegatedEmail}@%{domainName}"
+  password = "%{password}"
+
+  name {
+    family_name = "Schrute"
+    given_name = "Dwight"
+  }
+}
+
+data "googleworkspace_role" "groups_admin" {
+  name = "_GROUPS_ADMIN_ROLE"
+}
+
+resource "googleworkspace_role_assignment" "delegated" {
+  role_id     = data.googleworkspace_role.groups_admin.id
+  assigned_to = googleworkspace_user.delegated.id
+}
+
+data "googleworkspace_super_admins" "test" {
+  include_delegated_admins = true
+
+  depends_on = [googleworkspace_user.admin, googleworkspace_role_assignment.delegated]
+}
+`, data)
+}
@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+func dataSourceSystemRoles() *schema.Resource {
+	// Generate datasource schema from resource
+	dsRoleSchema := datasourceSchemaFromResourceSchema(resourceRole().Schema)
+
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "System Roles data source in the Terraform Googleworkspace provider. It lists the pre-defined " +
+			"system roles along with their privileges. System Roles resides under the " +
+			"`https://www.googleapis.com/auth/admin.directory.rolemanagement` client scope.",
+
+		ReadContext: dataSourceSystemRolesRead,
+
+		Schema: map[string]*schema.Schema{
+			"roles": {
+				Description: "A list of the pre-defined system roles.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: dsRoleSchema,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSystemRolesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	rolesService, diags := GetRolesService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	var result []*directory.Role
+	err := rolesService.List(client.Customer).Pages(ctx, func(roles *directory.Roles) error {
+		for _, role := range roles.Items {
+			if role.IsSystemRole {
+				result = append(result, role)
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if err := d.Set("roles", flattenRoles(result)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("system-roles")
+
+	return diags
+}
+
+func flattenRoles(roles []*directory.Role) interface{} {
+	var result []interface{}
+
+	for _, role := range roles {
+		result = append(result, flattenRole(role))
+	}
+
+	return result
+}
+
+func flattenRole(role *directory.Role) interface{} {
+	privileges := make([]interface{}, len(role.RolePrivileges))
+	for i, priv := range role.RolePrivileges {
+		privileges[i] = map[string]interface{}{
+			"service_id":     priv.ServiceId,
+			"privilege_name": priv.PrivilegeName,
+		}
+	}
+
+	result := map[string]interface{}{}
+	result["id"] = strconv.FormatInt(role.RoleId, 10)
+	result["name"] = role.RoleName
+	result["description"] = role.RoleDescription
+	result["privileges"] = privileges
+	result["is_system_role"] = role.IsSystemRole
+	result["is_super_admin_role"] = role.IsSuperAdminRole
+	result["etag"] = role.Etag
+
+	return result
+}
@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSystemRoles(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSystemRoles(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckFunc(testAccResourcePrivilegesCount("data.googleworkspace_system_roles.test", "roles.#")),
+					resource.TestCheckTypeSetElemNestedAttrs("data.googleworkspace_system_roles.test", "roles.*",
+						map[string]string{
+							"name":           "_GROUPS_ADMIN_ROLE",
+							"is_system_role": "true",
+							"privileges.#":   "6",
+						}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSystemRoles() string {
+	return `
+data "googleworkspace_system_roles" "test" {}
+`
+}
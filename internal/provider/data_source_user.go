@@ -47,7 +47,7 @@ func dataSourceUserRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 		user, err := usersService.Get(d.Get("primary_email").(string)).Do()
 		if err != nil {
-			return diag.FromErr(err)
+			return apiErrorDiagnostics(err)
 		}
 
 		if user == nil {
@@ -62,5 +62,5 @@ func dataSourceUserRead(ctx context.Context, d *schema.ResourceData, meta interf
 		d.SetId(user.Id)
 	}
 
-	return resourceUserRead(ctx, d, meta)
+	return withoutRemovedFromStateWarning(resourceUserRead(ctx, d, meta))
 }
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceUserAliases() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "User Aliases data source in the Terraform Googleworkspace provider. It returns the aliases of " +
+			"a user, including the ones managed outside of Terraform. User Aliases resides under the " +
+			"`https://www.googleapis.com/auth/admin.directory.user` client scope.",
+
+		ReadContext: dataSourceUserAliasesRead,
+
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Description:  "The unique ID of the user.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"user_id", "primary_email"},
+			},
+			"primary_email": {
+				Description:  "The user's primary email address, or one of their aliases.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"user_id", "primary_email"},
+			},
+			"aliases": {
+				Description: "The user's alias email addresses.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"non_editable_aliases": {
+				Description: "The user's non-editable alias email addresses. These are typically outside the " +
+					"account's primary domain or sub-domain.",
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUserAliasesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	usersService, diags := GetUsersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	userKey := d.Get("primary_email").(string)
+	if v, ok := d.GetOk("user_id"); ok {
+		userKey = v.(string)
+	}
+
+	user, err := usersService.Get(userKey).Fields("id", "primaryEmail", "aliases", "nonEditableAliases").Do()
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	d.Set("user_id", user.Id)
+	d.Set("primary_email", user.PrimaryEmail)
+	d.Set("aliases", user.Aliases)
+	d.Set("non_editable_aliases", user.NonEditableAliases)
+	d.SetId(user.Id)
+
+	return diags
+}
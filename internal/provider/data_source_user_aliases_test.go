@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceUserAliases(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testUserVals := map[string]interface{}{
+		"domainName": domainName,
+		"userEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":   acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceUserAliases(testUserVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.googleworkspace_user_aliases.by_email", "aliases.#", "1"),
+					resource.TestCheckResourceAttr("data.googleworkspace_user_aliases.by_email", "aliases.0",
+						Nprintf("%{userEmail}-alias@%{domainName}", testUserVals)),
+					resource.TestCheckResourceAttrPair("data.googleworkspace_user_aliases.by_email", "user_id",
+						"googleworkspace_user.my-new-user", "id"),
+					resource.TestCheckResourceAttr("data.googleworkspace_user_aliases.by_id", "primary_email",
+						Nprintf("%{userEmail}@%{domainName}", testUserVals)),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceUserAliases(testUserVals map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_user" "my-new-user" {
+  primary_email = "%{userEmail}@%{domainName}"
+  password = "%{password}"
+
+  name {
+    family_name = "Scott"
+    given_name = "Michael"
+  }
+
+  aliases = ["%{userEmail}-alias@%{domainName}"]
+}
+
+data "googleworkspace_user_aliases" "by_email" {
+  primary_email = googleworkspace_user.my-new-user.primary_email
+}
+
+data "googleworkspace_user_aliases" "by_id" {
+  user_id = googleworkspace_user.my-new-user.id
+}
+`, testUserVals)
+}
@@ -5,6 +5,7 @@ package googleworkspace
 
 import (
 	"context"
+	"errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	directory "google.golang.org/api/admin/directory/v1"
@@ -14,6 +15,18 @@ func dataSourceUsers() *schema.Resource {
 	// Generate datasource schema from resource
 	dsUserSchema := datasourceSchemaFromResourceSchema(resourceUser().Schema)
 
+	dsSchema := map[string]*schema.Schema{
+		"users": {
+			Description: "A list of User resources.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: dsUserSchema,
+			},
+		},
+	}
+	addLimitFieldToSchema(dsSchema, "users")
+
 	return &schema.Resource{
 		// This description is used by the documentation generator and the language server.
 		Description: "Users data source in the Terraform Googleworkspace provider. Users resides " +
@@ -21,16 +34,7 @@ func dataSourceUsers() *schema.Resource {
 
 		ReadContext: dataSourceUsersRead,
 
-		Schema: map[string]*schema.Schema{
-			"users": {
-				Description: "A list of User resources.",
-				Type:        schema.TypeList,
-				Computed:    true,
-				Elem: &schema.Resource{
-					Schema: dsUserSchema,
-				},
-			},
-		},
+		Schema: dsSchema,
 	}
 }
 
@@ -47,16 +51,22 @@ func dataSourceUsersRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return diags
 	}
 
+	limit := d.Get("limit").(int)
+
 	var result []*directory.User
-	err := usersService.List().Customer(client.Customer).Projection("full").Pages(ctx, func(resp *directory.Users) error {
+	err := usersService.List().Customer(client.Customer).Projection("full").MaxResults(dataSourcePageSize(limit, usersMaxResults)).Pages(ctx, func(resp *directory.Users) error {
 		for _, user := range resp.Users {
+			if limit > 0 && len(result) >= limit {
+				return errDataSourceLimitReached
+			}
+
 			result = append(result, user)
 		}
 
 		return nil
 	})
 
-	if err != nil {
+	if err != nil && !errors.Is(err, errDataSourceLimitReached) {
 		return handleNotFoundError(err, d, "users")
 	}
 
@@ -112,7 +122,7 @@ func flattenUser(user *directory.User, client *apiClient) interface{} {
 	result["suspension_reason"] = user.SuspensionReason
 	result["thumbnail_photo_url"] = user.ThumbnailPhotoUrl
 	result["languages"] = flattenInterfaceObjects(user.Languages)
-	result["posix_accounts"] = flattenInterfaceObjects(user.PosixAccounts)
+	result["posix_accounts"] = flattenUserPosixAccounts(user.PosixAccounts)
 	result["creation_time"] = user.CreationTime
 	result["non_editable_aliases"] = user.NonEditableAliases
 	result["ssh_public_keys"] = flattenInterfaceObjects(user.SshPublicKeys)
@@ -124,6 +134,7 @@ func flattenUser(user *directory.User, client *apiClient) interface{} {
 	result["thumbnail_photo_etag"] = user.ThumbnailPhotoEtag
 	result["ims"] = flattenInterfaceObjects(user.Ims)
 	result["custom_schemas"] = customSchemas
+	result["custom_schema_values"] = flattenCustomSchemaValuesMap(user.CustomSchemas)
 	result["is_enrolled_in_2_step_verification"] = user.IsEnrolledIn2Sv
 	result["is_enforced_in_2_step_verification"] = user.IsEnforcedIn2Sv
 	result["archived"] = user.Archived
@@ -39,6 +39,7 @@ func TestAccDataSourceUsers(t *testing.T) {
 						"users.#"),
 					resource.TestMatchResourceAttr("data.googleworkspace_users.users",
 						"users.0.primary_email", regexp.MustCompile(fmt.Sprintf("^.*@%s$", domainName))),
+					resource.TestCheckResourceAttr("data.googleworkspace_users.limited", "users.#", "1"),
 				),
 			},
 		},
@@ -51,5 +52,11 @@ func testAccDataSourceUsers(testUserVals map[string]interface{}) string {
 data "googleworkspace_users" "users" {
   depends_on = [googleworkspace_user.my-new-user]
 }
+
+data "googleworkspace_users" "limited" {
+  limit = 1
+
+  depends_on = [googleworkspace_user.my-new-user]
+}
 `
 }
@@ -4,9 +4,31 @@
 package googleworkspace
 
 import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Page sizes of the plural datasources, these are the maximums allowed by the APIs
+// so that large tenants need as few requests as possible
+const (
+	buildingsMaxResults          = 500
+	calendarFeaturesMaxResults   = 500
+	calendarResourcesMaxResults  = 500
+	devicesMaxResults            = 100
+	groupsMaxResults             = 200
+	licenseAssignmentsMaxResults = 1000
+	membersMaxResults            = 200
+	usersMaxResults              = 500
 )
 
+// errDataSourceLimitReached is returned from a Pages callback to stop paginating
+// once a datasource collected `limit` results
+var errDataSourceLimitReached = errors.New("datasource limit reached")
+
 // datasourceSchemaFromResourceSchema is a recursive func that
 // converts an existing Resource schema to a Datasource schema.
 // All schema elements are copied, but certain attributes are ignored or changed:
@@ -82,3 +104,37 @@ func addExactlyOneOfFieldsToSchema(schema map[string]*schema.Schema, keys ...str
 		schema[v].ExactlyOneOf = keys
 	}
 }
+
+// addLimitFieldToSchema adds an optional `limit` argument to a plural datasource
+func addLimitFieldToSchema(s map[string]*schema.Schema, kind string) {
+	s["limit"] = &schema.Schema{
+		Description:      fmt.Sprintf("The maximum number of %s to return. All %s are returned if unset.", kind, kind),
+		Type:             schema.TypeInt,
+		Optional:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+	}
+}
+
+// dataSourcePageSize returns the page size to request, which doesn't need to exceed the limit
+func dataSourcePageSize(limit, maxResults int) int64 {
+	if limit > 0 && limit < maxResults {
+		return int64(limit)
+	}
+
+	return int64(maxResults)
+}
+
+// withoutRemovedFromStateWarning drops the warning of resource reads about resources deleted outside of
+// Terraform, which doesn't apply to datasources reusing them
+func withoutRemovedFromStateWarning(diags diag.Diagnostics) diag.Diagnostics {
+	var filtered diag.Diagnostics
+	for _, d := range diags {
+		if d.Severity == diag.Warning && d.Detail == removedFromStateDetail {
+			continue
+		}
+
+		filtered = append(filtered, d)
+	}
+
+	return filtered
+}
@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"testing"
+)
+
+func TestDataSourcePageSize(t *testing.T) {
+	cases := map[string]struct {
+		limit, maxResults int
+		expected          int64
+	}{
+		"no limit":           {limit: 0, maxResults: 500, expected: 500},
+		"limit below max":    {limit: 10, maxResults: 500, expected: 10},
+		"limit above max":    {limit: 1000, maxResults: 500, expected: 500},
+		"limit equal to max": {limit: 200, maxResults: 200, expected: 200},
+	}
+
+	for name, tc := range cases {
+		if got := dataSourcePageSize(tc.limit, tc.maxResults); got != tc.expected {
+			t.Errorf("%s: expected %d, got %d", name, tc.expected, got)
+		}
+	}
+}
@@ -4,17 +4,38 @@
 package googleworkspace
 
 import (
+	"context"
+	"math/rand"
+	"sync"
 	"time"
 )
 
 // The number of consistent responses we want before we consider the resource consistent
 const numConsistent = 4
 
+// Consistency checks only compare etags, so the polls only request the etag
+// rather than transferring the whole resource on every attempt
+const consistencyCheckFields = "etag"
+
+// Most changes propagate within a few seconds, so polls start quickly and then back off
+const (
+	consistencyPollMinInterval = time.Second
+	consistencyPollMaxInterval = time.Second * 10
+)
+
+var (
+	consistencyPollRandMu sync.Mutex
+	consistencyPollRand   = rand.New(rand.NewSource(time.Now().UnixNano()))
+)
+
 type consistencyCheck struct {
 	currConsistent int
 	etagChanges    int
 	lastEtag       string
-	resourceType   string
+	// consistentSince is when the current run of consistent responses started, that is
+	// roughly when the etag last changed or the resource was last not found
+	consistentSince time.Time
+	resourceType    string
 	// timeout should be set to the timeout of the action
 	timeout time.Duration
 }
@@ -24,15 +45,17 @@ func (cc *consistencyCheck) reachedConsistency(numInserts int) bool {
 	// However, there are cases where we'll have multiple Inserts, and the
 	// initial changes were already consistent by the time the latter
 	// inserts happened, thus once we start polling, those changes
-	// wouldn't be counted. In which case, we have a maxConsistent
-	// that we'll assume is good.
+	// wouldn't be counted. In which case, we assume the resource is
+	// consistent once it has stayed the same for half of the timeout.
 
-	// max consistent will be set to the timeout * 6 / 2 (or, every 10 seconds for half the timeout time)
-	// so that it checks that at least the last half of responses were consistent
-	maxConsistent := int(cc.timeout.Minutes()) * 6 / 2
+	// the polls are jittered and back off, so how long the resource stayed the same is measured
+	// from the start of the current run of consistent responses rather than counted in polls
+	if cc.currConsistent == 0 || cc.consistentSince.IsZero() {
+		cc.consistentSince = time.Now()
+	}
 
 	return (cc.currConsistent == numConsistent && cc.etagChanges >= numInserts) ||
-		cc.currConsistent >= maxConsistent
+		(cc.currConsistent > 0 && time.Since(cc.consistentSince) >= cc.timeout/2)
 }
 
 func (cc *consistencyCheck) handleNewEtag(etag string) {
@@ -40,3 +63,39 @@ func (cc *consistencyCheck) handleNewEtag(etag string) {
 	cc.lastEtag = etag
 	cc.etagChanges += 1
 }
+
+// retryConsistencyCheck calls checkFunc until it succeeds, like retryTimeDuration it keeps
+// retrying as long as checkFunc reports the resource isn't consistent yet. The interval
+// between polls grows exponentially and is jittered, so that large applies waiting on many
+// resources at once don't poll in lockstep.
+func retryConsistencyCheck(ctx context.Context, timeout time.Duration, checkFunc func() error) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	interval := consistencyPollMinInterval
+	for {
+		err := checkFunc()
+		if err == nil || !IsNotConsistent(err) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(jitterConsistencyPollInterval(interval)):
+		}
+
+		interval *= 2
+		if interval > consistencyPollMaxInterval {
+			interval = consistencyPollMaxInterval
+		}
+	}
+}
+
+// jitterConsistencyPollInterval returns a random duration between half of the interval and the interval
+func jitterConsistencyPollInterval(interval time.Duration) time.Duration {
+	consistencyPollRandMu.Lock()
+	defer consistencyPollRandMu.Unlock()
+
+	return interval/2 + time.Duration(consistencyPollRand.Int63n(int64(interval/2)+1))
+}
@@ -4,6 +4,8 @@
 package googleworkspace
 
 import (
+	"context"
+	"fmt"
 	"testing"
 	"time"
 )
@@ -28,7 +30,8 @@ func TestConsistencyCheckReachedConsistency(t *testing.T) {
 	// We'll assume it's consistent and that one of the inserts already contained
 	// and updated etag that we're missing
 	cc.etagChanges = 2
-	cc.currConsistent = 18
+	cc.currConsistent = 6
+	cc.consistentSince = time.Now().Add(-time.Minute * 3)
 
 	if !cc.reachedConsistency(numInserts) {
 		t.Errorf("Failed: did not reach consistency (numInserts: %d, currConsistent: %d, etagChanges: %d, timeout: %d)", numInserts, cc.currConsistent, cc.etagChanges, int(cc.timeout.Minutes()))
@@ -37,6 +40,7 @@ func TestConsistencyCheckReachedConsistency(t *testing.T) {
 	// We've seen all the inserts come through, but we haven't had 4 consistent tags yet
 	cc.etagChanges = 3
 	cc.currConsistent = 1
+	cc.consistentSince = time.Now()
 
 	if cc.reachedConsistency(numInserts) {
 		t.Errorf("Failed: reached consistency (numInserts: %d, currConsistent: %d, etagChanges: %d, timeout: %d)", numInserts, cc.currConsistent, cc.etagChanges, int(cc.timeout.Minutes()))
@@ -50,6 +54,31 @@ func TestConsistencyCheckReachedConsistency(t *testing.T) {
 	}
 }
 
+func TestConsistencyCheckReachedConsistencyRestarts(t *testing.T) {
+	cc := consistencyCheck{
+		timeout:         time.Duration(time.Minute * 5),
+		currConsistent:  20,
+		etagChanges:     1,
+		lastEtag:        "12345",
+		consistentSince: time.Now().Add(-time.Minute),
+	}
+
+	// many quick polls don't make up for half of the timeout
+	if cc.reachedConsistency(3) {
+		t.Errorf("Failed: reached consistency after being consistent for a minute of a %s timeout", cc.timeout)
+	}
+
+	// a new etag restarts the time the resource has been consistent for
+	cc.consistentSince = time.Now().Add(-time.Minute * 3)
+	cc.handleNewEtag("abcde")
+	if cc.reachedConsistency(3) {
+		t.Error("Failed: reached consistency right after a new etag")
+	}
+	if time.Since(cc.consistentSince) > time.Minute {
+		t.Errorf("Failed: a new etag didn't restart the consistent run, started %s ago", time.Since(cc.consistentSince))
+	}
+}
+
 func TestConsistencyHandleNewEtag(t *testing.T) {
 	cc := consistencyCheck{
 		resourceType: "test",
@@ -70,3 +99,52 @@ func TestConsistencyHandleNewEtag(t *testing.T) {
 		t.Errorf("Failed ['abcde']: shows more/less etag changes (expected: %d, got: %d)", 3, cc.etagChanges)
 	}
 }
+
+func TestRetryConsistencyCheck(t *testing.T) {
+	attempts := 0
+	err := retryConsistencyCheck(context.Background(), time.Minute, func() error {
+		attempts++
+		if attempts < 3 {
+			return fmt.Errorf("timed out while waiting for test to be inserted")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts)
+	}
+
+	// other errors aren't retried
+	attempts = 0
+	err = retryConsistencyCheck(context.Background(), time.Minute, func() error {
+		attempts++
+		return fmt.Errorf("unexpected error during retries of test")
+	})
+	if err == nil {
+		t.Fatal("expected an error, got none")
+	}
+	if attempts != 1 {
+		t.Errorf("expected 1 attempt, got %d", attempts)
+	}
+
+	// the last consistency error is returned once the timeout is reached
+	err = retryConsistencyCheck(context.Background(), time.Second*2, func() error {
+		return fmt.Errorf("timed out while waiting for test to be inserted")
+	})
+	if err == nil || !IsNotConsistent(err) {
+		t.Errorf("expected a consistency error, got: %v", err)
+	}
+}
+
+func TestJitterConsistencyPollInterval(t *testing.T) {
+	interval := time.Second * 10
+
+	for i := 0; i < 100; i++ {
+		jittered := jitterConsistencyPollInterval(interval)
+		if jittered < interval/2 || jittered > interval {
+			t.Fatalf("expected an interval between %s and %s, got %s", interval/2, interval, jittered)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// groupMembershipGraph holds the group memberships planned by the group member resources, that is those
+// remaining after the plan. Memberships are only ever added to it, as the provider is configured again for
+// each plan and apply, and memberships destroyed by the plan aren't planned. Cycles between groups created
+// in the same apply can't be found with the API, as the groups don't exist yet when planning.
+type groupMembershipGraph struct {
+	mu sync.Mutex
+
+	// emails maps the IDs and aliases of groups to their primary email
+	emails map[string]string
+	// members maps the email of groups to the emails of the groups that are members of it
+	members map[string]map[string]bool
+}
+
+// setGroup records the ID and aliases of a group, so that memberships referencing them are matched
+func (g *groupMembershipGraph) setGroup(id, email string, aliases []string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.emails == nil {
+		g.emails = make(map[string]string)
+	}
+
+	email = strings.ToLower(email)
+	g.emails[strings.ToLower(id)] = email
+	for _, alias := range aliases {
+		g.emails[strings.ToLower(alias)] = email
+	}
+}
+
+// checkAndAddMembership returns an error naming the groups of the cycle if the group memberEmail can't be a
+// member of the group groupKey, and adds the membership otherwise. Checking and adding is atomic, so
+// memberships planned concurrently that form a cycle are reported.
+func (g *groupMembershipGraph) checkAndAddMembership(groupKey, memberEmail string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	group, member := g.resolve(groupKey), g.resolve(memberEmail)
+	if group == member {
+		return fmt.Errorf("group %s can't be a member of itself", memberEmail)
+	}
+
+	if path := g.path(member, group, map[string]bool{}); path != nil {
+		return fmt.Errorf("adding group %s to group %s would create a membership cycle, each group being a "+
+			"member of the previous one: %s", member, group, strings.Join(append([]string{group}, path...), " > "))
+	}
+
+	g.add(group, member)
+
+	return nil
+}
+
+// addMembership adds a membership without checking it, for memberships the plan doesn't change, so that
+// planned memberships forming a cycle with them are still reported
+func (g *groupMembershipGraph) addMembership(groupKey, memberEmail string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.add(g.resolve(groupKey), g.resolve(memberEmail))
+}
+
+func (g *groupMembershipGraph) resolve(key string) string {
+	key = strings.ToLower(key)
+	if email, ok := g.emails[key]; ok {
+		return email
+	}
+
+	return key
+}
+
+func (g *groupMembershipGraph) add(group, member string) {
+	if g.members == nil {
+		g.members = make(map[string]map[string]bool)
+	}
+
+	if g.members[group] == nil {
+		g.members[group] = make(map[string]bool)
+	}
+
+	g.members[group][member] = true
+}
+
+// path returns the groups from the group from to the group to, each one being a member of the previous one,
+// or nil if to isn't a direct or indirect member of from
+func (g *groupMembershipGraph) path(from, to string, visited map[string]bool) []string {
+	if from == to {
+		return []string{to}
+	}
+
+	visited[from] = true
+	for member := range g.members[from] {
+		if visited[member] {
+			continue
+		}
+
+		if path := g.path(member, to, visited); path != nil {
+			return append([]string{from}, path...)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGroupMembershipGraphCycle(t *testing.T) {
+	g := groupMembershipGraph{}
+
+	if err := g.checkAndAddMembership("a@example.com", "b@example.com"); err != nil {
+		t.Fatalf("a first membership returned an error: %s", err)
+	}
+	if err := g.checkAndAddMembership("b@example.com", "c@example.com"); err != nil {
+		t.Fatalf("a membership without a cycle returned an error: %s", err)
+	}
+
+	err := g.checkAndAddMembership("c@example.com", "A@example.com")
+	if err == nil {
+		t.Fatal("a membership cycle wasn't reported")
+	}
+	if !strings.Contains(err.Error(), "c@example.com > a@example.com > b@example.com > c@example.com") {
+		t.Errorf("the cycle wasn't named in the error: %s", err)
+	}
+
+	if err := g.checkAndAddMembership("a@example.com", "a@example.com"); err == nil {
+		t.Error("a group being a member of itself wasn't reported")
+	}
+
+	if err := g.checkAndAddMembership("a@example.com", "b@example.com"); err != nil {
+		t.Errorf("planning a membership again returned an error: %s", err)
+	}
+}
+
+func TestGroupMembershipGraphIdsAndAliases(t *testing.T) {
+	g := groupMembershipGraph{}
+
+	g.setGroup("01abc", "a@example.com", []string{"alias-a@example.com"})
+	if err := g.checkAndAddMembership("01abc", "b@example.com"); err != nil {
+		t.Fatalf("a membership without a cycle returned an error: %s", err)
+	}
+
+	if err := g.checkAndAddMembership("b@example.com", "alias-a@example.com"); err == nil {
+		t.Error("a membership cycle through the ID and alias of a group wasn't reported")
+	}
+}
+
+func TestGroupMembershipGraphUnchangedMemberships(t *testing.T) {
+	g := groupMembershipGraph{}
+
+	g.addMembership("a@example.com", "b@example.com")
+	g.addMembership("b@example.com", "a@example.com")
+
+	if err := g.checkAndAddMembership("b@example.com", "c@example.com"); err != nil {
+		t.Fatalf("a membership without a cycle returned an error: %s", err)
+	}
+
+	if err := g.checkAndAddMembership("c@example.com", "a@example.com"); err == nil {
+		t.Error("a membership cycle through unchanged memberships wasn't reported")
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"net/http"
+	"strings"
+)
+
+const requestReasonHeader = "X-Goog-Request-Reason"
+
+// headersTransport adds the provider's user agent and the configured request reason to each
+// request, so traffic from the provider can be attributed in audit logs
+type headersTransport struct {
+	userAgent     string
+	requestReason string
+	transport     http.RoundTripper
+}
+
+func NewTransportWithHeaders(userAgent, requestReason string, t http.RoundTripper) *headersTransport {
+	return &headersTransport{
+		userAgent:     userAgent,
+		requestReason: requestReason,
+		transport:     t,
+	}
+}
+
+func (t *headersTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.userAgent == "" && t.requestReason == "" {
+		return t.transport.RoundTrip(req)
+	}
+
+	// RoundTrip must not modify the original request
+	newReq := req.Clone(req.Context())
+
+	if t.userAgent != "" {
+		// keep the user agent set by the API client, which identifies the client library
+		userAgent := strings.TrimSpace(strings.Join([]string{t.userAgent, newReq.Header.Get("User-Agent")}, " "))
+		newReq.Header.Set("User-Agent", userAgent)
+	}
+
+	if t.requestReason != "" {
+		newReq.Header.Set(requestReasonHeader, t.requestReason)
+	}
+
+	return t.transport.RoundTrip(newReq)
+}
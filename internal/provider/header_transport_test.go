@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHeadersTransport(t *testing.T) {
+	var userAgent, requestReason string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.Header.Get("User-Agent")
+		requestReason = r.Header.Get(requestReasonHeader)
+	}))
+	defer ts.Close()
+
+	client := ts.Client()
+	client.Transport = NewTransportWithHeaders("terraform-provider-googleworkspace/dev my-pipeline", "CHG-1234", http.DefaultTransport)
+
+	req, err := http.NewRequest("GET", ts.URL, nil)
+	if err != nil {
+		t.Fatalf("unable to construct request: %v", err)
+	}
+	req.Header.Set("User-Agent", "google-api-go-client/0.5")
+
+	if _, err := client.Do(req); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	if expected := "terraform-provider-googleworkspace/dev my-pipeline google-api-go-client/0.5"; userAgent != expected {
+		t.Errorf("expected user agent %q, got %q", expected, userAgent)
+	}
+
+	if requestReason != "CHG-1234" {
+		t.Errorf("expected request reason %q, got %q", "CHG-1234", requestReason)
+	}
+
+	if req.Header.Get(requestReasonHeader) != "" {
+		t.Errorf("expected the original request not to be modified")
+	}
+}
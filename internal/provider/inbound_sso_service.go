@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	cloudIdentityBasePath = "https://cloudidentity.googleapis.com/"
+
+	cloudIdentityOperationTimeout = 5 * time.Minute
+)
+
+// inboundSsoService is a client of the Cloud Identity API's inbound SSO methods, which aren't
+// available in the version of google.golang.org/api/cloudidentity/v1 the provider depends on.
+type inboundSsoService struct {
+	restService
+}
+
+// inboundSamlSsoProfile is a SAML 2.0 identity provider configuration,
+// see https://cloud.google.com/identity/docs/reference/rest/v1/inboundSamlSsoProfiles
+type inboundSamlSsoProfile struct {
+	Name        string         `json:"name,omitempty"`
+	Customer    string         `json:"customer,omitempty"`
+	DisplayName string         `json:"displayName,omitempty"`
+	IdpConfig   *samlIdpConfig `json:"idpConfig,omitempty"`
+	SpConfig    *samlSpConfig  `json:"spConfig,omitempty"`
+}
+
+type samlIdpConfig struct {
+	EntityId               string `json:"entityId,omitempty"`
+	SingleSignOnServiceUri string `json:"singleSignOnServiceUri,omitempty"`
+	LogoutRedirectUri      string `json:"logoutRedirectUri,omitempty"`
+	ChangePasswordUri      string `json:"changePasswordUri,omitempty"`
+}
+
+type samlSpConfig struct {
+	EntityId                    string `json:"entityId,omitempty"`
+	AssertionConsumerServiceUri string `json:"assertionConsumerServiceUri,omitempty"`
+}
+
+// idpCredential is an X.509 certificate of a SAML identity provider, used to verify its
+// assertions, see https://cloud.google.com/identity/docs/reference/rest/v1/inboundSamlSsoProfiles.idpCredentials
+type idpCredential struct {
+	Name       string      `json:"name,omitempty"`
+	UpdateTime string      `json:"updateTime,omitempty"`
+	RsaKeyInfo *idpKeyInfo `json:"rsaKeyInfo,omitempty"`
+	DsaKeyInfo *idpKeyInfo `json:"dsaKeyInfo,omitempty"`
+}
+
+type idpKeyInfo struct {
+	KeySize int `json:"keySize,omitempty"`
+}
+
+// cloudIdentityOperation is the long running operation returned by the methods modifying
+// inbound SSO configurations
+type cloudIdentityOperation struct {
+	Name     string                        `json:"name,omitempty"`
+	Done     bool                          `json:"done,omitempty"`
+	Error    *cloudIdentityOperationStatus `json:"error,omitempty"`
+	Response json.RawMessage               `json:"response,omitempty"`
+}
+
+type cloudIdentityOperationStatus struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+func (s *inboundSsoService) CreateProfile(ctx context.Context, profile *inboundSamlSsoProfile) (*inboundSamlSsoProfile, error) {
+	var op cloudIdentityOperation
+	if err := s.do(ctx, "POST", "v1/inboundSamlSsoProfiles", nil, profile, &op); err != nil {
+		return nil, err
+	}
+
+	var result inboundSamlSsoProfile
+	if err := s.waitOperation(ctx, &op, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (s *inboundSsoService) GetProfile(ctx context.Context, name string) (*inboundSamlSsoProfile, error) {
+	var result inboundSamlSsoProfile
+	if err := s.do(ctx, "GET", "v1/"+name, nil, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (s *inboundSsoService) PatchProfile(ctx context.Context, name string, profile *inboundSamlSsoProfile, updateMask []string) error {
+	params := url.Values{}
+	params.Set("updateMask", strings.Join(updateMask, ","))
+
+	var op cloudIdentityOperation
+	if err := s.do(ctx, "PATCH", "v1/"+name, params, profile, &op); err != nil {
+		return err
+	}
+
+	return s.waitOperation(ctx, &op, nil)
+}
+
+func (s *inboundSsoService) DeleteProfile(ctx context.Context, name string) error {
+	var op cloudIdentityOperation
+	if err := s.do(ctx, "DELETE", "v1/"+name, nil, nil, &op); err != nil {
+		return err
+	}
+
+	return s.waitOperation(ctx, &op, nil)
+}
+
+func (s *inboundSsoService) AddIdpCredential(ctx context.Context, profile string, pemData string) (*idpCredential, error) {
+	var op cloudIdentityOperation
+	if err := s.do(ctx, "POST", "v1/"+profile+"/idpCredentials:add", nil, map[string]string{"pemData": pemData}, &op); err != nil {
+		return nil, err
+	}
+
+	var result idpCredential
+	if err := s.waitOperation(ctx, &op, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (s *inboundSsoService) GetIdpCredential(ctx context.Context, name string) (*idpCredential, error) {
+	var result idpCredential
+	if err := s.do(ctx, "GET", "v1/"+name, nil, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (s *inboundSsoService) DeleteIdpCredential(ctx context.Context, name string) error {
+	var op cloudIdentityOperation
+	if err := s.do(ctx, "DELETE", "v1/"+name, nil, nil, &op); err != nil {
+		return err
+	}
+
+	return s.waitOperation(ctx, &op, nil)
+}
+
+// waitOperation polls the operation until it's done and decodes its response into result
+func (s *inboundSsoService) waitOperation(ctx context.Context, op *cloudIdentityOperation, result interface{}) error {
+	err := retryConsistencyCheck(ctx, cloudIdentityOperationTimeout, func() error {
+		if op.Done {
+			return nil
+		}
+
+		if err := s.do(ctx, "GET", "v1/"+op.Name, nil, nil, op); err != nil {
+			return err
+		}
+
+		if !op.Done {
+			return fmt.Errorf("timed out while waiting for operation %s to complete", op.Name)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if op.Error != nil {
+		return fmt.Errorf("operation %s failed with code %d: %s", op.Name, op.Error.Code, op.Error.Message)
+	}
+
+	if result == nil || len(op.Response) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(op.Response, result)
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestInboundSsoService_CreateProfileWaitsForOperation(t *testing.T) {
+	polls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/v1/inboundSamlSsoProfiles":
+			fmt.Fprint(w, `{"name": "operations/abc", "done": false}`)
+		case r.Method == "GET" && r.URL.Path == "/v1/operations/abc":
+			polls++
+			fmt.Fprint(w, `{"name": "operations/abc", "done": true, "response": {"name": "inboundSamlSsoProfiles/123", "displayName": "Okta"}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	service := &inboundSsoService{restService{client: ts.Client(), BasePath: ts.URL + "/"}}
+
+	profile, err := service.CreateProfile(context.Background(), &inboundSamlSsoProfile{DisplayName: "Okta"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if polls != 1 {
+		t.Errorf("expected the operation to be polled once, got %d", polls)
+	}
+
+	if profile.Name != "inboundSamlSsoProfiles/123" || profile.DisplayName != "Okta" {
+		t.Errorf("unexpected profile: %#v", profile)
+	}
+}
+
+func TestInboundSsoService_operationError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"name": "operations/abc", "done": true, "error": {"code": 3, "message": "invalid entity id"}}`)
+	}))
+	defer ts.Close()
+
+	service := &inboundSsoService{restService{client: ts.Client(), BasePath: ts.URL + "/"}}
+
+	err := service.DeleteProfile(context.Background(), "inboundSamlSsoProfiles/123")
+	if err == nil {
+		t.Fatalf("expected the operation's error to be returned")
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"log"
+	"strings"
+	"sync"
+)
+
+// mutexKV is a set of mutexes identified by key, used to serialize writes to the same
+// object across resources while writes to different objects still run in parallel.
+type mutexKV struct {
+	lock  sync.Mutex
+	store map[string]*sync.Mutex
+}
+
+func newMutexKV() *mutexKV {
+	return &mutexKV{
+		store: make(map[string]*sync.Mutex),
+	}
+}
+
+// Lock locks the mutex for the given key, creating it if necessary
+func (m *mutexKV) Lock(key string) {
+	log.Printf("[DEBUG] Locking %q", key)
+	m.get(key).Lock()
+	log.Printf("[DEBUG] Locked %q", key)
+}
+
+// Unlock unlocks the mutex for the given key, it panics if the key isn't locked
+func (m *mutexKV) Unlock(key string) {
+	log.Printf("[DEBUG] Unlocking %q", key)
+	m.get(key).Unlock()
+	log.Printf("[DEBUG] Unlocked %q", key)
+}
+
+func (m *mutexKV) get(key string) *sync.Mutex {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	mutex, ok := m.store[key]
+	if !ok {
+		mutex = &sync.Mutex{}
+		m.store[key] = mutex
+	}
+
+	return mutex
+}
+
+// groupMembersMutexKV serializes membership writes per group, as concurrent inserts into the
+// same group intermittently fail with 409 or 412 errors
+var groupMembersMutexKV = newMutexKV()
+
+// groupMembersMutexKey returns the key membership writes to the group are serialized on. Group
+// keys are case-insensitive, a group referenced by email and by ID is locked separately.
+func groupMembersMutexKey(groupKey string) string {
+	return "groups/" + strings.ToLower(groupKey)
+}
@@ -65,6 +65,16 @@ func New(version string) func() *schema.Provider {
 					Optional: true,
 				},
 
+				"check_enabled_apis": {
+					Description: "Check the APIs most resources depend on, the Admin SDK, Groups Settings, Chrome Policy, " +
+						"Enterprise License Manager and Gmail APIs, are enabled in the Google Cloud project of the credentials " +
+						"when the provider is configured, so all the disabled APIs are reported at once instead of failing " +
+						"one resource at a time mid-apply. The Gmail API is only checked if `impersonated_user_email` is set.",
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
 				"credentials": {
 					Description: "Either the path to or the contents of a service account key file in JSON format " +
 						"you can manage key files using the Cloud Console).  If not provided, the application default " +
@@ -89,6 +99,17 @@ func New(version string) func() *schema.Provider {
 					Optional: true,
 				},
 
+				"custom_endpoint": {
+					Description: "The base URL requests are sent to instead of the Google APIs, for instance the address of " +
+						"`go run ./scripts/fakeworkspace`, a local fake of the APIs for testing. When set, requests are sent " +
+						"without the provider's credentials, which are ignored, so it can't be used with the real APIs.",
+					Type: schema.TypeString,
+					DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+						"GOOGLEWORKSPACE_CUSTOM_ENDPOINT",
+					}, nil),
+					Optional: true,
+				},
+
 				"impersonated_user_email": {
 					Description: "The impersonated user's email with access to the Admin APIs can access the Admin SDK Directory API. " +
 						"`impersonated_user_email` is required for all services except group and user management.",
@@ -107,6 +128,17 @@ func New(version string) func() *schema.Provider {
 					Elem:     &schema.Schema{Type: schema.TypeString},
 				},
 
+				"request_reason": {
+					Description: "A reason sent with every request in the `X-Goog-Request-Reason` header, such as a ticket " +
+						"or change id, so requests can be traced back to their origin in audit logs and by Google support.",
+					Type: schema.TypeString,
+					DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+						"GOOGLEWORKSPACE_REQUEST_REASON",
+						"CLOUDSDK_CORE_REQUEST_REASON",
+					}, nil),
+					Optional: true,
+				},
+
 				"service_account": {
 					Description: "The service account used to create the provided `access_token` if authenticating using " +
 						"the `access_token` method and needing to impersonate a user. This service account will require the " +
@@ -114,40 +146,124 @@ func New(version string) func() *schema.Provider {
 					Type:     schema.TypeString,
 					Optional: true,
 				},
+
+				"skip_user_type_validation": {
+					Description: "Skip the plan-time validation of the types of `googleworkspace_user` blocks, such as " +
+						"`emails`, `phones` and `relations`, so values the API accepts but the provider doesn't know yet " +
+						"are passed through as is.",
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"enforce_unique_posix_uids": {
+					Description: "Fail the plan when `googleworkspace_user` POSIX accounts share a `uid` within the same " +
+						"`system_id`, within a user or across the users of the plan. The API accepts duplicate uids, " +
+						"which some setups rely on, so by default they're only logged as warnings.",
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"unique_external_id_custom_types": {
+					Description: "Custom types of `googleworkspace_user` external ids, such as `employee_number`, whose " +
+						"values are checked at plan time not to be used by another user of the plan or of the directory.",
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+
+				"user_agent_suffix": {
+					Description: "A suffix appended to the user agent of every request, such as a module or pipeline " +
+						"name, so traffic from the provider can be attributed.",
+					Type: schema.TypeString,
+					DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+						"GOOGLEWORKSPACE_USER_AGENT_SUFFIX",
+					}, nil),
+					Optional: true,
+				},
 			},
 			DataSourcesMap: map[string]*schema.Resource{
-				"googleworkspace_chrome_policy_schema": dataSourceChromePolicySchema(),
-				"googleworkspace_domain":               dataSourceDomain(),
-				"googleworkspace_domain_alias":         dataSourceDomainAlias(),
-				"googleworkspace_group":                dataSourceGroup(),
-				"googleworkspace_groups":               dataSourceGroups(),
-				"googleworkspace_group_member":         dataSourceGroupMember(),
-				"googleworkspace_group_members":        dataSourceGroupMembers(),
-				"googleworkspace_group_settings":       dataSourceGroupSettings(),
-				"googleworkspace_org_unit":             dataSourceOrgUnit(),
-				"googleworkspace_privileges":           dataSourcePrivileges(),
-				"googleworkspace_role":                 dataSourceRole(),
-				"googleworkspace_schema":               dataSourceSchema(),
-				"googleworkspace_user":                 dataSourceUser(),
-				"googleworkspace_users":                dataSourceUsers(),
+				"googleworkspace_buildings":                  dataSourceBuildings(),
+				"googleworkspace_calendar_features":          dataSourceCalendarFeatures(),
+				"googleworkspace_calendar_resources":         dataSourceCalendarResources(),
+				"googleworkspace_chrome_policy_schema":       dataSourceChromePolicySchema(),
+				"googleworkspace_chrome_resolved_policies":   dataSourceChromeResolvedPolicies(),
+				"googleworkspace_customer":                   dataSourceCustomer(),
+				"googleworkspace_data_transfer":              dataSourceDataTransfer(),
+				"googleworkspace_data_transfer_applications": dataSourceDataTransferApplications(),
+				"googleworkspace_devices":                    dataSourceDevices(),
+				"googleworkspace_domain":                     dataSourceDomain(),
+				"googleworkspace_domain_alias":               dataSourceDomainAlias(),
+				"googleworkspace_domain_dns_records":         dataSourceDomainDnsRecords(),
+				"googleworkspace_gmail_profile":              dataSourceGmailProfile(),
+				"googleworkspace_gmail_send_as_aliases":      dataSourceGmailSendAsAliases(),
+				"googleworkspace_group":                      dataSourceGroup(),
+				"googleworkspace_groups":                     dataSourceGroups(),
+				"googleworkspace_groups_settings":            dataSourceGroupsSettings(),
+				"googleworkspace_group_aliases":              dataSourceGroupAliases(),
+				"googleworkspace_group_member":               dataSourceGroupMember(),
+				"googleworkspace_group_members":              dataSourceGroupMembers(),
+				"googleworkspace_group_membership_check":     dataSourceGroupMembershipCheck(),
+				"googleworkspace_group_settings":             dataSourceGroupSettings(),
+				"googleworkspace_group_transitive_members":   dataSourceGroupTransitiveMembers(),
+				"googleworkspace_inactive_users":             dataSourceInactiveUsers(),
+				"googleworkspace_license_assignments":        dataSourceLicenseAssignments(),
+				"googleworkspace_license_skus":               dataSourceLicenseSkus(),
+				"googleworkspace_org_unit":                   dataSourceOrgUnit(),
+				"googleworkspace_org_unit_children":          dataSourceOrgUnitChildren(),
+				"googleworkspace_org_unit_users":             dataSourceOrgUnitUsers(),
+				"googleworkspace_privileges":                 dataSourcePrivileges(),
+				"googleworkspace_role":                       dataSourceRole(),
+				"googleworkspace_schema":                     dataSourceSchema(),
+				"googleworkspace_service_account":            dataSourceServiceAccount(),
+				"googleworkspace_super_admins":               dataSourceSuperAdmins(),
+				"googleworkspace_system_roles":               dataSourceSystemRoles(),
+				"googleworkspace_user":                       dataSourceUser(),
+				"googleworkspace_user_aliases":               dataSourceUserAliases(),
+				"googleworkspace_users":                      dataSourceUsers(),
 			},
 			ResourcesMap: map[string]*schema.Resource{
-				"googleworkspace_chrome_policy":       resourceChromePolicy(),
-				"googleworkspace_domain":              resourceDomain(),
-				"googleworkspace_domain_alias":        resourceDomainAlias(),
-				"googleworkspace_gmail_send_as_alias": resourceGmailSendAsAlias(),
-				"googleworkspace_group":               resourceGroup(),
-				"googleworkspace_group_member":        resourceGroupMember(),
-				"googleworkspace_group_members":       resourceGroupMembers(),
-				"googleworkspace_group_settings":      resourceGroupSettings(),
-				"googleworkspace_org_unit":            resourceOrgUnit(),
-				"googleworkspace_role":                resourceRole(),
-				"googleworkspace_role_assignment":     resourceRoleAssignment(),
-				"googleworkspace_schema":              resourceSchema(),
-				"googleworkspace_user":                resourceUser(),
+				"googleworkspace_alert_feedback":                          resourceAlertFeedback(),
+				"googleworkspace_alerts_deletion":                         resourceAlertsDeletion(),
+				"googleworkspace_calendar_acl":                            resourceCalendarAcl(),
+				"googleworkspace_chat_space":                              resourceChatSpace(),
+				"googleworkspace_chat_space_member":                       resourceChatSpaceMember(),
+				"googleworkspace_chrome_policy":                           resourceChromePolicy(),
+				"googleworkspace_chrome_print_server":                     resourceChromePrintServer(),
+				"googleworkspace_data_transfer":                           resourceDataTransfer(),
+				"googleworkspace_device_user_action":                      resourceDeviceUserAction(),
+				"googleworkspace_domain":                                  resourceDomain(),
+				"googleworkspace_domain_alias":                            resourceDomainAlias(),
+				"googleworkspace_domain_shared_contact":                   resourceDomainSharedContact(),
+				"googleworkspace_gmail_send_as_alias":                     resourceGmailSendAsAlias(),
+				"googleworkspace_group":                                   resourceGroup(),
+				"googleworkspace_group_member":                            resourceGroupMember(),
+				"googleworkspace_group_members":                           resourceGroupMembers(),
+				"googleworkspace_group_security_settings":                 resourceGroupSecuritySettings(),
+				"googleworkspace_group_settings":                          resourceGroupSettings(),
+				"googleworkspace_inbound_saml_sso_profile":                resourceInboundSamlSsoProfile(),
+				"googleworkspace_inbound_saml_sso_profile_idp_credential": resourceInboundSamlSsoProfileIdpCredential(),
+				"googleworkspace_org_unit":                                resourceOrgUnit(),
+				"googleworkspace_org_unit_tree":                           resourceOrgUnitTree(),
+				"googleworkspace_role":                                    resourceRole(),
+				"googleworkspace_role_assignment":                         resourceRoleAssignment(),
+				"googleworkspace_schema":                                  resourceSchema(),
+				"googleworkspace_shared_drive_member":                     resourceSharedDriveMember(),
+				"googleworkspace_user":                                    resourceUser(),
+				"googleworkspace_user_invitation":                         resourceUserInvitation(),
+				"googleworkspace_user_sign_out":                           resourceUserSignOut(),
+				"googleworkspace_vault_matter":                            resourceVaultMatter(),
 			},
 		}
 
+		for name, r := range p.DataSourcesMap {
+			traceResource(name, r)
+		}
+		for name, r := range p.ResourcesMap {
+			traceResource(name, r)
+		}
+
 		p.ConfigureContextFunc = configure(version, p)
 
 		return p
@@ -181,6 +297,11 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 			return nil, diags
 		}
 
+		// Get custom endpoint
+		if v, ok := d.GetOk("custom_endpoint"); ok {
+			config.CustomEndpoint = v.(string)
+		}
+
 		// Get impersonated user email
 		if v, ok := d.GetOk("impersonated_user_email"); ok {
 			config.ImpersonatedUserEmail = v.(string)
@@ -200,11 +321,31 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 			config.ServiceAccount = v.(string)
 		}
 
+		// Get request reason
+		if v, ok := d.GetOk("request_reason"); ok {
+			config.RequestReason = v.(string)
+		}
+
+		config.CheckEnabledApis = d.Get("check_enabled_apis").(bool)
+		config.SkipUserTypeValidation = d.Get("skip_user_type_validation").(bool)
+		config.UniqueExternalIdCustomTypes = listOfInterfacestoStrings(d.Get("unique_external_id_custom_types"))
+		config.EnforceUniquePosixUids = d.Get("enforce_unique_posix_uids").(bool)
+
 		config.UserAgent = p.UserAgent("terraform-provider-googleworkspace", version)
+		if v, ok := d.GetOk("user_agent_suffix"); ok {
+			config.UserAgent = fmt.Sprintf("%s %s", config.UserAgent, v.(string))
+		}
 
 		// nolint
 		newCtx, _ := schema.StopContext(ctx)
 		diags = config.loadAndValidate(newCtx)
+		if diags.HasError() {
+			return &config, diags
+		}
+
+		if config.CheckEnabledApis {
+			diags = append(diags, config.checkEnabledApis(newCtx)...)
+		}
 
 		return &config, diags
 	}
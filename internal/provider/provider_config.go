@@ -5,8 +5,13 @@ package googleworkspace
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -14,13 +19,23 @@ import (
 	"golang.org/x/oauth2"
 	googleoauth "golang.org/x/oauth2/google"
 
+	datatransfer "google.golang.org/api/admin/datatransfer/v1"
 	directory "google.golang.org/api/admin/directory/v1"
+	alertcenter "google.golang.org/api/alertcenter/v1beta1"
+	"google.golang.org/api/calendar/v3"
 	"google.golang.org/api/chromepolicy/v1"
+	"google.golang.org/api/cloudidentity/v1"
+	"google.golang.org/api/drive/v3"
 	"google.golang.org/api/gmail/v1"
 	"google.golang.org/api/groupssettings/v1"
+	"google.golang.org/api/iam/v1"
 	"google.golang.org/api/impersonate"
+	"google.golang.org/api/licensing/v1"
 	"google.golang.org/api/option"
+	"google.golang.org/api/reseller/v1"
+	"google.golang.org/api/siteverification/v1"
 	"google.golang.org/api/transport"
+	"google.golang.org/api/vault/v1"
 )
 
 type apiClient struct {
@@ -30,9 +45,153 @@ type apiClient struct {
 	ClientScopes          []string
 	Credentials           string
 	Customer              string
+	CustomEndpoint        string
 	ImpersonatedUserEmail string
+	RequestReason         string
 	ServiceAccount        string
 	UserAgent             string
+
+	CheckEnabledApis            bool
+	SkipUserTypeValidation      bool
+	UniqueExternalIdCustomTypes []string
+	EnforceUniquePosixUids      bool
+
+	// services are constructed once per service and impersonated subject, and
+	// reused for the lifetime of the provider
+	servicesMu sync.Mutex
+	services   map[string]interface{}
+
+	// the privileges catalog, by service ID, is listed once to validate roles at plan time
+	privilegesMu sync.Mutex
+	privileges   map[string][]string
+
+	// the unique external ids planned by users, to find duplicates before any of the users exist
+	plannedExternalIds plannedExternalIds
+
+	// the posix uids planned by users, to find users of the plan sharing a uid
+	plannedPosixUids plannedPosixUids
+
+	// the group memberships planned, to find membership cycles between groups that may not exist yet
+	groupMemberships groupMembershipGraph
+}
+
+// apiProbe is a cheap request to an API the provider requires, used to check the API is enabled
+type apiProbe struct {
+	name    string
+	service string
+	probe   func(ctx context.Context) error
+}
+
+// checkEnabledApis probes the APIs most resources depend on, and returns a single diagnostic listing
+// those that aren't enabled in the Google Cloud project of the credentials. Other errors of the probes,
+// such as missing scopes or resources that don't exist, are ignored as they don't tell whether the API
+// is enabled, and are reported by the resources that need them.
+func (c *apiClient) checkEnabledApis(ctx context.Context) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	probes := []apiProbe{
+		{
+			name:    "Admin SDK API",
+			service: "admin.googleapis.com",
+			probe: func(ctx context.Context) error {
+				directoryService, diags := c.NewDirectoryService()
+				if diags.HasError() {
+					return fmt.Errorf("%s", diags[0].Summary)
+				}
+
+				_, err := directoryService.Customers.Get(c.Customer).Fields("id").Context(ctx).Do()
+				return err
+			},
+		},
+		{
+			name:    "Groups Settings API",
+			service: "groupssettings.googleapis.com",
+			probe: func(ctx context.Context) error {
+				groupsSettingsService, diags := c.NewGroupsSettingsService()
+				if diags.HasError() {
+					return fmt.Errorf("%s", diags[0].Summary)
+				}
+
+				// the group doesn't need to exist, the API is checked to be enabled first
+				_, err := groupsSettingsService.Groups.Get("terraform-api-probe@example.com").Context(ctx).Do()
+				return err
+			},
+		},
+		{
+			name:    "Chrome Policy API",
+			service: "chromepolicy.googleapis.com",
+			probe: func(ctx context.Context) error {
+				chromePolicyService, diags := c.NewChromePolicyService()
+				if diags.HasError() {
+					return fmt.Errorf("%s", diags[0].Summary)
+				}
+
+				_, err := chromePolicyService.Customers.PolicySchemas.List(fmt.Sprintf("customers/%s", c.Customer)).
+					PageSize(1).Context(ctx).Do()
+				return err
+			},
+		},
+		{
+			name:    "Enterprise License Manager API",
+			service: "licensing.googleapis.com",
+			probe: func(ctx context.Context) error {
+				licensingService, diags := c.NewLicensingService()
+				if diags.HasError() {
+					return fmt.Errorf("%s", diags[0].Summary)
+				}
+
+				_, err := licensingService.LicenseAssignments.ListForProduct("Google-Apps", c.Customer).
+					MaxResults(1).Context(ctx).Do()
+				return err
+			},
+		},
+	}
+
+	// the Gmail API can only be called on behalf of a user
+	if c.ImpersonatedUserEmail != "" {
+		probes = append(probes, apiProbe{
+			name:    "Gmail API",
+			service: "gmail.googleapis.com",
+			probe: func(ctx context.Context) error {
+				gmailService, diags := c.NewGmailService(ctx, c.ImpersonatedUserEmail)
+				if diags.HasError() {
+					return fmt.Errorf("%s", diags[0].Summary)
+				}
+
+				_, err := gmailService.Users.Settings.SendAs.List(c.ImpersonatedUserEmail).Context(ctx).Do()
+				return err
+			},
+		})
+	}
+
+	var disabled []string
+	for _, p := range probes {
+		log.Printf("[DEBUG] Checking the %s is enabled", p.name)
+
+		err := p.probe(ctx)
+		if err == nil {
+			continue
+		}
+
+		if !isServiceDisabledError(err) {
+			log.Printf("[DEBUG] Ignoring error of the %s probe: %s", p.name, err)
+			continue
+		}
+
+		disabled = append(disabled, fmt.Sprintf("- %s (%s): https://console.cloud.google.com/apis/library/%s",
+			p.name, p.service, p.service))
+	}
+
+	if len(disabled) > 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("%d of the APIs required by the provider are not enabled", len(disabled)),
+			Detail: "Enable these APIs in the Google Cloud project of the provider's credentials, and retry after a few minutes:\n" +
+				strings.Join(disabled, "\n"),
+		})
+	}
+
+	return diags
 }
 
 func (c *apiClient) loadAndValidate(ctx context.Context) diag.Diagnostics {
@@ -42,6 +201,17 @@ func (c *apiClient) loadAndValidate(ctx context.Context) diag.Diagnostics {
 		c.ClientScopes = DefaultClientScopes
 	}
 
+	if c.CustomEndpoint != "" {
+		// fakes of the APIs don't check credentials, and the provider's credentials must not be sent to
+		// whatever host the custom endpoint names
+		log.Printf("[INFO] Sending unauthenticated requests to %s", c.CustomEndpoint)
+		creds := googleoauth.Credentials{
+			TokenSource: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "unauthenticated"}),
+		}
+		diags = c.SetupClient(ctx, &creds)
+		return diags
+	}
+
 	if c.AccessToken != "" {
 		contents, _, err := pathOrContents(c.AccessToken)
 		if err != nil {
@@ -139,111 +309,639 @@ func (c *apiClient) SetupClient(ctx context.Context, creds *googleoauth.Credenti
 	// See ClientWithAdditionalRetries
 	retryTransport := NewTransportWithDefaultRetries(scrubbedLoggingTransport)
 
+	// 4. Tracing Transport - records a span for each request, including its retries.
+	tracingTransport := NewTransportWithTracing(retryTransport)
+
+	// 5. Headers Transport - adds the user agent and request reason to each request.
+	headersTransport := NewTransportWithHeaders(c.UserAgent, c.RequestReason, tracingTransport)
+
 	// Set final transport value.
-	client.Transport = retryTransport
+	client.Transport = headersTransport
 
 	c.client = client
 	return diags
 }
 
+// customBasePath returns the base path of a service when requests are sent to the custom endpoint,
+// keeping the path of the service's default base path.
+func (c *apiClient) customBasePath(basePath string) string {
+	if c.CustomEndpoint == "" {
+		return basePath
+	}
+
+	endpoint := strings.TrimSuffix(c.CustomEndpoint, "/")
+	if u, err := url.Parse(basePath); err == nil {
+		return endpoint + u.Path
+	}
+
+	return endpoint + "/"
+}
+
+// cachedService returns the service cached under the given service name and subject,
+// constructing it with newService the first time it is requested. The service is constructed
+// outside the lock, so constructing the client of one subject doesn't hold up the others. When
+// concurrent requests construct the same service, the first one cached is kept.
+func (c *apiClient) cachedService(name, subject string, newService func() (interface{}, diag.Diagnostics)) (interface{}, diag.Diagnostics) {
+	key := name + "/" + subject
+
+	c.servicesMu.Lock()
+	service, ok := c.services[key]
+	c.servicesMu.Unlock()
+	if ok {
+		return service, nil
+	}
+
+	service, diags := newService()
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	c.servicesMu.Lock()
+	defer c.servicesMu.Unlock()
+
+	if cached, ok := c.services[key]; ok {
+		return cached, nil
+	}
+
+	if c.services == nil {
+		c.services = map[string]interface{}{}
+	}
+	c.services[key] = service
+
+	return service, diags
+}
+
+// detachedContext keeps the values of the context it wraps, but not its deadline or cancellation.
+// Clients built during a request are cached, so they mustn't stop working once the request is done.
+type detachedContext struct {
+	parent context.Context
+}
+
+func (c detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (c detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (c detachedContext) Err() error {
+	return nil
+}
+
+func (c detachedContext) Value(key interface{}) interface{} {
+	return c.parent.Value(key)
+}
+
+func (c *apiClient) NewAlertCenterService() (*alertcenter.Service, diag.Diagnostics) {
+	service, diags := c.cachedService("alertcenter", c.ImpersonatedUserEmail, func() (interface{}, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		log.Printf("[INFO] Instantiating Google Alert Center service")
+
+		alertCenterService, err := alertcenter.NewService(context.Background(), option.WithHTTPClient(c.client))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		if alertCenterService == nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Alert Center Service could not be created.",
+			})
+
+			return nil, diags
+		}
+
+		alertCenterService.BasePath = c.customBasePath(alertCenterService.BasePath)
+
+		return alertCenterService, diags
+	})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return service.(*alertcenter.Service), diags
+}
+
+func (c *apiClient) NewCalendarService(ctx context.Context, userId string) (*calendar.Service, diag.Diagnostics) {
+	service, diags := c.cachedService("calendar", userId, func() (interface{}, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		log.Printf("[INFO] Instantiating Google Calendar service")
+
+		// calendar ACLs can only be managed by the calendar's owner, so the oauth token
+		// impersonates the user owning the calendar.
+		log.Printf("[INFO] Creating Google Calendar client that impersonates %q", userId)
+		newClient := &apiClient{
+			Credentials:           c.Credentials,
+			ClientScopes:          c.ClientScopes,
+			Customer:              c.Customer,
+			UserAgent:             c.UserAgent,
+			RequestReason:         c.RequestReason,
+			CustomEndpoint:        c.CustomEndpoint,
+			ImpersonatedUserEmail: userId,
+		}
+		// the client is cached and outlives the request, so it keeps the request's values but not its cancellation
+		clientCtx := detachedContext{ctx}
+		diags = newClient.loadAndValidate(clientCtx)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		calendarService, err := calendar.NewService(clientCtx, option.WithHTTPClient(newClient.client))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		if calendarService == nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Calendar Service could not be created.",
+			})
+
+			return nil, diags
+		}
+
+		calendarService.BasePath = newClient.customBasePath(calendarService.BasePath)
+
+		return calendarService, diags
+	})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return service.(*calendar.Service), diags
+}
+
+func (c *apiClient) NewChatService() (*chatService, diag.Diagnostics) {
+	service, diags := c.cachedService("chat", c.ImpersonatedUserEmail, func() (interface{}, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		log.Printf("[INFO] Instantiating Google Chat service")
+
+		if c.client == nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Google Chat Service could not be created.",
+			})
+
+			return nil, diags
+		}
+
+		return &chatService{restService{
+			client:   c.client,
+			BasePath: c.customBasePath(chatBasePath),
+		}}, diags
+	})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return service.(*chatService), diags
+}
+
 func (c *apiClient) NewChromePolicyService() (*chromepolicy.Service, diag.Diagnostics) {
-	var diags diag.Diagnostics
+	service, diags := c.cachedService("chromepolicy", c.ImpersonatedUserEmail, func() (interface{}, diag.Diagnostics) {
+		var diags diag.Diagnostics
 
-	log.Printf("[INFO] Instantiating Google Admin Chrome Policy service")
+		log.Printf("[INFO] Instantiating Google Admin Chrome Policy service")
 
-	chromePolicyService, err := chromepolicy.NewService(context.Background(), option.WithHTTPClient(c.client))
-	if err != nil {
-		return nil, diag.FromErr(err)
+		chromePolicyService, err := chromepolicy.NewService(context.Background(), option.WithHTTPClient(c.client))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		if chromePolicyService == nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Directory Service could not be created.",
+			})
+
+			return nil, diags
+		}
+
+		chromePolicyService.BasePath = c.customBasePath(chromePolicyService.BasePath)
+
+		return chromePolicyService, diags
+	})
+	if diags.HasError() {
+		return nil, diags
 	}
 
-	if chromePolicyService == nil {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "Directory Service could not be created.",
-		})
+	return service.(*chromepolicy.Service), diags
+}
+
+func (c *apiClient) NewCloudIdentityService() (*cloudidentity.Service, diag.Diagnostics) {
+	service, diags := c.cachedService("cloudidentity", c.ImpersonatedUserEmail, func() (interface{}, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		log.Printf("[INFO] Instantiating Cloud Identity service")
+
+		cloudIdentityService, err := cloudidentity.NewService(context.Background(), option.WithHTTPClient(c.client))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		if cloudIdentityService == nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Cloud Identity Service could not be created.",
+			})
+
+			return nil, diags
+		}
+
+		cloudIdentityService.BasePath = c.customBasePath(cloudIdentityService.BasePath)
+
+		return cloudIdentityService, diags
+	})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return service.(*cloudidentity.Service), diags
+}
+
+func (c *apiClient) NewDataTransferService() (*datatransfer.Service, diag.Diagnostics) {
+	service, diags := c.cachedService("datatransfer", c.ImpersonatedUserEmail, func() (interface{}, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		log.Printf("[INFO] Instantiating Google Admin Data Transfer service")
+
+		dataTransferService, err := datatransfer.NewService(context.Background(), option.WithHTTPClient(c.client))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		if dataTransferService == nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Data Transfer Service could not be created.",
+			})
 
+			return nil, diags
+		}
+
+		dataTransferService.BasePath = c.customBasePath(dataTransferService.BasePath)
+
+		return dataTransferService, diags
+	})
+	if diags.HasError() {
 		return nil, diags
 	}
 
-	return chromePolicyService, diags
+	return service.(*datatransfer.Service), diags
 }
 
 func (c *apiClient) NewDirectoryService() (*directory.Service, diag.Diagnostics) {
-	var diags diag.Diagnostics
+	service, diags := c.cachedService("directory", c.ImpersonatedUserEmail, func() (interface{}, diag.Diagnostics) {
+		var diags diag.Diagnostics
 
-	log.Printf("[INFO] Instantiating Google Admin Directory service")
+		log.Printf("[INFO] Instantiating Google Admin Directory service")
 
-	directoryService, err := directory.NewService(context.Background(), option.WithHTTPClient(c.client))
-	if err != nil {
-		return nil, diag.FromErr(err)
+		directoryService, err := directory.NewService(context.Background(), option.WithHTTPClient(c.client))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		if directoryService == nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Directory Service could not be created.",
+			})
+
+			return nil, diags
+		}
+
+		directoryService.BasePath = c.customBasePath(directoryService.BasePath)
+
+		return directoryService, diags
+	})
+	if diags.HasError() {
+		return nil, diags
 	}
 
-	if directoryService == nil {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "Directory Service could not be created.",
-		})
+	return service.(*directory.Service), diags
+}
 
+func (c *apiClient) NewDriveService() (*drive.Service, diag.Diagnostics) {
+	service, diags := c.cachedService("drive", c.ImpersonatedUserEmail, func() (interface{}, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		log.Printf("[INFO] Instantiating Drive service")
+
+		driveService, err := drive.NewService(context.Background(), option.WithHTTPClient(c.client))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		if driveService == nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Drive Service could not be created.",
+			})
+
+			return nil, diags
+		}
+
+		driveService.BasePath = c.customBasePath(driveService.BasePath)
+
+		return driveService, diags
+	})
+	if diags.HasError() {
 		return nil, diags
 	}
 
-	return directoryService, diags
+	return service.(*drive.Service), diags
 }
+
 func (c *apiClient) NewGmailService(ctx context.Context, userId string) (*gmail.Service, diag.Diagnostics) {
-	var diags diag.Diagnostics
+	service, diags := c.cachedService("gmail", userId, func() (interface{}, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		log.Printf("[INFO] Instantiating Google Admin Gmail service")
+
+		// the send-as-alias resource requires the oauth token impersonate the user
+		// the alias is being created for.
+		log.Printf("[INFO] Creating Google Admin Gmail client that impersonates %q", userId)
+		newClient := &apiClient{
+			Credentials:           c.Credentials,
+			ClientScopes:          c.ClientScopes,
+			Customer:              c.Customer,
+			UserAgent:             c.UserAgent,
+			RequestReason:         c.RequestReason,
+			CustomEndpoint:        c.CustomEndpoint,
+			ImpersonatedUserEmail: userId,
+		}
+		// the client is cached and outlives the request, so it keeps the request's values but not its cancellation
+		clientCtx := detachedContext{ctx}
+		diags = newClient.loadAndValidate(clientCtx)
+		if diags.HasError() {
+			return nil, diags
+		}
 
-	log.Printf("[INFO] Instantiating Google Admin Gmail service")
+		gmailService, err := gmail.NewService(clientCtx, option.WithHTTPClient(newClient.client))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
 
-	// the send-as-alias resource requires the oauth token impersonate the user
-	// the alias is being created for.
-	log.Printf("[INFO] Creating Google Admin Gmail client that impersonates %q", userId)
-	newClient := &apiClient{
-		Credentials:           c.Credentials,
-		ClientScopes:          c.ClientScopes,
-		Customer:              c.Customer,
-		UserAgent:             c.UserAgent,
-		ImpersonatedUserEmail: userId,
+		if gmailService == nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Gmail Service could not be created.",
+			})
+
+			return nil, diags
+		}
+
+		gmailService.BasePath = newClient.customBasePath(gmailService.BasePath)
+
+		return gmailService, diags
+	})
+	if diags.HasError() {
+		return nil, diags
 	}
-	diags = newClient.loadAndValidate(ctx)
+
+	return service.(*gmail.Service), diags
+}
+
+func (c *apiClient) NewGroupsSettingsService() (*groupssettings.Service, diag.Diagnostics) {
+	service, diags := c.cachedService("groupssettings", c.ImpersonatedUserEmail, func() (interface{}, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		log.Printf("[INFO] Instantiating Google Admin Groups Settings service")
+
+		groupsSettingsService, err := groupssettings.NewService(context.Background(), option.WithHTTPClient(c.client))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		if groupsSettingsService == nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Groups Settings Service could not be created.",
+			})
+
+			return nil, diags
+		}
+
+		groupsSettingsService.BasePath = c.customBasePath(groupsSettingsService.BasePath)
+
+		return groupsSettingsService, diags
+	})
 	if diags.HasError() {
 		return nil, diags
 	}
 
-	gmailService, err := gmail.NewService(ctx, option.WithHTTPClient(newClient.client))
-	if err != nil {
-		return nil, diag.FromErr(err)
+	return service.(*groupssettings.Service), diags
+}
+
+func (c *apiClient) NewIamService() (*iam.Service, diag.Diagnostics) {
+	service, diags := c.cachedService("iam", c.ImpersonatedUserEmail, func() (interface{}, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		log.Printf("[INFO] Instantiating IAM service")
+
+		iamService, err := iam.NewService(context.Background(), option.WithHTTPClient(c.client))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		if iamService == nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "IAM Service could not be created.",
+			})
+
+			return nil, diags
+		}
+
+		iamService.BasePath = c.customBasePath(iamService.BasePath)
+
+		return iamService, diags
+	})
+	if diags.HasError() {
+		return nil, diags
 	}
 
-	if gmailService == nil {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "Gmail Service could not be created.",
-		})
+	return service.(*iam.Service), diags
+}
+
+func (c *apiClient) NewInboundSsoService() (*inboundSsoService, diag.Diagnostics) {
+	service, diags := c.cachedService("inboundsso", c.ImpersonatedUserEmail, func() (interface{}, diag.Diagnostics) {
+		var diags diag.Diagnostics
 
+		log.Printf("[INFO] Instantiating Cloud Identity Inbound SSO service")
+
+		if c.client == nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Cloud Identity Inbound SSO Service could not be created.",
+			})
+
+			return nil, diags
+		}
+
+		return &inboundSsoService{restService{
+			client:   c.client,
+			BasePath: c.customBasePath(cloudIdentityBasePath),
+		}}, diags
+	})
+	if diags.HasError() {
 		return nil, diags
 	}
 
-	return gmailService, diags
+	return service.(*inboundSsoService), diags
 }
 
-func (c *apiClient) NewGroupsSettingsService() (*groupssettings.Service, diag.Diagnostics) {
-	var diags diag.Diagnostics
+func (c *apiClient) NewLicensingService() (*licensing.Service, diag.Diagnostics) {
+	service, diags := c.cachedService("licensing", c.ImpersonatedUserEmail, func() (interface{}, diag.Diagnostics) {
+		var diags diag.Diagnostics
 
-	log.Printf("[INFO] Instantiating Google Admin Groups Settings service")
+		log.Printf("[INFO] Instantiating Enterprise License Manager service")
 
-	groupsSettingsService, err := groupssettings.NewService(context.Background(), option.WithHTTPClient(c.client))
-	if err != nil {
-		return nil, diag.FromErr(err)
+		licensingService, err := licensing.NewService(context.Background(), option.WithHTTPClient(c.client))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		if licensingService == nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Enterprise License Manager Service could not be created.",
+			})
+
+			return nil, diags
+		}
+
+		licensingService.BasePath = c.customBasePath(licensingService.BasePath)
+
+		return licensingService, diags
+	})
+	if diags.HasError() {
+		return nil, diags
 	}
 
-	if groupsSettingsService == nil {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "Groups Settings Service could not be created.",
-		})
+	return service.(*licensing.Service), diags
+}
+
+func (c *apiClient) NewResellerService() (*reseller.Service, diag.Diagnostics) {
+	service, diags := c.cachedService("reseller", c.ImpersonatedUserEmail, func() (interface{}, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		log.Printf("[INFO] Instantiating Reseller service")
+
+		resellerService, err := reseller.NewService(context.Background(), option.WithHTTPClient(c.client))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		if resellerService == nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Reseller Service could not be created.",
+			})
+
+			return nil, diags
+		}
+
+		resellerService.BasePath = c.customBasePath(resellerService.BasePath)
+
+		return resellerService, diags
+	})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return service.(*reseller.Service), diags
+}
 
+func (c *apiClient) NewSharedContactsService() (*sharedContactsService, diag.Diagnostics) {
+	service, diags := c.cachedService("sharedcontacts", c.ImpersonatedUserEmail, func() (interface{}, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		log.Printf("[INFO] Instantiating Domain Shared Contacts service")
+
+		if c.client == nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Domain Shared Contacts Service could not be created.",
+			})
+
+			return nil, diags
+		}
+
+		return &sharedContactsService{
+			client:   c.client,
+			BasePath: c.customBasePath(sharedContactsBasePath),
+		}, diags
+	})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return service.(*sharedContactsService), diags
+}
+
+func (c *apiClient) NewSiteVerificationService() (*siteverification.Service, diag.Diagnostics) {
+	service, diags := c.cachedService("siteverification", c.ImpersonatedUserEmail, func() (interface{}, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		log.Printf("[INFO] Instantiating Site Verification service")
+
+		siteVerificationService, err := siteverification.NewService(context.Background(), option.WithHTTPClient(c.client))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		if siteVerificationService == nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Site Verification Service could not be created.",
+			})
+
+			return nil, diags
+		}
+
+		siteVerificationService.BasePath = c.customBasePath(siteVerificationService.BasePath)
+
+		return siteVerificationService, diags
+	})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return service.(*siteverification.Service), diags
+}
+
+func (c *apiClient) NewVaultService() (*vault.Service, diag.Diagnostics) {
+	service, diags := c.cachedService("vault", c.ImpersonatedUserEmail, func() (interface{}, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		log.Printf("[INFO] Instantiating Vault service")
+
+		vaultService, err := vault.NewService(context.Background(), option.WithHTTPClient(c.client))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		if vaultService == nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Vault Service could not be created.",
+			})
+
+			return nil, diags
+		}
+
+		vaultService.BasePath = c.customBasePath(vaultService.BasePath)
+
+		return vaultService, diags
+	})
+	if diags.HasError() {
 		return nil, diags
 	}
 
-	return groupsSettingsService, diags
+	return service.(*vault.Service), diags
 }
@@ -7,7 +7,11 @@ import (
 	"context"
 	"fmt"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -243,6 +247,139 @@ func TestConfigLoadAndValidate_accessTokenOnly(t *testing.T) {
 	}
 }
 
+func TestConfigLoadAndValidate_customEndpointIgnoresCredentials(t *testing.T) {
+	var authorization string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	config := &apiClient{
+		AccessToken:    "secret-token",
+		Customer:       "my_customer",
+		CustomEndpoint: ts.URL,
+	}
+
+	diags := config.loadAndValidate(context.Background())
+	if err := checkDiags(diags); err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	resp, err := config.client.Get(ts.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if authorization != "Bearer unauthenticated" {
+		t.Errorf("expected the custom endpoint not to get the provider's credentials, got %q", authorization)
+	}
+}
+
+func TestConfigServicesAreCached(t *testing.T) {
+	config := &apiClient{
+		Credentials:           testFakeCredentialsPath,
+		ImpersonatedUserEmail: "my-fake-email@example.com",
+	}
+
+	diags := config.loadAndValidate(context.Background())
+	if err := checkDiags(diags); err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	directoryService, diags := config.NewDirectoryService()
+	if err := checkDiags(diags); err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	cachedDirectoryService, diags := config.NewDirectoryService()
+	if err := checkDiags(diags); err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	if directoryService != cachedDirectoryService {
+		t.Errorf("expected the directory service to be reused")
+	}
+
+	gmailService, diags := config.NewGmailService(context.Background(), "user-1@example.com")
+	if err := checkDiags(diags); err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	cachedGmailService, diags := config.NewGmailService(context.Background(), "user-1@example.com")
+	if err := checkDiags(diags); err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	if gmailService != cachedGmailService {
+		t.Errorf("expected the gmail service for the same user to be reused")
+	}
+
+	otherGmailService, diags := config.NewGmailService(context.Background(), "user-2@example.com")
+	if err := checkDiags(diags); err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	if gmailService == otherGmailService {
+		t.Errorf("expected a separate gmail service per impersonated user")
+	}
+}
+
+func TestConfigCachedServiceConcurrent(t *testing.T) {
+	config := &apiClient{}
+
+	// building the service of one subject doesn't hold up the others
+	building := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan interface{})
+	go func() {
+		service, _ := config.cachedService("gmail", "slow@example.com", func() (interface{}, diag.Diagnostics) {
+			close(building)
+			<-release
+			return new(int), nil
+		})
+		done <- service
+	}()
+	<-building
+
+	var wg sync.WaitGroup
+	services := make([]interface{}, 10)
+	for i := range services {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			services[i], _ = config.cachedService("gmail", "fast@example.com", func() (interface{}, diag.Diagnostics) {
+				return new(int), nil
+			})
+		}(i)
+	}
+	wg.Wait()
+	close(release)
+	<-done
+
+	for i, service := range services {
+		if service != services[0] {
+			t.Errorf("expected service %d to be the cached service", i)
+		}
+	}
+}
+
+func TestDetachedContext(t *testing.T) {
+	type key struct{}
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), key{}, "value"))
+	cancel()
+
+	detached := detachedContext{ctx}
+	if err := detached.Err(); err != nil {
+		t.Errorf("expected the detached context not to be canceled, got %v", err)
+	}
+
+	if v := detached.Value(key{}); v != "value" {
+		t.Errorf("expected the detached context to keep the values, got %v", v)
+	}
+}
+
 func checkValidCreds(config *apiClient) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -277,3 +414,50 @@ func checkValidCredsGroupAdmin(config *apiClient) diag.Diagnostics {
 
 	return diags
 }
+
+func TestConfigCheckEnabledApis(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		// the Groups Settings and Chrome Policy APIs are disabled, the other APIs don't find the probed resources
+		if strings.HasPrefix(r.URL.Path, "/groups/v1/") || strings.HasPrefix(r.URL.Path, "/v1/customers/") {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"error": {"code": 403, "message": "API has not been used in project 123 before or it is disabled.", ` +
+				`"details": [{"@type": "type.googleapis.com/google.rpc.ErrorInfo", "reason": "SERVICE_DISABLED"}]}}`))
+			return
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error": {"code": 404, "message": "Not found"}}`))
+	}))
+	defer ts.Close()
+
+	config := &apiClient{
+		Customer:       "my_customer",
+		CustomEndpoint: ts.URL,
+	}
+
+	diags := config.loadAndValidate(context.Background())
+	if err := checkDiags(diags); err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	diags = config.checkEnabledApis(context.Background())
+	if len(diags) != 1 {
+		t.Fatalf("expected a single diagnostic, got %#v", diags)
+	}
+
+	if diags[0].Summary != "2 of the APIs required by the provider are not enabled" {
+		t.Errorf("unexpected summary: %q", diags[0].Summary)
+	}
+
+	for _, expected := range []string{"groupssettings.googleapis.com", "chromepolicy.googleapis.com"} {
+		if !strings.Contains(diags[0].Detail, expected) {
+			t.Errorf("expected detail to contain %q, got:\n%s", expected, diags[0].Detail)
+		}
+	}
+
+	if strings.Contains(diags[0].Detail, "admin.googleapis.com") {
+		t.Errorf("expected the Admin SDK API not to be reported, got:\n%s", diags[0].Detail)
+	}
+}
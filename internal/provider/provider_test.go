@@ -26,6 +26,17 @@ var providerFactories = map[string]func() (*schema.Provider, error){
 	},
 }
 
+// testFakeProviderConfig configures the provider to send requests to a fake of the APIs,
+// started with fakeworkspace.NewTestServer
+func testFakeProviderConfig(endpoint string) string {
+	return fmt.Sprintf(`
+provider "googleworkspace" {
+  customer_id     = "my_customer"
+  custom_endpoint = %q
+}
+`, endpoint)
+}
+
 var credsEnvVars = []string{
 	"GOOGLEWORKSPACE_CREDENTIALS",
 	"GOOGLEWORKSPACE_CLOUD_KEYFILE_JSON",
@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	alertcenter "google.golang.org/api/alertcenter/v1beta1"
+)
+
+func resourceAlertFeedback() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Alert Feedback resource in the Terraform Googleworkspace provider. It gives feedback on an " +
+			"Alert Center alert, e.g. marking a known-benign alert as `NOT_USEFUL` during automated triage. " +
+			"Feedback can't be removed, so destroying the resource only removes it from state. Alert Feedback " +
+			"requires the `https://www.googleapis.com/auth/apps.alerts` client scope, which isn't one of the " +
+			"provider's default scopes and needs to be added to `oauth_scopes`.",
+
+		CreateContext: resourceAlertFeedbackCreate,
+		ReadContext:   resourceAlertFeedbackRead,
+		DeleteContext: resourceAlertFeedbackDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAlertFeedbackImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"alert_id": {
+				Description: "The ID of the alert.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"type": {
+				Description: "The type of the feedback. Valid values are:" +
+					"\n\t- `NOT_USEFUL`" +
+					"\n\t- `SOMEWHAT_USEFUL`" +
+					"\n\t- `VERY_USEFUL`",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"NOT_USEFUL", "SOMEWHAT_USEFUL", "VERY_USEFUL"}, false)),
+			},
+			"feedback_id": {
+				Description: "The unique ID of the feedback.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"email": {
+				Description: "The email address of the user who gave the feedback.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"create_time": {
+				Description: "The time the feedback was given, in RFC 3339 format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"alert_status": {
+				Description: "The current status of the alert, `NOT_STARTED`, `IN_PROGRESS` or `CLOSED`. The status " +
+					"is managed in the Admin console, it can't be changed through the Alert Center API.",
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// Adding a computed id simply to override the `optional` id that gets added in the SDK
+			// that will then display improperly in the docs
+			"id": {
+				Description: "The ID of the feedback, in the format `<alert_id>/<feedback_id>`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceAlertFeedbackCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	alertCenterService, diags := client.NewAlertCenterService()
+	if diags.HasError() {
+		return diags
+	}
+
+	alertsService, diags := GetAlertsService(alertCenterService)
+	if diags.HasError() {
+		return diags
+	}
+
+	feedbackService, diags := GetAlertFeedbackService(alertsService)
+	if diags.HasError() {
+		return diags
+	}
+
+	customerId, diags := alertCenterCustomerId(client)
+	if diags.HasError() {
+		return diags
+	}
+
+	alertId := d.Get("alert_id").(string)
+	log.Printf("[DEBUG] Creating Alert Feedback for Alert %q", alertId)
+
+	feedback, err := feedbackService.Create(alertId, &alertcenter.AlertFeedback{
+		Type: d.Get("type").(string),
+	}).CustomerId(customerId).Do()
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", alertId, feedback.FeedbackId))
+
+	log.Printf("[DEBUG] Finished creating Alert Feedback %q", d.Id())
+
+	return resourceAlertFeedbackRead(ctx, d, meta)
+}
+
+func resourceAlertFeedbackRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	alertCenterService, diags := client.NewAlertCenterService()
+	if diags.HasError() {
+		return diags
+	}
+
+	alertsService, diags := GetAlertsService(alertCenterService)
+	if diags.HasError() {
+		return diags
+	}
+
+	feedbackService, diags := GetAlertFeedbackService(alertsService)
+	if diags.HasError() {
+		return diags
+	}
+
+	customerId, diags := alertCenterCustomerId(client)
+	if diags.HasError() {
+		return diags
+	}
+
+	alertId := d.Get("alert_id").(string)
+	feedbackId := strings.TrimPrefix(d.Id(), alertId+"/")
+
+	log.Printf("[DEBUG] Getting Alert Feedback %q", d.Id())
+
+	resp, err := feedbackService.List(alertId).CustomerId(customerId).Do()
+	if err != nil {
+		return handleReadNotFoundError(err, d, d.Id())
+	}
+
+	var feedback *alertcenter.AlertFeedback
+	for _, f := range resp.Feedback {
+		if f.FeedbackId == feedbackId {
+			feedback = f
+			break
+		}
+	}
+
+	if feedback == nil {
+		log.Printf("[WARN] Removing Alert Feedback %q because it's gone", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	metadata, err := alertsService.GetMetadata(alertId).CustomerId(customerId).Do()
+	if err != nil {
+		return handleReadNotFoundError(err, d, d.Id())
+	}
+
+	d.Set("type", feedback.Type)
+	d.Set("feedback_id", feedback.FeedbackId)
+	d.Set("email", feedback.Email)
+	d.Set("create_time", feedback.CreateTime)
+	d.Set("alert_status", metadata.Status)
+
+	log.Printf("[DEBUG] Finished getting Alert Feedback %q", d.Id())
+
+	return diags
+}
+
+func resourceAlertFeedbackDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// feedback can't be removed from an alert, so the resource is only removed from state
+	log.Printf("[DEBUG] Removing Alert Feedback %q from state", d.Id())
+
+	return diags
+}
+
+func resourceAlertFeedbackImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Alert Feedback Id (%s) is not of the correct format (<alert_id>/<feedback_id>)", d.Id())
+	}
+
+	d.Set("alert_id", parts[0])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// alertCenterCustomerId resolves the provider's customer to its ID, as the Alert Center API doesn't accept
+// the `my_customer` alias
+func alertCenterCustomerId(client *apiClient) (string, diag.Diagnostics) {
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return "", diags
+	}
+
+	customersService, diags := GetCustomersService(directoryService)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	customer, err := customersService.Get(client.Customer).Fields("id").Do()
+	if err != nil {
+		return "", apiErrorDiagnostics(err)
+	}
+
+	return customer.Id, diags
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceAlertFeedback_basic(t *testing.T) {
+	t.Parallel()
+
+	alertId := os.Getenv("GOOGLEWORKSPACE_ALERT_ID")
+
+	if alertId == "" {
+		t.Skip("GOOGLEWORKSPACE_ALERT_ID needs to be set to run this test")
+	}
+
+	testAlertVals := map[string]interface{}{
+		"alertId": alertId,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAlertFeedback(testAlertVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_alert_feedback.benign", "type", "NOT_USEFUL"),
+					resource.TestCheckResourceAttrSet("googleworkspace_alert_feedback.benign", "feedback_id"),
+					resource.TestCheckResourceAttrSet("googleworkspace_alert_feedback.benign", "email"),
+					resource.TestCheckResourceAttrSet("googleworkspace_alert_feedback.benign", "alert_status"),
+				),
+			},
+			{
+				ResourceName:      "googleworkspace_alert_feedback.benign",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceAlertFeedback(testAlertVals map[string]interface{}) string {
+	return Nprintf(`
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/admin.directory.customer",
+    "https://www.googleapis.com/auth/apps.alerts",
+  ]
+}
+
+resource "googleworkspace_alert_feedback" "benign" {
+  alert_id = "%{alertId}"
+  type     = "NOT_USEFUL"
+}
+`, testAlertVals)
+}
@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	alertcenter "google.golang.org/api/alertcenter/v1beta1"
+)
+
+// alertsBatchDeleteMaxAlerts is the maximum number of alerts deleted by a single batch delete request
+const alertsBatchDeleteMaxAlerts = 1000
+
+func resourceAlertsDeletion() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Alerts Deletion resource deletes the Alert Center alerts matching a filter, e.g. to close out " +
+			"known-benign alerts in automated triage. The matching alerts are deleted when the resource is created, " +
+			"and again whenever `filter` or `triggers` change. Destroying the resource doesn't restore the alerts, " +
+			"which can be restored in the Admin console within 30 days. Alerts Deletion requires the " +
+			"`https://www.googleapis.com/auth/apps.alerts` client scope, which isn't one of the provider's default " +
+			"scopes and needs to be added to `oauth_scopes`.",
+
+		CreateContext: resourceAlertsDeletionCreate,
+		ReadContext:   resourceAlertsDeletionRead,
+		DeleteContext: resourceAlertsDeletionDelete,
+
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Description: "Query string restricting the alerts that are deleted, e.g. " +
+					"`type = \"Suspicious login\" AND createTime < \"2022-01-01T00:00:00Z\"`, see " +
+					"https://developers.google.com/admin-sdk/alertcenter/guides/query-filters.",
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"triggers": {
+				Description: "Arbitrary map of values that, when changed, deletes the matching alerts again.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"deleted_alert_ids": {
+				Description: "The IDs of the alerts that were deleted.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"deleted_at": {
+				Description: "The time the alerts were deleted, in RFC 3339 format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			// Adding a computed id simply to override the `optional` id that gets added in the SDK
+			// that will then display improperly in the docs
+			"id": {
+				Description: "The unique ID of the deletion.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceAlertsDeletionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	alertCenterService, diags := client.NewAlertCenterService()
+	if diags.HasError() {
+		return diags
+	}
+
+	alertsService, diags := GetAlertsService(alertCenterService)
+	if diags.HasError() {
+		return diags
+	}
+
+	customerId, diags := alertCenterCustomerId(client)
+	if diags.HasError() {
+		return diags
+	}
+
+	filter := d.Get("filter").(string)
+	log.Printf("[DEBUG] Deleting Alerts matching %q", filter)
+
+	var alertIds []string
+	err := alertsService.List().CustomerId(customerId).Filter(filter).Pages(ctx, func(resp *alertcenter.ListAlertsResponse) error {
+		for _, alert := range resp.Alerts {
+			alertIds = append(alertIds, alert.AlertId)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	deletedAlertIds := []string{}
+	for start := 0; start < len(alertIds); start += alertsBatchDeleteMaxAlerts {
+		end := start + alertsBatchDeleteMaxAlerts
+		if end > len(alertIds) {
+			end = len(alertIds)
+		}
+
+		resp, err := alertsService.BatchDelete(&alertcenter.BatchDeleteAlertsRequest{
+			AlertId:    alertIds[start:end],
+			CustomerId: customerId,
+		}).Context(ctx).Do()
+		if err != nil {
+			return apiErrorDiagnostics(err)
+		}
+
+		deletedAlertIds = append(deletedAlertIds, resp.SuccessAlertIds...)
+
+		if len(resp.FailedAlertStatus) > 0 {
+			var failures []string
+			for alertId, status := range resp.FailedAlertStatus {
+				failures = append(failures, fmt.Sprintf("%s: %s", alertId, status.Message))
+			}
+			sort.Strings(failures)
+
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("%d alerts could not be deleted", len(failures)),
+				Detail:   strings.Join(failures, "\n"),
+			})
+		}
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("deleted_alert_ids", deletedAlertIds)
+	d.Set("deleted_at", time.Now().UTC().Format(time.RFC3339))
+
+	log.Printf("[DEBUG] Finished deleting %d Alerts matching %q", len(deletedAlertIds), filter)
+
+	return append(diags, resourceAlertsDeletionRead(ctx, d, meta)...)
+}
+
+// resourceAlertsDeletionRead doesn't do anything, as the deleted alerts are kept in state as they were deleted
+func resourceAlertsDeletionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	return diags
+}
+
+func resourceAlertsDeletionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// the alerts aren't restored, so the resource is only removed from state
+	log.Printf("[DEBUG] Removing Alerts Deletion %q from state", d.Id())
+
+	return diags
+}
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceAlertsDeletion_noMatches(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAlertsDeletion_noMatches(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_alerts_deletion.test", "deleted_alert_ids.#", "0"),
+					resource.TestCheckResourceAttrSet("googleworkspace_alerts_deletion.test", "deleted_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceAlertsDeletion_noMatches() string {
+	return `
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/admin.directory.customer",
+    "https://www.googleapis.com/auth/apps.alerts",
+  ]
+}
+
+# no alerts can have been created before Alert Center existed
+resource "googleworkspace_alerts_deletion" "test" {
+  filter = "createTime < \"2000-01-01T00:00:00Z\""
+}
+`
+}
@@ -0,0 +1,253 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"google.golang.org/api/calendar/v3"
+)
+
+func resourceCalendarAcl() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Calendar ACL resource manages an access control rule of a user's calendar, e.g. letting an " +
+			"assistant make changes to events and manage sharing of an executive's primary calendar. The rule is " +
+			"managed on behalf of `user_email` through domain-wide delegation, so service account credentials are " +
+			"required. Calendar ACL requires the `https://www.googleapis.com/auth/calendar` client scope, which isn't " +
+			"one of the provider's default scopes and needs to be added to `oauth_scopes`.",
+
+		CreateContext: resourceCalendarAclCreate,
+		ReadContext:   resourceCalendarAclRead,
+		UpdateContext: resourceCalendarAclUpdate,
+		DeleteContext: resourceCalendarAclDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCalendarAclImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user_email": {
+				Description: "The primary email of the user owning the calendar.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"calendar_id": {
+				Description: "The ID of the calendar, defaults to the user's primary calendar.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "primary",
+			},
+			"scope_type": {
+				Description: "The type of the scope the rule applies to. Acceptable values are `default` (the public scope), " +
+					"`user`, `group` and `domain`.",
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"default", "user", "group", "domain"},
+					false)),
+			},
+			"scope_value": {
+				Description: "The email address of a user or group, or the name of a domain, depending on `scope_type`. " +
+					"Omitted for the `default` scope.",
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.EqualFold(old, new)
+				},
+			},
+			"role": {
+				Description: "The role granted to the scope. Acceptable values are `freeBusyReader` (see free/busy " +
+					"information), `reader` (see all event details), `writer` (make changes to events) and `owner` " +
+					"(make changes to events and manage sharing).",
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"freeBusyReader", "reader",
+					"writer", "owner"}, false)),
+			},
+			"send_notifications": {
+				Description: "Whether to send a notification about the calendar sharing change.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"rule_id": {
+				Description: "The ID of the rule, in the format `{scope_type}:{scope_value}`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"etag": {
+				Description: "ETag of the resource.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			// Adding a computed id simply to override the `optional` id that gets added in the SDK
+			// that will then display improperly in the docs
+			"id": {
+				Description: "The ID of this resource.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceCalendarAclCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	userEmail := d.Get("user_email").(string)
+	calendarService, diags := client.NewCalendarService(ctx, userEmail)
+	if diags.HasError() {
+		return diags
+	}
+
+	aclService, diags := GetCalendarAclService(calendarService)
+	if diags.HasError() {
+		return diags
+	}
+
+	calendarId := d.Get("calendar_id").(string)
+	log.Printf("[DEBUG] Creating Calendar ACL for calendar %q of %q", calendarId, userEmail)
+
+	rule, err := aclService.Insert(calendarId, &calendar.AclRule{
+		Role: d.Get("role").(string),
+		Scope: &calendar.AclRuleScope{
+			Type:  d.Get("scope_type").(string),
+			Value: d.Get("scope_value").(string),
+		},
+	}).SendNotifications(d.Get("send_notifications").(bool)).Do()
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", userEmail, calendarId, rule.Id))
+
+	log.Printf("[DEBUG] Finished creating Calendar ACL %q", d.Id())
+
+	return resourceCalendarAclRead(ctx, d, meta)
+}
+
+func resourceCalendarAclRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	calendarService, diags := client.NewCalendarService(ctx, d.Get("user_email").(string))
+	if diags.HasError() {
+		return diags
+	}
+
+	aclService, diags := GetCalendarAclService(calendarService)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Getting Calendar ACL %q", d.Id())
+
+	rule, err := aclService.Get(d.Get("calendar_id").(string), calendarAclRuleId(d.Id())).Do()
+	if err != nil {
+		return handleReadNotFoundError(err, d, d.Id())
+	}
+
+	// deleted rules can still be returned by the API, with the `none` role
+	if rule.Role == "none" {
+		log.Printf("[WARN] Removing Calendar ACL %q because it's gone", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("role", rule.Role)
+	d.Set("rule_id", rule.Id)
+	d.Set("etag", rule.Etag)
+	if rule.Scope != nil {
+		d.Set("scope_type", rule.Scope.Type)
+		d.Set("scope_value", rule.Scope.Value)
+	}
+
+	log.Printf("[DEBUG] Finished getting Calendar ACL %q", d.Id())
+
+	return diags
+}
+
+func resourceCalendarAclUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	calendarService, diags := client.NewCalendarService(ctx, d.Get("user_email").(string))
+	if diags.HasError() {
+		return diags
+	}
+
+	aclService, diags := GetCalendarAclService(calendarService)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Updating Calendar ACL %q", d.Id())
+
+	if d.HasChange("role") {
+		_, err := aclService.Patch(d.Get("calendar_id").(string), calendarAclRuleId(d.Id()), &calendar.AclRule{
+			Role: d.Get("role").(string),
+		}).SendNotifications(d.Get("send_notifications").(bool)).Do()
+		if err != nil {
+			return apiErrorDiagnostics(err)
+		}
+	}
+
+	log.Printf("[DEBUG] Finished updating Calendar ACL %q", d.Id())
+
+	return resourceCalendarAclRead(ctx, d, meta)
+}
+
+func resourceCalendarAclDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	calendarService, diags := client.NewCalendarService(ctx, d.Get("user_email").(string))
+	if diags.HasError() {
+		return diags
+	}
+
+	aclService, diags := GetCalendarAclService(calendarService)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Deleting Calendar ACL %q", d.Id())
+
+	err := aclService.Delete(d.Get("calendar_id").(string), calendarAclRuleId(d.Id())).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, d.Id())
+	}
+
+	log.Printf("[DEBUG] Finished deleting Calendar ACL %q", d.Id())
+
+	return diags
+}
+
+func resourceCalendarAclImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+
+	// id is of format "<user_email>/<calendar_id>/<rule_id>"
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("Calendar ACL Id (%s) is not of the correct format (<user_email>/<calendar_id>/<rule_id>)", d.Id())
+	}
+
+	d.Set("user_email", parts[0])
+	d.Set("calendar_id", parts[1])
+	d.Set("send_notifications", true)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// calendarAclRuleId returns the rule ID from the resource ID, of format "<user_email>/<calendar_id>/<rule_id>"
+func calendarAclRuleId(id string) string {
+	parts := strings.Split(id, "/")
+	return parts[len(parts)-1]
+}
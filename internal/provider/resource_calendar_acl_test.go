@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceCalendarAcl_basic(t *testing.T) {
+	// the calendar owner needs a license including Google Calendar, which users created in
+	// tests don't have
+	calendarUser := os.Getenv("GOOGLEWORKSPACE_TEST_GMAIL_USER")
+
+	if calendarUser == "" {
+		t.Skip("GOOGLEWORKSPACE_TEST_GMAIL_USER needs to be set to run this test")
+	}
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testAclVals := map[string]interface{}{
+		"domainName":   domainName,
+		"calendarUser": calendarUser,
+		"userEmail":    fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":     acctest.RandString(10),
+		"role":         "reader",
+	}
+
+	testAclValsUpdate := map[string]interface{}{}
+	for k, v := range testAclVals {
+		testAclValsUpdate[k] = v
+	}
+	testAclValsUpdate["role"] = "owner"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceCalendarAcl_basic(testAclVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_calendar_acl.acl", "role", "reader"),
+					resource.TestCheckResourceAttr("googleworkspace_calendar_acl.acl", "rule_id",
+						fmt.Sprintf("user:%s@%s", testAclVals["userEmail"], domainName)),
+				),
+			},
+			{
+				ResourceName:            "googleworkspace_calendar_acl.acl",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"etag"},
+			},
+			{
+				Config: testAccResourceCalendarAcl_basic(testAclValsUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_calendar_acl.acl", "role", "owner"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceCalendarAcl_basic(testAclVals map[string]interface{}) string {
+	return Nprintf(`
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/admin.directory.user",
+    "https://www.googleapis.com/auth/calendar",
+  ]
+}
+
+resource "googleworkspace_user" "assistant" {
+  primary_email = "%{userEmail}@%{domainName}"
+  password      = "%{password}"
+
+  name {
+    family_name = "Scott"
+    given_name  = "Michael"
+  }
+}
+
+resource "googleworkspace_calendar_acl" "acl" {
+  user_email  = "%{calendarUser}"
+  scope_type  = "user"
+  scope_value = googleworkspace_user.assistant.primary_email
+  role        = "%{role}"
+
+  send_notifications = false
+}
+`, testAclVals)
+}
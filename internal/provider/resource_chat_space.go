@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceChatSpace() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Chat Space resource manages Google Chat spaces, created on behalf of the impersonated user. " +
+			"Chat Space resides under the `https://www.googleapis.com/auth/chat.spaces` and " +
+			"`https://www.googleapis.com/auth/chat.delete` client scopes, which aren't among the provider's default " +
+			"scopes and need to be added to `oauth_scopes`. The Google Chat API must be enabled and configured as a " +
+			"Chat app in the Google Cloud project of the provider's credentials.",
+
+		CreateContext: resourceChatSpaceCreate,
+		ReadContext:   resourceChatSpaceRead,
+		UpdateContext: resourceChatSpaceUpdate,
+		DeleteContext: resourceChatSpaceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The resource name of the space, in the format `spaces/{space}`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"display_name": {
+				Description:      "The space's display name, which must be unique within the domain.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 128)),
+			},
+			"description": {
+				Description:      "A description of the space, such as its purpose.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(0, 150)),
+			},
+			"guidelines": {
+				Description:      "The rules, expectations and etiquette of the space.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(0, 5000)),
+			},
+			"external_user_allowed": {
+				Description: "Whether users outside of the domain can be members of the space. " +
+					"This can't be changed after the space is created.",
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"space_history_state": {
+				Description: "Whether messages are kept by default in the space. Acceptable values are `HISTORY_ON` " +
+					"and `HISTORY_OFF`. Defaults to the domain's history setting.",
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"HISTORY_ON", "HISTORY_OFF"},
+					false)),
+			},
+			"space_uri": {
+				Description: "The URI of the space in Google Chat.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"create_time": {
+				Description: "The time at which the space was created.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			// Adding a computed id simply to override the `optional` id that gets added in the SDK
+			// that will then display improperly in the docs
+			"id": {
+				Description: "The ID of this resource.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceChatSpaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	chatService, diags := client.NewChatService()
+	if diags.HasError() {
+		return diags
+	}
+
+	displayName := d.Get("display_name").(string)
+	log.Printf("[DEBUG] Creating Chat Space %q", displayName)
+
+	// the request id makes the creation idempotent, so retried requests don't create duplicate spaces
+	space, err := chatService.CreateSpace(ctx, &chatSpace{
+		DisplayName: displayName,
+		SpaceType:   "SPACE",
+		SpaceDetails: &chatSpaceDetails{
+			Description: d.Get("description").(string),
+			Guidelines:  d.Get("guidelines").(string),
+		},
+		ExternalUserAllowed: d.Get("external_user_allowed").(bool),
+		SpaceHistoryState:   d.Get("space_history_state").(string),
+	}, resource.UniqueId())
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	d.SetId(space.Name)
+
+	log.Printf("[DEBUG] Finished creating Chat Space %q: %#v", d.Id(), displayName)
+
+	return resourceChatSpaceRead(ctx, d, meta)
+}
+
+func resourceChatSpaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	chatService, diags := client.NewChatService()
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Getting Chat Space %q", d.Id())
+
+	space, err := chatService.GetSpace(ctx, d.Id())
+	if err != nil {
+		return handleReadNotFoundError(err, d, d.Id())
+	}
+
+	d.Set("name", space.Name)
+	d.Set("display_name", space.DisplayName)
+	d.Set("external_user_allowed", space.ExternalUserAllowed)
+	d.Set("space_history_state", space.SpaceHistoryState)
+	d.Set("space_uri", space.SpaceUri)
+	d.Set("create_time", space.CreateTime)
+
+	if space.SpaceDetails != nil {
+		d.Set("description", space.SpaceDetails.Description)
+		d.Set("guidelines", space.SpaceDetails.Guidelines)
+	} else {
+		d.Set("description", "")
+		d.Set("guidelines", "")
+	}
+
+	d.SetId(space.Name)
+
+	log.Printf("[DEBUG] Finished getting Chat Space %q: %#v", d.Id(), space.DisplayName)
+
+	return diags
+}
+
+func resourceChatSpaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	chatService, diags := client.NewChatService()
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Updating Chat Space %q", d.Id())
+
+	var updateMask []string
+	if d.HasChange("display_name") {
+		updateMask = append(updateMask, "display_name")
+	}
+	if d.HasChanges("description", "guidelines") {
+		updateMask = append(updateMask, "space_details")
+	}
+	if d.HasChange("space_history_state") {
+		updateMask = append(updateMask, "space_history_state")
+	}
+
+	if len(updateMask) > 0 {
+		_, err := chatService.PatchSpace(ctx, d.Id(), &chatSpace{
+			DisplayName: d.Get("display_name").(string),
+			SpaceDetails: &chatSpaceDetails{
+				Description: d.Get("description").(string),
+				Guidelines:  d.Get("guidelines").(string),
+			},
+			SpaceHistoryState: d.Get("space_history_state").(string),
+		}, updateMask)
+		if err != nil {
+			return apiErrorDiagnostics(err)
+		}
+	}
+
+	log.Printf("[DEBUG] Finished updating Chat Space %q", d.Id())
+
+	return resourceChatSpaceRead(ctx, d, meta)
+}
+
+func resourceChatSpaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := meta.(*apiClient)
+
+	chatService, diags := client.NewChatService()
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Deleting Chat Space %q", d.Id())
+
+	err := chatService.DeleteSpace(ctx, d.Id())
+	if err != nil {
+		return handleNotFoundError(err, d, d.Id())
+	}
+
+	log.Printf("[DEBUG] Finished deleting Chat Space %q", d.Id())
+
+	return diags
+}
@@ -0,0 +1,234 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceChatSpaceMember() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Chat Space Member resource manages the members of Google Chat spaces, which can be users " +
+			"or groups. Chat Space Member resides under the `https://www.googleapis.com/auth/chat.memberships` " +
+			"client scope, which isn't one of the provider's default scopes and needs to be added to `oauth_scopes`.",
+
+		CreateContext: resourceChatSpaceMemberCreate,
+		ReadContext:   resourceChatSpaceMemberRead,
+		UpdateContext: resourceChatSpaceMemberUpdate,
+		DeleteContext: resourceChatSpaceMemberDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceChatSpaceMemberImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"space": {
+				Description: "The resource name of the space, in the format `spaces/{space}`.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"email": {
+				Description: "The email address of the user added to the space. Exactly one of `email` " +
+					"and `group_id` must be set.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"email", "group_id"},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.EqualFold(old, new)
+				},
+			},
+			"group_id": {
+				Description: "The unique ID of the group added to the space, all of its members become members " +
+					"of the space. Exactly one of `email` and `group_id` must be set.",
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"role": {
+				Description: "The member's role in the space. Acceptable values are `ROLE_MEMBER` and " +
+					"`ROLE_MANAGER`, space managers can manage the space's settings and members. Groups can only " +
+					"be members.",
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "ROLE_MEMBER",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"ROLE_MEMBER", "ROLE_MANAGER"},
+					false)),
+			},
+			"name": {
+				Description: "The resource name of the membership, in the format `spaces/{space}/members/{member}`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"state": {
+				Description: "The state of the membership, `JOINED` or `INVITED`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			// Adding a computed id simply to override the `optional` id that gets added in the SDK
+			// that will then display improperly in the docs
+			"id": {
+				Description: "The ID of this resource.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceChatSpaceMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	chatService, diags := client.NewChatService()
+	if diags.HasError() {
+		return diags
+	}
+
+	space := d.Get("space").(string)
+	membership := &chatMembership{
+		Role: d.Get("role").(string),
+	}
+
+	if email := d.Get("email").(string); email != "" {
+		membership.Member = &chatUser{
+			Name: "users/" + email,
+			Type: "HUMAN",
+		}
+	} else {
+		membership.GroupMember = &chatGroup{
+			Name: "groups/" + d.Get("group_id").(string),
+		}
+	}
+
+	log.Printf("[DEBUG] Creating Chat Space Member in %q: %#v", space, membership)
+
+	membership, err := chatService.CreateMembership(ctx, space, membership)
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	d.SetId(membership.Name)
+
+	log.Printf("[DEBUG] Finished creating Chat Space Member %q", d.Id())
+
+	return resourceChatSpaceMemberRead(ctx, d, meta)
+}
+
+func resourceChatSpaceMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	chatService, diags := client.NewChatService()
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Getting Chat Space Member %q", d.Id())
+
+	membership, err := chatService.GetMembership(ctx, d.Id())
+	if err != nil {
+		return handleReadNotFoundError(err, d, d.Id())
+	}
+
+	// users are returned by their ID rather than the configured email, which is kept as is,
+	// the email is only looked up when it's unknown, such as when the member is imported
+	if membership.Member != nil && d.Get("email").(string) == "" {
+		directoryService, diags := client.NewDirectoryService()
+		if diags.HasError() {
+			return diags
+		}
+
+		usersService, diags := GetUsersService(directoryService)
+		if diags.HasError() {
+			return diags
+		}
+
+		user, err := usersService.Get(strings.TrimPrefix(membership.Member.Name, "users/")).Fields("primaryEmail").Do()
+		if err != nil {
+			return apiErrorDiagnostics(err)
+		}
+
+		d.Set("email", user.PrimaryEmail)
+	}
+
+	if membership.GroupMember != nil {
+		d.Set("group_id", strings.TrimPrefix(membership.GroupMember.Name, "groups/"))
+	}
+
+	d.Set("name", membership.Name)
+	d.Set("role", membership.Role)
+	d.Set("state", membership.State)
+	d.SetId(membership.Name)
+
+	log.Printf("[DEBUG] Finished getting Chat Space Member %q", d.Id())
+
+	return diags
+}
+
+func resourceChatSpaceMemberUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	chatService, diags := client.NewChatService()
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Updating Chat Space Member %q", d.Id())
+
+	if d.HasChange("role") {
+		_, err := chatService.PatchMembership(ctx, d.Id(), &chatMembership{
+			Role: d.Get("role").(string),
+		}, []string{"role"})
+		if err != nil {
+			return apiErrorDiagnostics(err)
+		}
+	}
+
+	log.Printf("[DEBUG] Finished updating Chat Space Member %q", d.Id())
+
+	return resourceChatSpaceMemberRead(ctx, d, meta)
+}
+
+func resourceChatSpaceMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := meta.(*apiClient)
+
+	chatService, diags := client.NewChatService()
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Deleting Chat Space Member %q", d.Id())
+
+	err := chatService.DeleteMembership(ctx, d.Id())
+	if err != nil {
+		return handleNotFoundError(err, d, d.Id())
+	}
+
+	log.Printf("[DEBUG] Finished deleting Chat Space Member %q", d.Id())
+
+	return diags
+}
+
+func resourceChatSpaceMemberImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+
+	// id is of format "spaces/<space>/members/<member>"
+	if len(parts) != 4 || parts[0] != "spaces" || parts[2] != "members" {
+		return nil, fmt.Errorf("Chat Space Member Id (%s) is not of the correct format (spaces/<space>/members/<member>)", d.Id())
+	}
+
+	d.Set("space", fmt.Sprintf("spaces/%s", parts[1]))
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceChatSpaceMember_basic(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testMemberVals := map[string]interface{}{
+		"domainName":  domainName,
+		"displayName": fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"userEmail":   fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"groupEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":    acctest.RandString(10),
+		"role":        "ROLE_MEMBER",
+	}
+
+	testMemberValsUpdate := map[string]interface{}{}
+	for k, v := range testMemberVals {
+		testMemberValsUpdate[k] = v
+	}
+	testMemberValsUpdate["role"] = "ROLE_MANAGER"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceChatSpaceMember(testMemberVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_chat_space_member.user", "role", "ROLE_MEMBER"),
+					resource.TestCheckResourceAttrSet("googleworkspace_chat_space_member.group", "state"),
+				),
+			},
+			{
+				Config: testAccResourceChatSpaceMember(testMemberValsUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_chat_space_member.user", "role", "ROLE_MANAGER"),
+				),
+			},
+			{
+				ResourceName:      "googleworkspace_chat_space_member.user",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "googleworkspace_chat_space_member.group",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceChatSpaceMember(testMemberVals map[string]interface{}) string {
+	return Nprintf(`
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/admin.directory.group",
+    "https://www.googleapis.com/auth/admin.directory.user",
+    "https://www.googleapis.com/auth/chat.delete",
+    "https://www.googleapis.com/auth/chat.memberships",
+    "https://www.googleapis.com/auth/chat.spaces",
+  ]
+}
+
+resource "googleworkspace_user" "user" {
+  primary_email = "%{userEmail}@%{domainName}"
+  password      = "%{password}"
+
+  name {
+    family_name = "Scott"
+    given_name  = "Michael"
+  }
+}
+
+resource "googleworkspace_group" "group" {
+  email = "%{groupEmail}@%{domainName}"
+}
+
+resource "googleworkspace_chat_space" "space" {
+  display_name = "%{displayName}"
+}
+
+resource "googleworkspace_chat_space_member" "user" {
+  space = googleworkspace_chat_space.space.name
+  email = googleworkspace_user.user.primary_email
+  role  = "%{role}"
+}
+
+resource "googleworkspace_chat_space_member" "group" {
+  space    = googleworkspace_chat_space.space.name
+  group_id = googleworkspace_group.group.id
+}
+`, testMemberVals)
+}
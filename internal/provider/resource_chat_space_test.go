@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceChatSpace_basic(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testSpaceVals := map[string]interface{}{
+		"displayName": fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"description": "created by terraform",
+	}
+
+	testSpaceValsUpdate := map[string]interface{}{
+		"displayName": testSpaceVals["displayName"].(string) + "-updated",
+		"description": "updated by terraform",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceChatSpace(testSpaceVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_chat_space.space", "description", "created by terraform"),
+					resource.TestCheckResourceAttrSet("googleworkspace_chat_space.space", "space_uri"),
+				),
+			},
+			{
+				Config: testAccResourceChatSpace(testSpaceValsUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_chat_space.space", "display_name", testSpaceValsUpdate["displayName"].(string)),
+					resource.TestCheckResourceAttr("googleworkspace_chat_space.space", "description", "updated by terraform"),
+				),
+			},
+			{
+				ResourceName:      "googleworkspace_chat_space.space",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceChatSpace(testSpaceVals map[string]interface{}) string {
+	return Nprintf(`
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/chat.spaces",
+    "https://www.googleapis.com/auth/chat.delete",
+  ]
+}
+
+resource "googleworkspace_chat_space" "space" {
+  display_name = "%{displayName}"
+  description  = "%{description}"
+  guidelines   = "Be nice"
+}
+`, testSpaceVals)
+}
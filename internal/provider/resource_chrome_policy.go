@@ -15,7 +15,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"google.golang.org/api/chromepolicy/v1"
 )
 
@@ -23,13 +22,20 @@ func resourceChromePolicy() *schema.Resource {
 	return &schema.Resource{
 		Description: "Chrome Policy resource in the Terraform Googleworkspace provider. " +
 			"Currently only supports policies not requiring additionalTargetKeys. Chrome Policy Schema " +
-			"resides under the `https://www.googleapis.com/auth/chrome.management.policy` client scope.",
+			"resides under the `https://www.googleapis.com/auth/chrome.management.policy` client scope. " +
+			"Policies of six schemas (`chrome.users.Homepage`, `chrome.users.IncognitoMode`, " +
+			"`chrome.users.ManagedBookmarksSetting`, `chrome.users.MaxConnectionsPerProxy`, " +
+			"`chrome.users.OnlineRevocationChecks` and `chrome.users.RestrictSigninToPattern`) are validated during " +
+			"plan against definitions bundled with the provider. The definitions of all other schemas are retrieved " +
+			"from the API, so planning them requires credentials.",
 
 		CreateContext: resourceChromePolicyCreate,
 		UpdateContext: resourceChromePolicyUpdate,
 		ReadContext:   resourceChromePolicyRead,
 		DeleteContext: resourceChromePolicyDelete,
 
+		CustomizeDiff: resourceChromePolicyCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"org_unit_id": {
 				Description:      "The target org unit on which this policy is applied.",
@@ -39,9 +45,11 @@ func resourceChromePolicy() *schema.Resource {
 				DiffSuppressFunc: diffSuppressOrgUnitId,
 			},
 			"policies": {
-				Description: "Policies to set for the org unit",
-				Type:        schema.TypeList,
-				Required:    true,
+				Description: "Policies to set for the org unit. Policies are identified by their `schema_name`, " +
+					"so their order doesn't matter.",
+				Type:     schema.TypeSet,
+				Required: true,
+				Set:      chromePolicyHash,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"schema_name": {
@@ -50,20 +58,28 @@ func resourceChromePolicy() *schema.Resource {
 							Required:    true,
 						},
 						"schema_values": {
-							Description: "JSON encoded map that represents key/value pairs that " +
-								"correspond to the given schema. ",
-							Type:     schema.TypeMap,
-							Required: true,
+							Description: "Map that represents key/value pairs that correspond to the given schema. " +
+								"Values are typed by their field in the policy schema: numbers, booleans, strings and enums " +
+								"can be written natively or JSON encoded, messages and lists must be JSON encoded, e.g. with " +
+								"`jsonencode()`. Values are always stored JSON encoded in the state, e.g. `\"ALLOWED\"`.",
+							Type:             schema.TypeMap,
+							Required:         true,
+							DiffSuppressFunc: diffSuppressPolicyValue,
 							Elem: &schema.Schema{
 								Type: schema.TypeString,
-								ValidateDiagFunc: validation.ToDiagFunc(
-									validation.StringIsJSON,
-								),
 							},
 						},
 					},
 				},
 			},
+			"inherit_on_destroy": {
+				Description: "If true, the policies are reset to inherit their values from the parent org unit when " +
+					"this resource is destroyed. If false, destroying this resource only removes it from the Terraform " +
+					"state and the org unit keeps its last applied policies.",
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 		},
 	}
 }
@@ -89,12 +105,7 @@ func resourceChromePolicyCreate(ctx context.Context, d *schema.ResourceData, met
 		TargetResource: "orgunits/" + orgUnitId,
 	}
 
-	diags = validateChromePolicies(ctx, d, client)
-	if diags.HasError() {
-		return diags
-	}
-
-	policies, diags := expandChromePoliciesValues(d.Get("policies").([]interface{}))
+	policies, diags := expandChromePolicies(ctx, d.Get("policies").(*schema.Set).List(), client)
 	if diags.HasError() {
 		return diags
 	}
@@ -122,7 +133,7 @@ func resourceChromePolicyCreate(ctx context.Context, d *schema.ResourceData, met
 	})
 
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	log.Printf("[DEBUG] Finished creating Chrome Policy for org:%s", orgUnitId)
@@ -146,6 +157,11 @@ func resourceChromePolicyUpdate(ctx context.Context, d *schema.ResourceData, met
 
 	log.Printf("[DEBUG] Updating Chrome Policy for org:%s", d.Id())
 
+	// inherit_on_destroy only affects Terraform's behavior
+	if !d.HasChange("policies") {
+		return resourceChromePolicyRead(ctx, d, meta)
+	}
+
 	policyTargetKey := &chromepolicy.GoogleChromePolicyV1PolicyTargetKey{
 		TargetResource: "orgunits/" + d.Id(),
 	}
@@ -154,7 +170,7 @@ func resourceChromePolicyUpdate(ctx context.Context, d *schema.ResourceData, met
 	old, _ := d.GetChange("policies")
 
 	var requests []*chromepolicy.GoogleChromePolicyV1InheritOrgUnitPolicyRequest
-	for _, p := range old.([]interface{}) {
+	for _, p := range old.(*schema.Set).List() {
 		policy := p.(map[string]interface{})
 		schemaName := policy["schema_name"].(string)
 
@@ -164,13 +180,16 @@ func resourceChromePolicyUpdate(ctx context.Context, d *schema.ResourceData, met
 		})
 	}
 
-	err := retryTimeDuration(ctx, time.Minute, func() error {
-		_, retryErr := chromePoliciesService.Orgunits.BatchInherit(fmt.Sprintf("customers/%s", client.Customer), &chromepolicy.GoogleChromePolicyV1BatchInheritOrgUnitPoliciesRequest{Requests: requests}).Do()
-		return retryErr
-	})
+	// policies reset outside of Terraform are no longer in state, there may be nothing to inherit
+	if len(requests) > 0 {
+		err := retryTimeDuration(ctx, time.Minute, func() error {
+			_, retryErr := chromePoliciesService.Orgunits.BatchInherit(fmt.Sprintf("customers/%s", client.Customer), &chromepolicy.GoogleChromePolicyV1BatchInheritOrgUnitPoliciesRequest{Requests: requests}).Do()
+			return retryErr
+		})
 
-	if err != nil {
-		return diag.FromErr(err)
+		if err != nil {
+			return apiErrorDiagnostics(err)
+		}
 	}
 
 	// run create
@@ -204,7 +223,7 @@ func resourceChromePolicyRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	policiesObj := []*chromepolicy.GoogleChromePolicyV1PolicyValue{}
-	for _, p := range d.Get("policies").([]interface{}) {
+	for _, p := range d.Get("policies").(*schema.Set).List() {
 		policy := p.(map[string]interface{})
 		schemaName := policy["schema_name"].(string)
 
@@ -221,13 +240,27 @@ func resourceChromePolicyRead(ctx context.Context, d *schema.ResourceData, meta
 			return retryErr
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			// the org unit the policies are set on was deleted
+			return handleReadNotFoundError(err, d, policyTargetKey.TargetResource)
+		}
+
+		// the policy was reset outside of Terraform, drop it from state so it is applied again
+		if len(resp.ResolvedPolicies) == 0 {
+			log.Printf("[WARN] Chrome Policy %s is no longer set for org:%s, removing it from state", schemaName, d.Id())
+			continue
 		}
 
 		if len(resp.ResolvedPolicies) != 1 {
 			return diag.Errorf("unexpected number of resolved policies for schema: %s", schemaName)
 		}
 
+		// the policy is only inherited from a parent org unit, so it is no longer set on this org unit
+		sourceKey := resp.ResolvedPolicies[0].SourceKey
+		if sourceKey != nil && sourceKey.TargetResource != policyTargetKey.TargetResource {
+			log.Printf("[WARN] Chrome Policy %s for org:%s is inherited from %s, removing it from state", schemaName, d.Id(), sourceKey.TargetResource)
+			continue
+		}
+
 		value := resp.ResolvedPolicies[0].Value
 
 		policiesObj = append(policiesObj, value)
@@ -242,11 +275,24 @@ func resourceChromePolicyRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
+	// inherit_on_destroy is not returned by the API, default it for resources created before it existed
+	if _, ok := d.GetOkExists("inherit_on_destroy"); !ok {
+		d.Set("inherit_on_destroy", true)
+	}
+
 	log.Printf("[DEBUG] Finished getting Chrome Policy for org:%s", d.Id())
 	return nil
 }
 
 func resourceChromePolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.Get("inherit_on_destroy").(bool) {
+		log.Printf("[DEBUG] Removing Chrome Policy for org:%s from state, keeping its current values", d.Id())
+
+		d.SetId("")
+
+		return nil
+	}
+
 	client := meta.(*apiClient)
 
 	chromePolicyService, diags := client.NewChromePolicyService()
@@ -266,7 +312,7 @@ func resourceChromePolicyDelete(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	var requests []*chromepolicy.GoogleChromePolicyV1InheritOrgUnitPolicyRequest
-	for _, p := range d.Get("policies").([]interface{}) {
+	for _, p := range d.Get("policies").(*schema.Set).List() {
 		policy := p.(map[string]interface{})
 		schemaName := policy["schema_name"].(string)
 
@@ -276,13 +322,16 @@ func resourceChromePolicyDelete(ctx context.Context, d *schema.ResourceData, met
 		})
 	}
 
-	err := retryTimeDuration(ctx, time.Minute, func() error {
-		_, retryErr := chromePoliciesService.Orgunits.BatchInherit(fmt.Sprintf("customers/%s", client.Customer), &chromepolicy.GoogleChromePolicyV1BatchInheritOrgUnitPoliciesRequest{Requests: requests}).Do()
-		return retryErr
-	})
+	// policies reset outside of Terraform are no longer in state, there may be nothing to inherit
+	if len(requests) > 0 {
+		err := retryTimeDuration(ctx, time.Minute, func() error {
+			_, retryErr := chromePoliciesService.Orgunits.BatchInherit(fmt.Sprintf("customers/%s", client.Customer), &chromepolicy.GoogleChromePolicyV1BatchInheritOrgUnitPoliciesRequest{Requests: requests}).Do()
+			return retryErr
+		})
 
-	if err != nil {
-		return diag.FromErr(err)
+		if err != nil {
+			return apiErrorDiagnostics(err)
+		}
 	}
 
 	log.Printf("[DEBUG] Finished deleting Chrome Policy for org:%s", d.Id())
@@ -291,83 +340,276 @@ func resourceChromePolicyDelete(ctx context.Context, d *schema.ResourceData, met
 
 // Chrome Policies
 
-func validateChromePolicies(ctx context.Context, d *schema.ResourceData, client *apiClient) diag.Diagnostics {
-	var diags diag.Diagnostics
+// decodePolicyFieldValue decodes a value of schema_values by the type of its field in the policy schema.
+// Strings and enums are taken as is, or unquoted if JSON encoded, booleans and numbers are parsed, also when
+// JSON encoded as a string, and messages and repeated fields must be JSON encoded.
+func decodePolicyFieldValue(field *chromepolicy.Proto2FieldDescriptorProto, v string) (interface{}, error) {
+	var value interface{}
+	jsonErr := json.Unmarshal([]byte(v), &value)
 
-	new := d.Get("policies")
+	if field.Label == "LABEL_REPEATED" || field.Type == "TYPE_MESSAGE" {
+		if jsonErr != nil {
+			return nil, fmt.Errorf("value provided for %s must be JSON encoded, e.g. with jsonencode(): %s", field.Name, jsonErr)
+		}
 
-	chromePolicyService, diags := client.NewChromePolicyService()
-	if diags.HasError() {
-		return diags
+		return value, nil
 	}
 
-	chromePolicySchemasService, diags := GetChromePolicySchemasService(chromePolicyService)
-	if diags.HasError() {
-		return diags
+	if s, ok := value.(string); jsonErr == nil && ok {
+		v = s
+	}
+
+	switch field.Type {
+	case "TYPE_BOOL":
+		if b, err := strconv.ParseBool(v); err == nil {
+			return b, nil
+		}
+	case "TYPE_FLOAT", "TYPE_DOUBLE",
+		"TYPE_INT64", "TYPE_FIXED64", "TYPE_SFIXED64", "TYPE_SINT64", "TYPE_UINT64",
+		"TYPE_INT32", "TYPE_FIXED32", "TYPE_SFIXED32", "TYPE_SINT32", "TYPE_UINT32":
+		// decoded as float like JSON numbers, validatePolicyFieldValueType checks integers
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			return f, nil
+		}
 	}
 
-	// Validate config against schemas
-	for _, policy := range new.([]interface{}) {
+	// strings, enums, and values that don't parse, which fail validation with the expected type
+	return v, nil
+}
+
+// normalizePolicyValue returns the value stored in schema_values without its JSON encoding
+func normalizePolicyValue(v string) string {
+	var value interface{}
+	if err := json.Unmarshal([]byte(v), &value); err != nil {
+		return v
+	}
+
+	if s, ok := value.(string); ok {
+		return s
+	}
+
+	// e.g. reformatted messages
+	b, err := json.Marshal(value)
+	if err != nil {
+		return v
+	}
+
+	return string(b)
+}
+
+// The field types aren't known when diffing, values are compared without their JSON encoding so that
+// natively written values and those written with jsonencode() match the values read from the API
+func diffSuppressPolicyValue(k, old, new string, d *schema.ResourceData) bool {
+	return normalizePolicyValue(old) == normalizePolicyValue(new)
+}
+
+// chromePolicyHash identifies policies by their schema name, so changes to the values
+// are planned as an update of the existing policy
+func chromePolicyHash(v interface{}) int {
+	return schema.HashString(v.(map[string]interface{})["schema_name"].(string))
+}
+
+// Validate the policies at plan time, so malformed values fail before reaching the API
+func resourceChromePolicyCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() || !rawConfig.GetAttr("policies").IsWhollyKnown() {
+		return nil
+	}
+
+	_, diags := expandChromePolicies(ctx, diff.Get("policies").(*schema.Set).List(), meta.(*apiClient))
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			return fmt.Errorf("%s", d.Summary)
+		}
+	}
+
+	return nil
+}
+
+// expandChromePolicies decodes the values of the policies by their schema definitions, which validates them
+func expandChromePolicies(ctx context.Context, policies []interface{}, client *apiClient) ([]*chromepolicy.GoogleChromePolicyV1PolicyValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	result := []*chromepolicy.GoogleChromePolicyV1PolicyValue{}
+
+	var chromePolicySchemasService *chromepolicy.CustomersPolicySchemasService
+
+	for _, policy := range policies {
 		schemaName := policy.(map[string]interface{})["schema_name"].(string)
+		policyDef := policy.(map[string]interface{})["schema_values"].(map[string]interface{})
 
-		var schemaDef *chromepolicy.GoogleChromePolicyV1PolicySchema
-		err := retryTimeDuration(ctx, time.Minute, func() error {
-			var retryErr error
+		// Decode with the bundled definitions first, so plans of common schemas don't depend on the
+		// API. They may be outdated, so the live schema definition decides if the policy doesn't pass them.
+		snapshotDef, inSnapshot := snapshotChromePolicySchema(schemaName)
+		var values map[string]interface{}
+		var snapshotDiags diag.Diagnostics
+		if inSnapshot {
+			values, snapshotDiags = decodeChromePolicySchemaValues(schemaName, snapshotDef, policyDef)
+		}
 
-			schemaDef, retryErr = chromePolicySchemasService.Get(fmt.Sprintf("customers/%s/policySchemas/%s", client.Customer, schemaName)).Do()
-			return retryErr
-		})
+		if !inSnapshot || snapshotDiags.HasError() {
+			if chromePolicySchemasService == nil {
+				chromePolicyService, diags := client.NewChromePolicyService()
+				if diags.HasError() {
+					return nil, diags
+				}
+
+				chromePolicySchemasService, diags = GetChromePolicySchemasService(chromePolicyService)
+				if diags.HasError() {
+					return nil, diags
+				}
+			}
+
+			var schemaDef *chromepolicy.GoogleChromePolicyV1PolicySchema
+			err := retryTimeDuration(ctx, time.Minute, func() error {
+				var retryErr error
+
+				schemaDef, retryErr = chromePolicySchemasService.Get(fmt.Sprintf("customers/%s/policySchemas/%s", client.Customer, schemaName)).Do()
+				return retryErr
+			})
+			if err != nil {
+				if inSnapshot {
+					log.Printf("[WARN] unable to retrieve schema definition (%s), using the bundled definition: %s", schemaName, err)
+					return nil, snapshotDiags
+				}
+
+				return nil, apiErrorDiagnostics(err)
+			}
+
+			values, diags = decodeChromePolicySchemaValues(schemaName, schemaDef, policyDef)
+			if diags.HasError() {
+				return nil, diags
+			}
+		}
+
+		schemaValuesJson, err := json.Marshal(values)
 		if err != nil {
-			return diag.FromErr(err)
+			return nil, diag.FromErr(err)
 		}
 
-		if schemaDef == nil || schemaDef.Definition == nil || schemaDef.Definition.MessageType == nil {
-			return append(diags, diag.Diagnostic{
-				Summary:  fmt.Sprintf("schema definition (%s) is empty", schemaName),
+		result = append(result, &chromepolicy.GoogleChromePolicyV1PolicyValue{
+			PolicySchema: schemaName,
+			Value:        schemaValuesJson,
+		})
+	}
+
+	return result, diags
+}
+
+// decodeChromePolicySchemaValues decodes and validates the values of a single policy against its schema definition
+func decodeChromePolicySchemaValues(schemaName string, schemaDef *chromepolicy.GoogleChromePolicyV1PolicySchema, policyDef map[string]interface{}) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if schemaDef == nil || schemaDef.Definition == nil || schemaDef.Definition.MessageType == nil {
+		return nil, append(diags, diag.Diagnostic{
+			Summary:  fmt.Sprintf("schema definition (%s) is empty", schemaName),
+			Severity: diag.Error,
+		})
+	}
+
+	messageTypes := map[string]*chromepolicy.Proto2DescriptorProto{}
+	indexPolicyMessageTypes(schemaDef.Definition.MessageType, messageTypes)
+
+	schemaFieldMap := map[string]*chromepolicy.Proto2FieldDescriptorProto{}
+	for _, schemaField := range schemaDef.Definition.MessageType {
+		for _, schemaNestedField := range schemaField.Field {
+			if _, ok := schemaFieldMap[schemaNestedField.Name]; !ok {
+				schemaFieldMap[schemaNestedField.Name] = schemaNestedField
+			}
+		}
+	}
+
+	values := map[string]interface{}{}
+	for polKey, polJsonVal := range policyDef {
+		schemaField, ok := schemaFieldMap[polKey]
+		if !ok {
+			return nil, append(diags, diag.Diagnostic{
+				Summary:  fmt.Sprintf("field name (%s) is not found in this schema definition (%s)", polKey, schemaName),
 				Severity: diag.Error,
 			})
 		}
 
-		schemaFieldMap := map[string][]*chromepolicy.Proto2FieldDescriptorProto{}
-		for _, schemaField := range schemaDef.Definition.MessageType {
-			for _, schemaNestedField := range schemaField.Field {
-				schemaFieldMap[schemaNestedField.Name] = schemaField.Field
-			}
+		if schemaField == nil {
+			return nil, append(diags, diag.Diagnostic{
+				Summary:  fmt.Sprintf("field type is not defined for field name (%s)", polKey),
+				Severity: diag.Warning,
+			})
 		}
 
-		policyDef := policy.(map[string]interface{})["schema_values"].(map[string]interface{})
+		polVal, err := decodePolicyFieldValue(schemaField, polJsonVal.(string))
+		if err == nil {
+			err = validatePolicyFieldValue(schemaField, polVal, messageTypes, polKey)
+		}
+		if err != nil {
+			return nil, append(diags, diag.Diagnostic{
+				Summary:  err.Error(),
+				Severity: diag.Error,
+			})
+		}
 
-		for polKey, polJsonVal := range policyDef {
-			if _, ok := schemaFieldMap[polKey]; !ok {
-				return append(diags, diag.Diagnostic{
-					Summary:  fmt.Sprintf("field name (%s) is not found in this schema definition (%s)", polKey, schemaName),
-					Severity: diag.Error,
-				})
-			}
+		values[polKey] = polVal
+	}
 
-			var polVal interface{}
-			err := json.Unmarshal([]byte(polJsonVal.(string)), &polVal)
-			if err != nil {
-				return diag.FromErr(err)
-			}
+	return values, nil
+}
+
+func indexPolicyMessageTypes(messageTypes []*chromepolicy.Proto2DescriptorProto, result map[string]*chromepolicy.Proto2DescriptorProto) {
+	for _, messageType := range messageTypes {
+		result[messageType.Name] = messageType
+		indexPolicyMessageTypes(messageType.NestedType, result)
+	}
+}
 
-			for _, schemaField := range schemaFieldMap[polKey] {
+// validatePolicyFieldValue validates a value against its field descriptor, recursing into
+// repeated and message fields. path is the location of the value, used in error messages.
+func validatePolicyFieldValue(field *chromepolicy.Proto2FieldDescriptorProto, value interface{}, messageTypes map[string]*chromepolicy.Proto2DescriptorProto, path string) error {
+	if field.Label != "LABEL_REPEATED" {
+		return validatePolicyFieldSingleValue(field, value, messageTypes, path)
+	}
 
-				if schemaField == nil {
-					return append(diags, diag.Diagnostic{
-						Summary:  fmt.Sprintf("field type is not defined for field name (%s)", polKey),
-						Severity: diag.Warning,
-					})
-				}
+	values, ok := value.([]interface{})
+	if !ok {
+		return fmt.Errorf("value provided for %s is of incorrect type (expected a list of type: %s)", path, field.Type)
+	}
 
-				validType := validatePolicyFieldValueType(schemaField.Type, polVal)
-				if !validType {
-					return append(diags, diag.Diagnostic{
-						Summary:  fmt.Sprintf("value provided for %s is of incorrect type (expected type: %s)", schemaField.Name, schemaField.Type),
-						Severity: diag.Error,
-					})
-				}
-			}
+	for i, v := range values {
+		if err := validatePolicyFieldSingleValue(field, v, messageTypes, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validatePolicyFieldSingleValue(field *chromepolicy.Proto2FieldDescriptorProto, value interface{}, messageTypes map[string]*chromepolicy.Proto2DescriptorProto, path string) error {
+	if !validatePolicyFieldValueType(field.Type, value) {
+		return fmt.Errorf("value provided for %s is of incorrect type (expected type: %s)", path, field.Type)
+	}
+
+	if field.Type != "TYPE_MESSAGE" {
+		return nil
+	}
+
+	// type names may be fully qualified, e.g. `.chrome.policy.ManagedBookmarksProto`
+	typeNameParts := strings.Split(field.TypeName, ".")
+	messageType, ok := messageTypes[typeNameParts[len(typeNameParts)-1]]
+	if !ok {
+		log.Printf("[WARN] message type %q of %s is not part of the schema definition, skipping validation", field.TypeName, path)
+		return nil
+	}
+
+	nestedFields := map[string]*chromepolicy.Proto2FieldDescriptorProto{}
+	for _, nestedField := range messageType.Field {
+		nestedFields[nestedField.Name] = nestedField
+	}
+
+	for k, v := range value.(map[string]interface{}) {
+		nestedField, ok := nestedFields[k]
+		if !ok {
+			return fmt.Errorf("field name (%s.%s) is not found in message type (%s)", path, k, messageType.Name)
+		}
+
+		if err := validatePolicyFieldValue(nestedField, v, messageTypes, fmt.Sprintf("%s.%s", path, k)); err != nil {
+			return err
 		}
 	}
 
@@ -409,13 +651,13 @@ func validatePolicyFieldValueType(fieldType string, fieldValue interface{}) bool
 		fallthrough
 	case "TYPE_UINT32":
 		// this is unmarshalled as a float, check that it's an int
-		if reflect.ValueOf(fieldValue).Kind() == reflect.Float32 &&
-			fieldValue == float32(int(fieldValue.(float32))) {
+		if reflect.ValueOf(fieldValue).Kind() == reflect.Float64 &&
+			fieldValue == float64(int32(fieldValue.(float64))) {
 			valid = true
 		}
 	case "TYPE_MESSAGE":
+		// nested fields are validated by validatePolicyFieldSingleValue
 		valid = reflect.ValueOf(fieldValue).Kind() == reflect.Map
-		// TODO we should probably recursively ensure the type is correct
 	case "TYPE_ENUM":
 		fallthrough
 	case "TYPE_STRING":
@@ -477,45 +719,6 @@ func convertPolicyFieldValueType(fieldType string, fieldValue interface{}) (inte
 	return value, err
 }
 
-func expandChromePoliciesValues(policies []interface{}) ([]*chromepolicy.GoogleChromePolicyV1PolicyValue, diag.Diagnostics) {
-	var diags diag.Diagnostics
-	result := []*chromepolicy.GoogleChromePolicyV1PolicyValue{}
-
-	for _, p := range policies {
-		policy := p.(map[string]interface{})
-
-		schemaName := policy["schema_name"].(string)
-		schemaValues := policy["schema_values"].(map[string]interface{})
-
-		policyValuesObj := map[string]interface{}{}
-
-		for k, v := range schemaValues {
-			var polVal interface{}
-			err := json.Unmarshal([]byte(v.(string)), &polVal)
-			if err != nil {
-				return nil, diag.FromErr(err)
-			}
-
-			policyValuesObj[k] = polVal
-		}
-
-		// create the json object and assign to the schema
-		schemaValuesJson, err := json.Marshal(policyValuesObj)
-		if err != nil {
-			return nil, diag.FromErr(err)
-		}
-
-		policyObj := chromepolicy.GoogleChromePolicyV1PolicyValue{
-			PolicySchema: schemaName,
-			Value:        schemaValuesJson,
-		}
-
-		result = append(result, &policyObj)
-	}
-
-	return result, diags
-}
-
 func flattenChromePolicies(ctx context.Context, policiesObj []*chromepolicy.GoogleChromePolicyV1PolicyValue, client *apiClient) ([]map[string]interface{}, diag.Diagnostics) {
 	var policies []map[string]interface{}
 
@@ -538,7 +741,7 @@ func flattenChromePolicies(ctx context.Context, policiesObj []*chromepolicy.Goog
 			return retryErr
 		})
 		if err != nil {
-			return nil, diag.FromErr(err)
+			return nil, apiErrorDiagnostics(err)
 		}
 
 		if schemaDef == nil || schemaDef.Definition == nil || schemaDef.Definition.MessageType == nil {
@@ -548,10 +751,12 @@ func flattenChromePolicies(ctx context.Context, policiesObj []*chromepolicy.Goog
 			})
 		}
 
-		schemaFieldMap := map[string][]*chromepolicy.Proto2FieldDescriptorProto{}
+		schemaFieldMap := map[string]*chromepolicy.Proto2FieldDescriptorProto{}
 		for _, schemaField := range schemaDef.Definition.MessageType {
 			for _, schemaNestedField := range schemaField.Field {
-				schemaFieldMap[schemaNestedField.Name] = schemaField.Field
+				if _, ok := schemaFieldMap[schemaNestedField.Name]; !ok {
+					schemaFieldMap[schemaNestedField.Name] = schemaNestedField
+				}
 			}
 		}
 
@@ -564,33 +769,31 @@ func flattenChromePolicies(ctx context.Context, policiesObj []*chromepolicy.Goog
 
 		schemaValues := map[string]interface{}{}
 		for k, v := range schemaValuesObj {
-			if _, ok := schemaFieldMap[k]; !ok {
+			schemaField, ok := schemaFieldMap[k]
+			if !ok {
 				return nil, append(diags, diag.Diagnostic{
 					Summary:  fmt.Sprintf("field name (%s) is not found in this schema definition (%s)", k, polObj.PolicySchema),
 					Severity: diag.Warning,
 				})
 			}
 
-			for _, schemaField := range schemaFieldMap[k] {
-
-				if schemaField == nil {
-					return nil, append(diags, diag.Diagnostic{
-						Summary:  fmt.Sprintf("field type is not defined for field name (%s)", k),
-						Severity: diag.Warning,
-					})
-				}
+			if schemaField == nil {
+				return nil, append(diags, diag.Diagnostic{
+					Summary:  fmt.Sprintf("field type is not defined for field name (%s)", k),
+					Severity: diag.Warning,
+				})
+			}
 
-				val, err := convertPolicyFieldValueType(schemaField.Type, v)
-				if err != nil {
-					return nil, diag.FromErr(err)
-				}
+			val, err := convertPolicyFieldValueType(schemaField.Type, v)
+			if err != nil {
+				return nil, diag.FromErr(err)
+			}
 
-				jsonVal, err := json.Marshal(val)
-				if err != nil {
-					return nil, diag.FromErr(err)
-				}
-				schemaValues[k] = string(jsonVal)
+			jsonVal, err := json.Marshal(val)
+			if err != nil {
+				return nil, diag.FromErr(err)
 			}
+			schemaValues[k] = string(jsonVal)
 		}
 
 		policies = append(policies, map[string]interface{}{
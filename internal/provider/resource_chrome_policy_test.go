@@ -7,6 +7,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"reflect"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -37,6 +39,38 @@ func TestAccResourceChromePolicy_basic(t *testing.T) {
 	})
 }
 
+func TestAccResourceChromePolicy_nativeValues(t *testing.T) {
+	t.Parallel()
+
+	ouName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceChromePolicy_nativeValues(ouName, 33, ".*@example"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_chrome_policy.test", "policies.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("googleworkspace_chrome_policy.test", "policies.*", map[string]string{
+						"schema_name":                           "chrome.users.RestrictSigninToPattern",
+						"schema_values.restrictSigninToPattern": encode(".*@example"),
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("googleworkspace_chrome_policy.test", "policies.*", map[string]string{
+						"schema_name":                          "chrome.users.MaxConnectionsPerProxy",
+						"schema_values.maxConnectionsPerProxy": "33",
+					}),
+				),
+			},
+			{
+				// native and JSON encoded values are equivalent
+				Config:   testAccResourceChromePolicy_multiple(ouName, 33, ".*@example"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccResourceChromePolicy_typeMessage(t *testing.T) {
 	t.Parallel()
 
@@ -58,6 +92,228 @@ func TestAccResourceChromePolicy_typeMessage(t *testing.T) {
 	})
 }
 
+func TestAccResourceChromePolicy_typeMessageInvalid(t *testing.T) {
+	t.Parallel()
+
+	ouName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceChromePolicy_typeMessageInvalid(ouName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`value provided for managedBookmarks.toplevelName is of incorrect type`),
+			},
+		},
+	})
+}
+
+func TestResourceChromePolicy_validatePolicyFieldValue(t *testing.T) {
+	t.Parallel()
+
+	messageTypes := map[string]*chromepolicy.Proto2DescriptorProto{}
+	indexPolicyMessageTypes([]*chromepolicy.Proto2DescriptorProto{
+		{
+			Name: "ManagedBookmarksSetting",
+			Field: []*chromepolicy.Proto2FieldDescriptorProto{
+				{Name: "managedBookmarks", Type: "TYPE_MESSAGE", TypeName: ".chrome.policy.ManagedBookmarks"},
+			},
+		},
+		{
+			Name: "ManagedBookmarks",
+			Field: []*chromepolicy.Proto2FieldDescriptorProto{
+				{Name: "toplevelName", Type: "TYPE_STRING"},
+				{Name: "bookmarks", Type: "TYPE_MESSAGE", TypeName: "Bookmark", Label: "LABEL_REPEATED"},
+			},
+			NestedType: []*chromepolicy.Proto2DescriptorProto{
+				{
+					Name: "Bookmark",
+					Field: []*chromepolicy.Proto2FieldDescriptorProto{
+						{Name: "name", Type: "TYPE_STRING"},
+						{Name: "position", Type: "TYPE_INT32"},
+					},
+				},
+			},
+		},
+	}, messageTypes)
+
+	field := messageTypes["ManagedBookmarksSetting"].Field[0]
+
+	cases := map[string]struct {
+		value string
+		err   string
+	}{
+		"valid": {
+			value: `{"toplevelName": "Stuff", "bookmarks": [{"name": "a", "position": 1}]}`,
+		},
+		"wrong nested type": {
+			value: `{"toplevelName": 5}`,
+			err:   "value provided for managedBookmarks.toplevelName is of incorrect type (expected type: TYPE_STRING)",
+		},
+		"unknown nested field": {
+			value: `{"topLevelName": "Stuff"}`,
+			err:   "field name (managedBookmarks.topLevelName) is not found in message type (ManagedBookmarks)",
+		},
+		"repeated field not a list": {
+			value: `{"bookmarks": {"name": "a"}}`,
+			err:   "value provided for managedBookmarks.bookmarks is of incorrect type (expected a list of type: TYPE_MESSAGE)",
+		},
+		"wrong type in repeated message": {
+			value: `{"bookmarks": [{"name": "a"}, {"position": 1.5}]}`,
+			err:   "value provided for managedBookmarks.bookmarks[1].position is of incorrect type (expected type: TYPE_INT32)",
+		},
+	}
+
+	for name, tc := range cases {
+		var value interface{}
+		if err := json.Unmarshal([]byte(tc.value), &value); err != nil {
+			t.Fatalf("%s: %s", name, err)
+		}
+
+		err := validatePolicyFieldValue(field, value, messageTypes, "managedBookmarks")
+		if tc.err == "" && err != nil {
+			t.Errorf("%s: unexpected error: %s", name, err)
+		}
+		if tc.err != "" && (err == nil || err.Error() != tc.err) {
+			t.Errorf("%s: expected error %q, got %v", name, tc.err, err)
+		}
+	}
+}
+
+func TestResourceChromePolicy_decodePolicyFieldValue(t *testing.T) {
+	stringField := &chromepolicy.Proto2FieldDescriptorProto{Name: "restrictSigninToPattern", Type: "TYPE_STRING"}
+	boolField := &chromepolicy.Proto2FieldDescriptorProto{Name: "enableOnlineRevocationChecks", Type: "TYPE_BOOL"}
+	intField := &chromepolicy.Proto2FieldDescriptorProto{Name: "maxConnectionsPerProxy", Type: "TYPE_INT64"}
+	messageField := &chromepolicy.Proto2FieldDescriptorProto{Name: "managedBookmarks", Type: "TYPE_MESSAGE"}
+	listField := &chromepolicy.Proto2FieldDescriptorProto{Name: "urls", Type: "TYPE_STRING", Label: "LABEL_REPEATED"}
+
+	cases := map[string]struct {
+		field *chromepolicy.Proto2FieldDescriptorProto
+		value string
+		want  interface{}
+		err   bool
+	}{
+		"native string":          {field: stringField, value: ".*@example", want: ".*@example"},
+		"encoded string":         {field: stringField, value: `".*@example"`, want: ".*@example"},
+		"string looks like int":  {field: stringField, value: "123", want: "123"},
+		"string looks like bool": {field: stringField, value: "true", want: "true"},
+		"native bool":            {field: boolField, value: "true", want: true},
+		"encoded bool":           {field: boolField, value: `"false"`, want: false},
+		"native int":             {field: intField, value: "33", want: float64(33)},
+		"not a number":           {field: intField, value: "lots", want: "lots"},
+		"message":                {field: messageField, value: `{"toplevelName": "Stuff"}`, want: map[string]interface{}{"toplevelName": "Stuff"}},
+		"message not encoded":    {field: messageField, value: "Stuff", err: true},
+		"list":                   {field: listField, value: `["a", "b"]`, want: []interface{}{"a", "b"}},
+		"list not encoded":       {field: listField, value: "a", err: true},
+	}
+
+	for name, tc := range cases {
+		got, err := decodePolicyFieldValue(tc.field, tc.value)
+		if tc.err {
+			if err == nil {
+				t.Errorf("%s: expected an error, got %v", name, got)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", name, err)
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%s: expected %#v, got %#v", name, tc.want, got)
+		}
+	}
+}
+
+func TestResourceChromePolicy_diffSuppressPolicyValue(t *testing.T) {
+	cases := map[string]struct {
+		old, new string
+		suppress bool
+	}{
+		"native number":          {old: "33", new: "33", suppress: true},
+		"native string":          {old: `".*@example"`, new: ".*@example", suppress: true},
+		"encoded string":         {old: `".*@example"`, new: `".*@example"`, suppress: true},
+		"native bool":            {old: "true", new: "true", suppress: true},
+		"reformatted message":    {old: `{"toplevelName":"Stuff"}`, new: `{ "toplevelName": "Stuff" }`, suppress: true},
+		"changed number":         {old: "33", new: "34", suppress: false},
+		"changed string":         {old: `".*@example"`, new: ".*@example.com", suppress: false},
+		"string looks like bool": {old: "true", new: `"true"`, suppress: true},
+		"encoded in state":       {old: `".*@example"`, new: ".*@example", suppress: true},
+		"changed bool":           {old: "true", new: "false", suppress: false},
+	}
+
+	for name, tc := range cases {
+		if got := diffSuppressPolicyValue("", tc.old, tc.new, nil); got != tc.suppress {
+			t.Errorf("%s: expected suppress to be %t, got %t", name, tc.suppress, got)
+		}
+	}
+}
+
+func TestAccResourceChromePolicy_drift(t *testing.T) {
+	t.Parallel()
+
+	ouName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	var orgUnitId string
+
+	// resets the policy outside of Terraform, as an admin would in the Admin console
+	resetPolicy := func() {
+		client, err := googleworkspaceTestClient()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		chromePolicyService, diags := client.NewChromePolicyService()
+		if diags.HasError() {
+			t.Fatal(diags[0].Summary)
+		}
+
+		chromePoliciesService, diags := GetChromePoliciesService(chromePolicyService)
+		if diags.HasError() {
+			t.Fatal(diags[0].Summary)
+		}
+
+		_, err = chromePoliciesService.Orgunits.BatchInherit(fmt.Sprintf("customers/%s", client.Customer), &chromepolicy.GoogleChromePolicyV1BatchInheritOrgUnitPoliciesRequest{
+			Requests: []*chromepolicy.GoogleChromePolicyV1InheritOrgUnitPolicyRequest{
+				{
+					PolicyTargetKey: &chromepolicy.GoogleChromePolicyV1PolicyTargetKey{
+						TargetResource: "orgunits/" + strings.TrimPrefix(orgUnitId, "id:"),
+					},
+					PolicySchema: "chrome.users.MaxConnectionsPerProxy",
+				},
+			},
+		}).Do()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceChromePolicy_basic(ouName, 33),
+				Check: func(s *terraform.State) error {
+					orgUnitId = s.RootModule().Resources["googleworkspace_org_unit.test"].Primary.ID
+					return nil
+				},
+			},
+			{
+				// the reset policy is detected as drift and applied again
+				PreConfig: resetPolicy,
+				Config:    testAccResourceChromePolicy_basic(ouName, 33),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_chrome_policy.test", "policies.#", "1"),
+					resource.TestCheckResourceAttr("googleworkspace_chrome_policy.test", "policies.0.schema_values.maxConnectionsPerProxy", "33"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourceChromePolicy_update(t *testing.T) {
 	t.Parallel()
 
@@ -87,6 +343,69 @@ func TestAccResourceChromePolicy_update(t *testing.T) {
 	})
 }
 
+func TestAccResourceChromePolicy_keepOnDestroy(t *testing.T) {
+	t.Parallel()
+
+	ouName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	// ensures the policy is still set on the org unit after the resource is destroyed
+	testCheck := func(s *terraform.State) error {
+		client, err := googleworkspaceTestClient()
+		if err != nil {
+			return err
+		}
+
+		rs, ok := s.RootModule().Resources["googleworkspace_org_unit.test"]
+		if !ok {
+			return fmt.Errorf("Can't find org unit resource: googleworkspace_org_unit.test")
+		}
+
+		chromePolicyService, diags := client.NewChromePolicyService()
+		if diags.HasError() {
+			return errors.New(diags[0].Summary)
+		}
+
+		chromePoliciesService, diags := GetChromePoliciesService(chromePolicyService)
+		if diags.HasError() {
+			return errors.New(diags[0].Summary)
+		}
+
+		policyTargetKey := &chromepolicy.GoogleChromePolicyV1PolicyTargetKey{
+			TargetResource: "orgunits/" + strings.TrimPrefix(rs.Primary.ID, "id:"),
+		}
+
+		resp, err := chromePoliciesService.Resolve(fmt.Sprintf("customers/%s", client.Customer), &chromepolicy.GoogleChromePolicyV1ResolveRequest{
+			PolicySchemaFilter: "chrome.users.MaxConnectionsPerProxy",
+			PolicyTargetKey:    policyTargetKey,
+		}).Do()
+		if err != nil {
+			return err
+		}
+		if len(resp.ResolvedPolicies) == 0 || resp.ResolvedPolicies[0].SourceKey.TargetResource != policyTargetKey.TargetResource {
+			return fmt.Errorf("Expected policy to be kept on the org unit")
+		}
+		return nil
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceChromePolicy_keepOnDestroy(ouName, 33),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_chrome_policy.test", "inherit_on_destroy", "false"),
+					resource.TestCheckResourceAttr("googleworkspace_chrome_policy.test", "policies.0.schema_values.maxConnectionsPerProxy", "33"),
+				),
+			},
+			{
+				Config: testAccResourceChromePolicy_orgUnitOnly(ouName),
+				Check:  testCheck,
+			},
+		},
+	})
+}
+
 func TestAccResourceChromePolicy_multiple(t *testing.T) {
 	t.Parallel()
 
@@ -145,30 +464,47 @@ func TestAccResourceChromePolicy_multiple(t *testing.T) {
 				Config: testAccResourceChromePolicy_multiple(ouName, 33, ".*@example"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("googleworkspace_chrome_policy.test", "policies.#", "2"),
-					resource.TestCheckResourceAttr("googleworkspace_chrome_policy.test", "policies.0.schema_name", "chrome.users.RestrictSigninToPattern"),
-					resource.TestCheckResourceAttr("googleworkspace_chrome_policy.test", "policies.0.schema_values.restrictSigninToPattern", encode(".*@example")),
-					resource.TestCheckResourceAttr("googleworkspace_chrome_policy.test", "policies.1.schema_name", "chrome.users.MaxConnectionsPerProxy"),
-					resource.TestCheckResourceAttr("googleworkspace_chrome_policy.test", "policies.1.schema_values.maxConnectionsPerProxy", "33"),
+					resource.TestCheckTypeSetElemNestedAttrs("googleworkspace_chrome_policy.test", "policies.*", map[string]string{
+						"schema_name":                           "chrome.users.RestrictSigninToPattern",
+						"schema_values.restrictSigninToPattern": encode(".*@example"),
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("googleworkspace_chrome_policy.test", "policies.*", map[string]string{
+						"schema_name":                          "chrome.users.MaxConnectionsPerProxy",
+						"schema_values.maxConnectionsPerProxy": "33",
+					}),
 				),
 			},
 			{
 				Config: testAccResourceChromePolicy_multipleRearranged(ouName, 34, ".*@example.com"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("googleworkspace_chrome_policy.test", "policies.#", "2"),
-					resource.TestCheckResourceAttr("googleworkspace_chrome_policy.test", "policies.0.schema_name", "chrome.users.MaxConnectionsPerProxy"),
-					resource.TestCheckResourceAttr("googleworkspace_chrome_policy.test", "policies.0.schema_values.maxConnectionsPerProxy", "34"),
-					resource.TestCheckResourceAttr("googleworkspace_chrome_policy.test", "policies.1.schema_name", "chrome.users.RestrictSigninToPattern"),
-					resource.TestCheckResourceAttr("googleworkspace_chrome_policy.test", "policies.1.schema_values.restrictSigninToPattern", encode(".*@example.com")),
+					resource.TestCheckTypeSetElemNestedAttrs("googleworkspace_chrome_policy.test", "policies.*", map[string]string{
+						"schema_name":                          "chrome.users.MaxConnectionsPerProxy",
+						"schema_values.maxConnectionsPerProxy": "34",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("googleworkspace_chrome_policy.test", "policies.*", map[string]string{
+						"schema_name":                           "chrome.users.RestrictSigninToPattern",
+						"schema_values.restrictSigninToPattern": encode(".*@example.com"),
+					}),
 				),
 			},
+			{
+				// rearranging the policies must not produce a diff
+				Config:   testAccResourceChromePolicy_multiple(ouName, 34, ".*@example.com"),
+				PlanOnly: true,
+			},
 			{
 				Config: testAccResourceChromePolicy_multipleDifferent(ouName, true, ".*@example.com"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("googleworkspace_chrome_policy.test", "policies.#", "2"),
-					resource.TestCheckResourceAttr("googleworkspace_chrome_policy.test", "policies.0.schema_name", "chrome.users.OnlineRevocationChecks"),
-					resource.TestCheckResourceAttr("googleworkspace_chrome_policy.test", "policies.0.schema_values.enableOnlineRevocationChecks", "true"),
-					resource.TestCheckResourceAttr("googleworkspace_chrome_policy.test", "policies.1.schema_name", "chrome.users.RestrictSigninToPattern"),
-					resource.TestCheckResourceAttr("googleworkspace_chrome_policy.test", "policies.1.schema_values.restrictSigninToPattern", encode(".*@example.com")),
+					resource.TestCheckTypeSetElemNestedAttrs("googleworkspace_chrome_policy.test", "policies.*", map[string]string{
+						"schema_name": "chrome.users.OnlineRevocationChecks",
+						"schema_values.enableOnlineRevocationChecks": "true",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("googleworkspace_chrome_policy.test", "policies.*", map[string]string{
+						"schema_name":                           "chrome.users.RestrictSigninToPattern",
+						"schema_values.restrictSigninToPattern": encode(".*@example.com"),
+					}),
 					testCheck,
 				),
 			},
@@ -206,6 +542,31 @@ resource "googleworkspace_chrome_policy" "test" {
 `, ouName, pattern, conns)
 }
 
+func testAccResourceChromePolicy_nativeValues(ouName string, conns int, pattern string) string {
+	return fmt.Sprintf(`
+resource "googleworkspace_org_unit" "test" {
+  name = "%s"
+  parent_org_unit_path = "/"
+}
+
+resource "googleworkspace_chrome_policy" "test" {
+  org_unit_id = googleworkspace_org_unit.test.id
+  policies {
+    schema_name = "chrome.users.RestrictSigninToPattern"
+    schema_values = {
+      restrictSigninToPattern = "%s"
+    }
+  }
+  policies {
+    schema_name = "chrome.users.MaxConnectionsPerProxy"
+    schema_values = {
+      maxConnectionsPerProxy = %d
+    }
+  }
+}
+`, ouName, pattern, conns)
+}
+
 func testAccResourceChromePolicy_multipleRearranged(ouName string, conns int, pattern string) string {
 	return fmt.Sprintf(`
 resource "googleworkspace_org_unit" "test" {
@@ -275,6 +636,36 @@ resource "googleworkspace_chrome_policy" "test" {
 `, ouName, conns)
 }
 
+func testAccResourceChromePolicy_keepOnDestroy(ouName string, conns int) string {
+	return fmt.Sprintf(`
+resource "googleworkspace_org_unit" "test" {
+  name = "%s"
+  parent_org_unit_path = "/"
+}
+
+resource "googleworkspace_chrome_policy" "test" {
+  org_unit_id = googleworkspace_org_unit.test.id
+  policies {
+    schema_name = "chrome.users.MaxConnectionsPerProxy"
+    schema_values = {
+      maxConnectionsPerProxy = jsonencode(%d)
+    }
+  }
+
+  inherit_on_destroy = false
+}
+`, ouName, conns)
+}
+
+func testAccResourceChromePolicy_orgUnitOnly(ouName string) string {
+	return fmt.Sprintf(`
+resource "googleworkspace_org_unit" "test" {
+  name = "%s"
+  parent_org_unit_path = "/"
+}
+`, ouName)
+}
+
 func testAccResourceChromePolicy_typeMessage(ouName string) string {
 	return fmt.Sprintf(`
 resource "googleworkspace_org_unit" "test" {
@@ -293,3 +684,22 @@ resource "googleworkspace_chrome_policy" "test" {
 }
 `, ouName)
 }
+
+func testAccResourceChromePolicy_typeMessageInvalid(ouName string) string {
+	return fmt.Sprintf(`
+resource "googleworkspace_org_unit" "test" {
+  name = "%s"
+  parent_org_unit_path = "/"
+}
+
+resource "googleworkspace_chrome_policy" "test" {
+  org_unit_id = googleworkspace_org_unit.test.id
+  policies {
+    schema_name = "chrome.users.ManagedBookmarksSetting"
+    schema_values = {
+		managedBookmarks = "{\"toplevelName\":5}"
+    }
+  }
+}
+`, ouName)
+}
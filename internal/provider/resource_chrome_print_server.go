@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+func resourceChromePrintServer() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Chrome Print Server resource manages the print servers that ChromeOS devices discover " +
+			"printers from, and the org unit they're made available to. Chrome Print Server requires the " +
+			"`https://www.googleapis.com/auth/admin.chrome.printers` client scope, which isn't one of the " +
+			"provider's default scopes and needs to be added to `oauth_scopes`.",
+
+		CreateContext: resourceChromePrintServerCreate,
+		ReadContext:   resourceChromePrintServerRead,
+		UpdateContext: resourceChromePrintServerUpdate,
+		DeleteContext: resourceChromePrintServerDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Description: "The name of the print server, as displayed to users.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"uri": {
+				Description: "The URI of the print server, e.g. `ipp://print.example.com:631`.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithScheme([]string{
+					"ipp", "ipps", "http", "https",
+				})),
+			},
+			"description": {
+				Description: "The description of the print server.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"org_unit_id": {
+				Description: "The ID of the org unit the print server is made available to, including its " +
+					"sub-org units. The print server is made available to the root org unit if unset.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: diffSuppressOrgUnitId,
+			},
+			"print_server_id": {
+				Description: "The unique ID of the print server.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"create_time": {
+				Description: "The time the print server was created, in RFC 3339 format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			// Adding a computed id simply to override the `optional` id that gets added in the SDK
+			// that will then display improperly in the docs
+			"id": {
+				Description: "The resource name of the print server, in the format " +
+					"`customers/{customer}/chrome/printServers/{print_server_id}`.",
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceChromePrintServerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	printServersService, diags := GetChromePrintServersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	customersService, diags := GetCustomersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	// print servers are named after the customer's ID, not the `my_customer` alias
+	customer, err := customersService.Get(client.Customer).Fields("id").Do()
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	displayName := d.Get("display_name").(string)
+	log.Printf("[DEBUG] Creating Chrome Print Server %q", displayName)
+
+	printServer, err := printServersService.Create(fmt.Sprintf("customers/%s", customer.Id), &directory.PrintServer{
+		DisplayName: displayName,
+		Uri:         d.Get("uri").(string),
+		Description: d.Get("description").(string),
+		OrgUnitId:   strings.TrimPrefix(d.Get("org_unit_id").(string), "id:"),
+	}).Do()
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	d.SetId(printServer.Name)
+
+	log.Printf("[DEBUG] Finished creating Chrome Print Server %q: %#v", d.Id(), displayName)
+
+	return resourceChromePrintServerRead(ctx, d, meta)
+}
+
+func resourceChromePrintServerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	printServersService, diags := GetChromePrintServersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Getting Chrome Print Server %q", d.Id())
+
+	printServer, err := printServersService.Get(d.Id()).Do()
+	if err != nil {
+		return handleReadNotFoundError(err, d, d.Id())
+	}
+
+	d.Set("display_name", printServer.DisplayName)
+	d.Set("uri", printServer.Uri)
+	d.Set("description", printServer.Description)
+
+	// keep the org unit ID as configured, with or without the `id:` prefix
+	if strings.TrimPrefix(d.Get("org_unit_id").(string), "id:") != strings.TrimPrefix(printServer.OrgUnitId, "id:") {
+		d.Set("org_unit_id", printServer.OrgUnitId)
+	}
+
+	d.Set("print_server_id", printServer.Id)
+	d.Set("create_time", printServer.CreateTime)
+	d.SetId(printServer.Name)
+
+	log.Printf("[DEBUG] Finished getting Chrome Print Server %q", d.Id())
+
+	return diags
+}
+
+func resourceChromePrintServerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	printServersService, diags := GetChromePrintServersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Updating Chrome Print Server %q", d.Id())
+
+	printServerObj := &directory.PrintServer{}
+	var updateMask []string
+
+	if d.HasChange("display_name") {
+		printServerObj.DisplayName = d.Get("display_name").(string)
+		updateMask = append(updateMask, "displayName")
+	}
+
+	if d.HasChange("uri") {
+		printServerObj.Uri = d.Get("uri").(string)
+		updateMask = append(updateMask, "uri")
+	}
+
+	if d.HasChange("description") {
+		printServerObj.Description = d.Get("description").(string)
+		// send the description even if it's empty, so it is cleared
+		printServerObj.ForceSendFields = append(printServerObj.ForceSendFields, "Description")
+		updateMask = append(updateMask, "description")
+	}
+
+	if len(updateMask) > 0 {
+		_, err := printServersService.Patch(d.Id(), printServerObj).UpdateMask(strings.Join(updateMask, ",")).Do()
+		if err != nil {
+			return apiErrorDiagnostics(err)
+		}
+	}
+
+	log.Printf("[DEBUG] Finished updating Chrome Print Server %q", d.Id())
+
+	return resourceChromePrintServerRead(ctx, d, meta)
+}
+
+func resourceChromePrintServerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	printServersService, diags := GetChromePrintServersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Deleting Chrome Print Server %q", d.Id())
+
+	_, err := printServersService.Delete(d.Id()).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, d.Id())
+	}
+
+	log.Printf("[DEBUG] Finished deleting Chrome Print Server %q", d.Id())
+
+	return diags
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceChromePrintServer_basic(t *testing.T) {
+	t.Parallel()
+
+	testPrintServerVals := map[string]interface{}{
+		"ouName":      fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"displayName": "Office Print Server",
+		"description": "Print server of the office",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceChromePrintServer(testPrintServerVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_chrome_print_server.test", "display_name", "Office Print Server"),
+					resource.TestCheckResourceAttr("googleworkspace_chrome_print_server.test", "description", "Print server of the office"),
+					resource.TestCheckResourceAttrSet("googleworkspace_chrome_print_server.test", "print_server_id"),
+					resource.TestCheckResourceAttrSet("googleworkspace_chrome_print_server.test", "create_time"),
+				),
+			},
+			{
+				ResourceName:      "googleworkspace_chrome_print_server.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the org unit ID is imported without the `id:` prefix
+				ImportStateVerifyIgnore: []string{"org_unit_id"},
+			},
+			{
+				Config: testAccResourceChromePrintServer(map[string]interface{}{
+					"ouName":      testPrintServerVals["ouName"],
+					"displayName": "Updated Print Server",
+					"description": "",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_chrome_print_server.test", "display_name", "Updated Print Server"),
+					resource.TestCheckResourceAttr("googleworkspace_chrome_print_server.test", "description", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceChromePrintServer(testPrintServerVals map[string]interface{}) string {
+	return Nprintf(`
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/admin.directory.customer",
+    "https://www.googleapis.com/auth/admin.directory.orgunit",
+    "https://www.googleapis.com/auth/admin.chrome.printers",
+  ]
+}
+
+resource "googleworkspace_org_unit" "test" {
+  name                 = "%{ouName}"
+  parent_org_unit_path = "/"
+}
+
+resource "googleworkspace_chrome_print_server" "test" {
+  display_name = "%{displayName}"
+  description  = "%{description}"
+  uri          = "ipps://print.example.com:631"
+  org_unit_id  = googleworkspace_org_unit.test.id
+}
+`, testPrintServerVals)
+}
@@ -0,0 +1,296 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	datatransfer "google.golang.org/api/admin/datatransfer/v1"
+)
+
+const (
+	dataTransferStatusCompleted = "completed"
+	dataTransferStatusFailed    = "failed"
+)
+
+func resourceDataTransfer() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Data Transfer resource transfers the data of a user's applications, e.g. their Drive files " +
+			"and Calendar events, to another user, e.g. before the user is deleted when offboarding them. The data " +
+			"is transferred when the resource is created, and a transfer can't be undone, so destroying the resource " +
+			"only removes it from state. Data Transfer requires the `https://www.googleapis.com/auth/admin.datatransfer` " +
+			"client scope, which isn't one of the provider's default scopes and needs to be added to `oauth_scopes`.",
+
+		CreateContext: resourceDataTransferCreate,
+		ReadContext:   resourceDataTransferRead,
+		UpdateContext: resourceDataTransferUpdate,
+		DeleteContext: resourceDataTransferDelete,
+
+		// transfers of large Drives may take hours
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"old_owner_user_id": {
+				Description: "The unique ID of the user whose data is transferred.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"new_owner_user_id": {
+				Description: "The unique ID of the user the data is transferred to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"application_data_transfers": {
+				Description: "The applications whose data is transferred.",
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"application_id": {
+							Description: "The ID of the application, see the " +
+								"`googleworkspace_data_transfer_applications` data source.",
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"application_transfer_params": {
+							Description: "The parameters of the application's transfer, e.g. the `PRIVACY_LEVEL` of " +
+								"the Drive files that are transferred.",
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Description: "The key of the parameter.",
+										Type:        schema.TypeString,
+										Required:    true,
+										ForceNew:    true,
+									},
+									"values": {
+										Description: "The values of the parameter.",
+										Type:        schema.TypeList,
+										Required:    true,
+										ForceNew:    true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+						"application_transfer_status": {
+							Description: "The status of the application's transfer, `pending`, `inProgress`, " +
+								"`completed` or `failed`.",
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"wait_for_completion": {
+				Description: "If true, creating the resource waits for the transfer of all applications to " +
+					"complete, within the create timeout, so that e.g. the old owner is only deleted afterwards. " +
+					"A failed transfer fails the apply.",
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"overall_transfer_status_code": {
+				Description: "The overall status of the transfer, `pending`, `inProgress`, `completed` or `failed`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"request_time": {
+				Description: "The time the transfer was requested, in RFC 3339 format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"etag": {
+				Description: "ETag of the resource.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			// Adding a computed id simply to override the `optional` id that gets added in the SDK
+			// that will then display improperly in the docs
+			"id": {
+				Description: "The ID of the transfer.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceDataTransferCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	dataTransferService, diags := client.NewDataTransferService()
+	if diags.HasError() {
+		return diags
+	}
+
+	transfersService, diags := GetDataTransferTransfersService(dataTransferService)
+	if diags.HasError() {
+		return diags
+	}
+
+	oldOwner := d.Get("old_owner_user_id").(string)
+	newOwner := d.Get("new_owner_user_id").(string)
+	log.Printf("[DEBUG] Creating Data Transfer from %q to %q", oldOwner, newOwner)
+
+	transferObj := &datatransfer.DataTransfer{
+		OldOwnerUserId: oldOwner,
+		NewOwnerUserId: newOwner,
+	}
+
+	for _, a := range d.Get("application_data_transfers").([]interface{}) {
+		adt := a.(map[string]interface{})
+
+		applicationId, err := strconv.ParseInt(adt["application_id"].(string), 10, 64)
+		if err != nil {
+			return diag.Errorf("application_id %q isn't a valid application ID: %s", adt["application_id"].(string), err)
+		}
+
+		var params []*datatransfer.ApplicationTransferParam
+		for _, p := range adt["application_transfer_params"].([]interface{}) {
+			param := p.(map[string]interface{})
+			params = append(params, &datatransfer.ApplicationTransferParam{
+				Key:   param["key"].(string),
+				Value: listOfInterfacestoStrings(param["values"]),
+			})
+		}
+
+		transferObj.ApplicationDataTransfers = append(transferObj.ApplicationDataTransfers, &datatransfer.ApplicationDataTransfer{
+			ApplicationId:             applicationId,
+			ApplicationTransferParams: params,
+		})
+	}
+
+	transfer, err := transfersService.Insert(transferObj).Do()
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	d.SetId(transfer.Id)
+
+	if d.Get("wait_for_completion").(bool) {
+		log.Printf("[DEBUG] Waiting for Data Transfer %q to complete", d.Id())
+
+		err = retryTimeDuration(ctx, d.Timeout(schema.TimeoutCreate), func() error {
+			newTransfer, retryErr := transfersService.Get(d.Id()).Do()
+			if retryErr != nil {
+				return retryErr
+			}
+
+			switch newTransfer.OverallTransferStatusCode {
+			case dataTransferStatusCompleted:
+				return nil
+			case dataTransferStatusFailed:
+				return fmt.Errorf("data transfer %s failed, the status of the transfer of each application is "+
+					"reported by the googleworkspace_data_transfer data source", d.Id())
+			}
+
+			return fmt.Errorf("timed out while waiting for data transfer %s to complete, its status is %s",
+				d.Id(), newTransfer.OverallTransferStatusCode)
+		})
+		if err != nil {
+			return apiErrorDiagnostics(err)
+		}
+	}
+
+	log.Printf("[DEBUG] Finished creating Data Transfer %q", d.Id())
+
+	return resourceDataTransferRead(ctx, d, meta)
+}
+
+func resourceDataTransferRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	dataTransferService, diags := client.NewDataTransferService()
+	if diags.HasError() {
+		return diags
+	}
+
+	transfersService, diags := GetDataTransferTransfersService(dataTransferService)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Getting Data Transfer %q", d.Id())
+
+	transfer, err := transfersService.Get(d.Id()).Do()
+	if err != nil {
+		return handleReadNotFoundError(err, d, d.Id())
+	}
+
+	setDataTransfer(d, transfer)
+
+	// wait_for_completion is not returned by the API, default it for imported transfers
+	if _, ok := d.GetOkExists("wait_for_completion"); !ok {
+		d.Set("wait_for_completion", true)
+	}
+
+	log.Printf("[DEBUG] Finished getting Data Transfer %q", d.Id())
+
+	return diags
+}
+
+// resourceDataTransferUpdate only updates wait_for_completion, all other attributes force a new transfer
+func resourceDataTransferUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceDataTransferRead(ctx, d, meta)
+}
+
+func resourceDataTransferDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// a transfer can't be undone, so the resource is only removed from state
+	log.Printf("[DEBUG] Removing Data Transfer %q from state", d.Id())
+
+	return diags
+}
+
+func setDataTransfer(d *schema.ResourceData, transfer *datatransfer.DataTransfer) {
+	var applicationDataTransfers []interface{}
+	for _, adt := range transfer.ApplicationDataTransfers {
+		var params []interface{}
+		for _, param := range adt.ApplicationTransferParams {
+			params = append(params, map[string]interface{}{
+				"key":    param.Key,
+				"values": param.Value,
+			})
+		}
+
+		applicationDataTransfers = append(applicationDataTransfers, map[string]interface{}{
+			"application_id":              strconv.FormatInt(adt.ApplicationId, 10),
+			"application_transfer_params": params,
+			"application_transfer_status": adt.ApplicationTransferStatus,
+		})
+	}
+
+	d.SetId(transfer.Id)
+	d.Set("old_owner_user_id", transfer.OldOwnerUserId)
+	d.Set("new_owner_user_id", transfer.NewOwnerUserId)
+	d.Set("application_data_transfers", applicationDataTransfers)
+	d.Set("overall_transfer_status_code", transfer.OverallTransferStatusCode)
+	d.Set("request_time", transfer.RequestTime)
+	d.Set("etag", transfer.Etag)
+}
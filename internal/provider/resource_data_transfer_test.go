@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceDataTransfer_basic(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testUserVals := map[string]interface{}{
+		"domainName": domainName,
+		"oldOwner":   fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"newOwner":   fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":   acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDataTransfer_basic(testUserVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_data_transfer.test", "overall_transfer_status_code", "completed"),
+					resource.TestCheckResourceAttr("googleworkspace_data_transfer.test", "application_data_transfers.0.application_transfer_status", "completed"),
+					resource.TestCheckResourceAttr("googleworkspace_data_transfer.test", "application_data_transfers.0.application_transfer_params.0.key", "PRIVACY_LEVEL"),
+					resource.TestCheckResourceAttr("googleworkspace_data_transfer.test", "application_data_transfers.0.application_transfer_params.0.values.#", "2"),
+					resource.TestCheckResourceAttrSet("googleworkspace_data_transfer.test", "request_time"),
+				),
+			},
+			{
+				ResourceName:            "googleworkspace_data_transfer.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"etag"},
+			},
+		},
+	})
+}
+
+func testAccResourceDataTransfer_basic(testUserVals map[string]interface{}) string {
+	return Nprintf(`
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/admin.directory.customer",
+    "https://www.googleapis.com/auth/admin.directory.user",
+    "https://www.googleapis.com/auth/admin.datatransfer",
+  ]
+}
+
+resource "googleworkspace_user" "old_owner" {
+  primary_email = "%{oldOwner}@%{domainName}"
+  password      = "%{password}"
+
+  name {
+    family_name = "Scott"
+    given_name  = "Michael"
+  }
+}
+
+resource "googleworkspace_user" "new_owner" {
+  primary_email = "%{newOwner}@%{domainName}"
+  password      = "%{password}"
+
+  name {
+    family_name = "Halpert"
+    given_name  = "Jim"
+  }
+}
+
+data "googleworkspace_data_transfer_applications" "test" {}
+
+resource "googleworkspace_data_transfer" "test" {
+  old_owner_user_id = googleworkspace_user.old_owner.id
+  new_owner_user_id = googleworkspace_user.new_owner.id
+
+  application_data_transfers {
+    application_id = data.googleworkspace_data_transfer_applications.test.ids_by_name["Drive and Docs"]
+
+    application_transfer_params {
+      key    = "PRIVACY_LEVEL"
+      values = ["SHARED", "PRIVATE"]
+    }
+  }
+}
+`, testUserVals)
+}
@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"google.golang.org/api/cloudidentity/v1"
+)
+
+func resourceDeviceUserAction() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Device User Action resource performs an action on a user's account on a device managed by " +
+			"Cloud Identity, e.g. blocking or wiping the account on a compromised device as part of a conditional " +
+			"access remediation. The action is performed when the resource is created, and again whenever `action` " +
+			"or `triggers` change. Destroying the resource doesn't undo the action. Device User Action requires the " +
+			"`https://www.googleapis.com/auth/cloud-identity.devices` client scope, which isn't one of the provider's " +
+			"default scopes and needs to be added to `oauth_scopes`.",
+
+		CreateContext: resourceDeviceUserActionCreate,
+		ReadContext:   resourceDeviceUserActionRead,
+		DeleteContext: resourceDeviceUserActionDelete,
+
+		Schema: map[string]*schema.Schema{
+			"device_user_name": {
+				Description: "The resource name of the device user, in the format " +
+					"`devices/{device}/deviceUsers/{device_user}`.",
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(
+					regexp.MustCompile(`^devices/[^/]+/deviceUsers/[^/]+$`), "must be in the format devices/{device}/deviceUsers/{device_user}")),
+			},
+			"action": {
+				Description: "The action performed on the device user. Valid values are:" +
+					"\n\t- `APPROVE`: approves the device user's access to the customer's data" +
+					"\n\t- `BLOCK`: blocks the device user's access to the customer's data" +
+					"\n\t- `WIPE`: removes the user's account and the customer's data from the device",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"APPROVE", "BLOCK", "WIPE"}, false)),
+			},
+			"triggers": {
+				Description: "Arbitrary map of values that, when changed, performs the action again.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"user_email": {
+				Description: "The email address of the device user.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"management_state": {
+				Description: "The management state of the device user, e.g. `APPROVED`, `BLOCKED` or `WIPED`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"performed_at": {
+				Description: "The time the action was performed, in RFC 3339 format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			// Adding a computed id simply to override the `optional` id that gets added in the SDK
+			// that will then display improperly in the docs
+			"id": {
+				Description: "The resource name of the device user.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceDeviceUserActionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	cloudIdentityService, diags := client.NewCloudIdentityService()
+	if diags.HasError() {
+		return diags
+	}
+
+	devicesService, diags := GetCloudIdentityDevicesService(cloudIdentityService)
+	if diags.HasError() {
+		return diags
+	}
+
+	deviceUsersService, diags := GetCloudIdentityDeviceUsersService(devicesService)
+	if diags.HasError() {
+		return diags
+	}
+
+	name := d.Get("device_user_name").(string)
+	action := d.Get("action").(string)
+	customer := fmt.Sprintf("customers/%s", client.Customer)
+	log.Printf("[DEBUG] Performing %s on Device User %q", action, name)
+
+	var op *cloudidentity.Operation
+	var err error
+	switch action {
+	case "APPROVE":
+		op, err = deviceUsersService.Approve(name, &cloudidentity.GoogleAppsCloudidentityDevicesV1ApproveDeviceUserRequest{
+			Customer: customer,
+		}).Context(ctx).Do()
+	case "BLOCK":
+		op, err = deviceUsersService.Block(name, &cloudidentity.GoogleAppsCloudidentityDevicesV1BlockDeviceUserRequest{
+			Customer: customer,
+		}).Context(ctx).Do()
+	case "WIPE":
+		op, err = deviceUsersService.Wipe(name, &cloudidentity.GoogleAppsCloudidentityDevicesV1WipeDeviceUserRequest{
+			Customer: customer,
+		}).Context(ctx).Do()
+	}
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if op != nil && op.Done && op.Error != nil {
+		return diag.Errorf("%s of device user %s failed: %s", action, name, op.Error.Message)
+	}
+
+	d.SetId(name)
+	d.Set("performed_at", time.Now().UTC().Format(time.RFC3339))
+
+	log.Printf("[DEBUG] Finished performing %s on Device User %q", action, name)
+
+	return resourceDeviceUserActionRead(ctx, d, meta)
+}
+
+func resourceDeviceUserActionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	cloudIdentityService, diags := client.NewCloudIdentityService()
+	if diags.HasError() {
+		return diags
+	}
+
+	devicesService, diags := GetCloudIdentityDevicesService(cloudIdentityService)
+	if diags.HasError() {
+		return diags
+	}
+
+	deviceUsersService, diags := GetCloudIdentityDeviceUsersService(devicesService)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Getting Device User %q of Device User Action", d.Id())
+
+	deviceUser, err := deviceUsersService.Get(d.Id()).Customer(fmt.Sprintf("customers/%s", client.Customer)).Do()
+	if err != nil {
+		return handleReadNotFoundError(err, d, d.Id())
+	}
+
+	d.Set("user_email", deviceUser.UserEmail)
+	d.Set("management_state", deviceUser.ManagementState)
+
+	log.Printf("[DEBUG] Finished getting Device User %q of Device User Action", d.Id())
+
+	return diags
+}
+
+func resourceDeviceUserActionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// the action isn't undone, e.g. a blocked device user stays blocked until it is approved again
+	log.Printf("[DEBUG] Removing Device User Action %q from state", d.Id())
+
+	return diags
+}
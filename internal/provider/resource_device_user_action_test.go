@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceDeviceUserAction_approve(t *testing.T) {
+	t.Parallel()
+
+	deviceUserName := os.Getenv("GOOGLEWORKSPACE_DEVICE_USER_NAME")
+
+	if deviceUserName == "" {
+		t.Skip("GOOGLEWORKSPACE_DEVICE_USER_NAME needs to be set to run this test")
+	}
+
+	testDeviceUserVals := map[string]interface{}{
+		"deviceUserName": deviceUserName,
+		"action":         "APPROVE",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDeviceUserAction(testDeviceUserVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_device_user_action.remediation", "id", deviceUserName),
+					resource.TestCheckResourceAttr("googleworkspace_device_user_action.remediation", "management_state", "APPROVED"),
+					resource.TestCheckResourceAttrSet("googleworkspace_device_user_action.remediation", "user_email"),
+					resource.TestCheckResourceAttrSet("googleworkspace_device_user_action.remediation", "performed_at"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceDeviceUserAction_invalidName(t *testing.T) {
+	t.Parallel()
+
+	testDeviceUserVals := map[string]interface{}{
+		"deviceUserName": "devices/abc123",
+		"action":         "BLOCK",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceDeviceUserAction(testDeviceUserVals),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("must be in the format devices/{device}/deviceUsers/{device_user}"),
+			},
+		},
+	})
+}
+
+func testAccResourceDeviceUserAction(testDeviceUserVals map[string]interface{}) string {
+	return Nprintf(`
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/cloud-identity.devices",
+  ]
+}
+
+resource "googleworkspace_device_user_action" "remediation" {
+  device_user_name = "%{deviceUserName}"
+  action           = "%{action}"
+}
+`, testDeviceUserVals)
+}
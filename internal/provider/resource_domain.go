@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -18,12 +19,21 @@ func resourceDomain() *schema.Resource {
 	return &schema.Resource{
 		// This description is used by the documentation generator and the language server.
 		Description: "Domain resource manages Google Workspace Domains. Domain resides under the " +
-			"`https://www.googleapis.com/auth/admin.directory.domain` client scope.",
+			"`https://www.googleapis.com/auth/admin.directory.domain` client scope, making a domain the primary " +
+			"domain also requires the `https://www.googleapis.com/auth/admin.directory.customer` client scope.",
 
 		CreateContext: resourceDomainCreate,
 		ReadContext:   resourceDomainRead,
+		UpdateContext: resourceDomainUpdate,
 		DeleteContext: resourceDomainDelete,
 
+		CustomizeDiff: resourceDomainCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -57,6 +67,15 @@ func resourceDomain() *schema.Resource {
 				Type:        schema.TypeBool,
 				Computed:    true,
 			},
+			"make_primary": {
+				Description: "If true, the domain is made the customer's primary domain, and made " +
+					"primary again if another domain is made primary outside of Terraform. The domain must be verified " +
+					"first, so it can't be made primary when it's created. Setting this back to `false` doesn't change " +
+					"the primary domain, as only another domain can be made primary. The primary domain can't be deleted.",
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"domain_name": {
 				Description: "The domain name of the customer.",
 				Type:        schema.TypeString,
@@ -74,6 +93,20 @@ func resourceDomain() *schema.Resource {
 	}
 }
 
+func resourceDomainCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("make_primary") || !diff.Get("make_primary").(bool) {
+		return nil
+	}
+
+	// verified is only known once the domain exists, and only verified domains can be made primary
+	if diff.Id() == "" || !diff.Get("verified").(bool) {
+		return fmt.Errorf("domain %s must be verified before it can be made the primary domain, set make_primary "+
+			"once the domain is verified", diff.Get("domain_name").(string))
+	}
+
+	return nil
+}
+
 func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -97,9 +130,9 @@ func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		DomainName: d.Get("domain_name").(string),
 	}
 
-	domain, err := domainsService.Insert(client.Customer, &domainObj).Do()
+	domain, err := domainsService.Insert(client.Customer, &domainObj).Context(ctx).Do()
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	// Use the domain name as the ID, as it should be unique
@@ -110,6 +143,64 @@ func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta inte
 	return resourceDomainRead(ctx, d, meta)
 }
 
+func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := meta.(*apiClient)
+
+	domainName := d.Get("domain_name").(string)
+	log.Printf("[DEBUG] Updating Domain %q: %#v", d.Id(), domainName)
+
+	if d.HasChange("make_primary") && d.Get("make_primary").(bool) {
+		directoryService, diags := client.NewDirectoryService()
+		if diags.HasError() {
+			return diags
+		}
+
+		customersService, diags := GetCustomersService(directoryService)
+		if diags.HasError() {
+			return diags
+		}
+
+		domainsService, diags := GetDomainsService(directoryService)
+		if diags.HasError() {
+			return diags
+		}
+
+		log.Printf("[DEBUG] Making Domain %q the primary domain", d.Id())
+
+		_, err := customersService.Patch(client.Customer, &directory.Customer{
+			CustomerDomain: domainName,
+		}).Do()
+		if err != nil {
+			return apiErrorDiagnostics(err)
+		}
+
+		// the primary domain changes asynchronously, users and groups may still be renamed to it afterwards
+		err = retryTimeDuration(ctx, d.Timeout(schema.TimeoutUpdate), func() error {
+			domain, retryErr := domainsService.Get(client.Customer, d.Id()).Fields("isPrimary").Do()
+			if retryErr != nil {
+				return retryErr
+			}
+
+			if !domain.IsPrimary {
+				return fmt.Errorf("timed out while waiting for domain %s to become the primary domain", domainName)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return apiErrorDiagnostics(err)
+		}
+	}
+
+	log.Printf("[DEBUG] Finished updating Domain %q: %#v", d.Id(), domainName)
+
+	diags = append(diags, resourceDomainRead(ctx, d, meta)...)
+
+	return diags
+}
+
 func resourceDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -130,7 +221,7 @@ func resourceDomainRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 	domain, err := domainsService.Get(client.Customer, d.Id()).Do()
 	if err != nil {
-		return handleNotFoundError(err, d, d.Id())
+		return handleReadNotFoundError(err, d, d.Id())
 	}
 
 	if domain == nil {
@@ -150,6 +241,13 @@ func resourceDomainRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set("creation_time", domain.CreationTime)
 	d.Set("is_primary", domain.IsPrimary)
 	d.Set("domain_name", domain.DomainName)
+
+	// make_primary can't be read, it's unset when another domain was made primary so that this
+	// domain is made primary again
+	if _, ok := d.GetOk("make_primary"); !ok || !domain.IsPrimary {
+		d.Set("make_primary", false)
+	}
+
 	d.SetId(domain.DomainName)
 	log.Printf("[DEBUG] Finished getting Domain %q: %#v", d.Id(), domain.DomainName)
 
@@ -175,6 +273,11 @@ func resourceDomainDelete(ctx context.Context, d *schema.ResourceData, meta inte
 		return diags
 	}
 
+	if d.Get("is_primary").(bool) {
+		return diag.Errorf("domain %s is the primary domain and can't be deleted, make another domain the "+
+			"primary domain first", domainName)
+	}
+
 	err := domainsService.Delete(client.Customer, domainName).Do()
 	if err != nil {
 		return handleNotFoundError(err, d, domainName)
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -24,16 +25,23 @@ func resourceDomainAlias() *schema.Resource {
 		ReadContext:   resourceDomainAliasRead,
 		DeleteContext: resourceDomainAliasDelete,
 
+		CustomizeDiff: resourceDomainAliasCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceDomainAliasImport,
 		},
 
 		Schema: map[string]*schema.Schema{
 			"parent_domain_name": {
-				Description: "The parent domain name that the domain alias is associated with. This can either be a primary or secondary domain name within a customer.",
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
+				Description: "The parent domain name that the domain alias is associated with. This can either be a primary " +
+					"or secondary domain name within a customer, and must be verified. Defaults to the primary domain.",
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.EqualFold(old, new)
+				},
 			},
 			"verified": {
 				Description: "Indicates the verification state of a domain alias.",
@@ -93,7 +101,7 @@ func resourceDomainAliasCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	domainAlias, err := domainAliasesService.Insert(client.Customer, &domainAliasObj).Do()
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	// Use the domainAlias name as the ID, as it should be unique
@@ -124,7 +132,7 @@ func resourceDomainAliasRead(ctx context.Context, d *schema.ResourceData, meta i
 
 	domainAlias, err := domainAliasesService.Get(client.Customer, d.Id()).Do()
 	if err != nil {
-		return handleNotFoundError(err, d, d.Id())
+		return handleReadNotFoundError(err, d, d.Id())
 	}
 
 	if domainAlias == nil {
@@ -147,6 +155,51 @@ func resourceDomainAliasRead(ctx context.Context, d *schema.ResourceData, meta i
 	return diags
 }
 
+// resourceDomainAliasCustomizeDiff checks the parent domain is a verified domain of the customer, as the API
+// only rejects other parents once the alias is created
+func resourceDomainAliasCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	parentDomainName := diff.Get("parent_domain_name").(string)
+	if parentDomainName == "" || !diff.NewValueKnown("parent_domain_name") || (diff.Id() != "" && !diff.HasChange("parent_domain_name")) {
+		return nil
+	}
+
+	client, ok := meta.(*apiClient)
+	if !ok || client == nil {
+		return nil
+	}
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	domainsService, diags := GetDomainsService(directoryService)
+	if diags.HasError() {
+		return fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	domain, err := domainsService.Get(client.Customer, parentDomainName).Fields("domainName", "verified").Do()
+	if isNotFound(err) {
+		return fmt.Errorf("parent_domain_name %q is not a domain of the customer", parentDomainName)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !domain.Verified {
+		return fmt.Errorf("parent_domain_name %q is not verified", parentDomainName)
+	}
+
+	return nil
+}
+
+// resourceDomainAliasImport accepts the domain alias name, in any case
+func resourceDomainAliasImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.SetId(strings.ToLower(d.Id()))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceDomainAliasDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -6,6 +6,8 @@ package googleworkspace
 import (
 	"fmt"
 	"os"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -35,6 +37,39 @@ func TestAccResourceDomainAlias(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"etag"},
 			},
+			{
+				// the alias name is case insensitive
+				ResourceName:            "googleworkspace_domain_alias.my-domain-alias",
+				ImportState:             true,
+				ImportStateId:           strings.ToUpper(domainAlias),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"etag"},
+			},
+		},
+	})
+}
+
+func TestAccResourceDomainAlias_unknownParent(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	domainAlias := fmt.Sprintf("tf-test-%s.com", acctest.RandString(10))
+	parentDomain := fmt.Sprintf("tf-test-%s.com", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceDomainAlias(parentDomain, domainAlias),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("is not a domain of the customer"),
+			},
 		},
 	})
 }
@@ -0,0 +1,411 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDomainSharedContact() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Domain Shared Contact resource manages external contacts shared with all users of a domain, which " +
+			"are listed in the directory and global address list. Domain Shared Contact requires the " +
+			"`https://www.google.com/m8/feeds/contacts/` client scope, which isn't one of the provider's default scopes " +
+			"and needs to be added to `oauth_scopes`. Contacts can take up to 24 hours to appear in the global address list.",
+
+		CreateContext: resourceDomainSharedContactCreate,
+		ReadContext:   resourceDomainSharedContactRead,
+		UpdateContext: resourceDomainSharedContactUpdate,
+		DeleteContext: resourceDomainSharedContactDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDomainSharedContactImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Description: "The domain the contact is shared with.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"given_name": {
+				Description: "The contact's first name.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"family_name": {
+				Description: "The contact's last name.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"full_name": {
+				Description: "The contact's full name, defaults to the given name followed by the family name.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"notes": {
+				Description: "Notes about the contact.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"emails": {
+				Description: "The contact's email addresses.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Description: "The email address.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"type": {
+							Description: "The type of the email address. Acceptable values are `work`, `home` and `other`.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "work",
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"work", "home", "other"},
+								false)),
+						},
+						"display_name": {
+							Description: "The name displayed with the email address.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"primary": {
+							Description: "Whether this is the contact's primary email address.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+					},
+				},
+			},
+			"phone_numbers": {
+				Description: "The contact's phone numbers.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"number": {
+							Description: "The phone number.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"type": {
+							Description: "The type of the phone number. Acceptable values are `work`, `home`, `mobile`, " +
+								"`main`, `work_fax`, `home_fax`, `pager` and `other`.",
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "work",
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"work", "home", "mobile",
+								"main", "work_fax", "home_fax", "pager", "other"}, false)),
+						},
+						"primary": {
+							Description: "Whether this is the contact's primary phone number.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+					},
+				},
+			},
+			"organizations": {
+				Description: "The organizations the contact belongs to.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "The name of the organization.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"title": {
+							Description: "The contact's title in the organization.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"type": {
+							Description: "The type of the organization. Acceptable values are `work` and `other`.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "work",
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"work", "other"},
+								false)),
+						},
+						"primary": {
+							Description: "Whether this is the contact's primary organization.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+					},
+				},
+			},
+			"addresses": {
+				Description: "The contact's postal addresses.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"formatted": {
+							Description: "The full, unstructured postal address.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"type": {
+							Description: "The type of the address. Acceptable values are `work`, `home` and `other`.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "work",
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"work", "home", "other"},
+								false)),
+						},
+						"primary": {
+							Description: "Whether this is the contact's primary address.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+					},
+				},
+			},
+			"contact_id": {
+				Description: "The ID of the contact.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"etag": {
+				Description: "ETag of the resource.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			// Adding a computed id simply to override the `optional` id that gets added in the SDK
+			// that will then display improperly in the docs
+			"id": {
+				Description: "The ID of this resource.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceDomainSharedContactCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	sharedContactsService, diags := client.NewSharedContactsService()
+	if diags.HasError() {
+		return diags
+	}
+
+	domain := d.Get("domain").(string)
+	log.Printf("[DEBUG] Creating Domain Shared Contact in %q", domain)
+
+	contact, err := sharedContactsService.Insert(ctx, domain, expandSharedContact(d))
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", domain, contact.contactId()))
+
+	log.Printf("[DEBUG] Finished creating Domain Shared Contact %q", d.Id())
+
+	return resourceDomainSharedContactRead(ctx, d, meta)
+}
+
+func resourceDomainSharedContactRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	sharedContactsService, diags := client.NewSharedContactsService()
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Getting Domain Shared Contact %q", d.Id())
+
+	contact, err := sharedContactsService.Get(ctx, d.Get("domain").(string), sharedContactId(d.Id()))
+	if err != nil {
+		return handleReadNotFoundError(err, d, d.Id())
+	}
+
+	if contact.Name != nil {
+		d.Set("given_name", contact.Name.GivenName)
+		d.Set("family_name", contact.Name.FamilyName)
+		d.Set("full_name", contact.Name.FullName)
+	}
+
+	d.Set("notes", contact.Content)
+	d.Set("contact_id", contact.contactId())
+	d.Set("etag", contact.Etag)
+
+	var emails []map[string]interface{}
+	for _, email := range contact.Emails {
+		emails = append(emails, map[string]interface{}{
+			"address":      email.Address,
+			"type":         strings.TrimPrefix(email.Rel, gdataRelPrefix),
+			"display_name": email.DisplayName,
+			"primary":      email.Primary,
+		})
+	}
+	d.Set("emails", emails)
+
+	var phoneNumbers []map[string]interface{}
+	for _, phoneNumber := range contact.PhoneNumbers {
+		phoneNumbers = append(phoneNumbers, map[string]interface{}{
+			"number":  phoneNumber.Number,
+			"type":    strings.TrimPrefix(phoneNumber.Rel, gdataRelPrefix),
+			"primary": phoneNumber.Primary,
+		})
+	}
+	d.Set("phone_numbers", phoneNumbers)
+
+	var organizations []map[string]interface{}
+	for _, organization := range contact.Organizations {
+		organizations = append(organizations, map[string]interface{}{
+			"name":    organization.Name,
+			"title":   organization.Title,
+			"type":    strings.TrimPrefix(organization.Rel, gdataRelPrefix),
+			"primary": organization.Primary,
+		})
+	}
+	d.Set("organizations", organizations)
+
+	var addresses []map[string]interface{}
+	for _, address := range contact.Addresses {
+		addresses = append(addresses, map[string]interface{}{
+			"formatted": address.FormattedAddress,
+			"type":      strings.TrimPrefix(address.Rel, gdataRelPrefix),
+			"primary":   address.Primary,
+		})
+	}
+	d.Set("addresses", addresses)
+
+	log.Printf("[DEBUG] Finished getting Domain Shared Contact %q", d.Id())
+
+	return diags
+}
+
+func resourceDomainSharedContactUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	sharedContactsService, diags := client.NewSharedContactsService()
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Updating Domain Shared Contact %q", d.Id())
+
+	_, err := sharedContactsService.Update(ctx, d.Get("domain").(string), sharedContactId(d.Id()), expandSharedContact(d))
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	log.Printf("[DEBUG] Finished updating Domain Shared Contact %q", d.Id())
+
+	return resourceDomainSharedContactRead(ctx, d, meta)
+}
+
+func resourceDomainSharedContactDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := meta.(*apiClient)
+
+	sharedContactsService, diags := client.NewSharedContactsService()
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Deleting Domain Shared Contact %q", d.Id())
+
+	err := sharedContactsService.Delete(ctx, d.Get("domain").(string), sharedContactId(d.Id()))
+	if err != nil {
+		return handleNotFoundError(err, d, d.Id())
+	}
+
+	log.Printf("[DEBUG] Finished deleting Domain Shared Contact %q", d.Id())
+
+	return diags
+}
+
+func resourceDomainSharedContactImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+
+	// id is of format "<domain>/<contact_id>"
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Domain Shared Contact Id (%s) is not of the correct format (<domain>/<contact_id>)", d.Id())
+	}
+
+	d.Set("domain", parts[0])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// sharedContactId returns the contact ID from the resource ID, of format "<domain>/<contact_id>"
+func sharedContactId(id string) string {
+	parts := strings.Split(id, "/")
+	return parts[len(parts)-1]
+}
+
+func expandSharedContact(d *schema.ResourceData) *sharedContact {
+	contact := &sharedContact{
+		Name: &gdataName{
+			GivenName:  d.Get("given_name").(string),
+			FamilyName: d.Get("family_name").(string),
+			FullName:   d.Get("full_name").(string),
+		},
+		Content: d.Get("notes").(string),
+	}
+
+	for _, e := range d.Get("emails").([]interface{}) {
+		email := e.(map[string]interface{})
+		contact.Emails = append(contact.Emails, gdataEmail{
+			Address:     email["address"].(string),
+			Rel:         gdataRelPrefix + email["type"].(string),
+			DisplayName: email["display_name"].(string),
+			Primary:     email["primary"].(bool),
+		})
+	}
+
+	for _, p := range d.Get("phone_numbers").([]interface{}) {
+		phoneNumber := p.(map[string]interface{})
+		contact.PhoneNumbers = append(contact.PhoneNumbers, gdataPhoneNumber{
+			Number:  phoneNumber["number"].(string),
+			Rel:     gdataRelPrefix + phoneNumber["type"].(string),
+			Primary: phoneNumber["primary"].(bool),
+		})
+	}
+
+	for _, o := range d.Get("organizations").([]interface{}) {
+		organization := o.(map[string]interface{})
+		contact.Organizations = append(contact.Organizations, gdataOrganization{
+			Name:    organization["name"].(string),
+			Title:   organization["title"].(string),
+			Rel:     gdataRelPrefix + organization["type"].(string),
+			Primary: organization["primary"].(bool),
+		})
+	}
+
+	for _, a := range d.Get("addresses").([]interface{}) {
+		address := a.(map[string]interface{})
+		contact.Addresses = append(contact.Addresses, gdataPostalAddress{
+			FormattedAddress: address["formatted"].(string),
+			Rel:              gdataRelPrefix + address["type"].(string),
+			Primary:          address["primary"].(bool),
+		})
+	}
+
+	return contact
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceDomainSharedContact_basic(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testContactVals := map[string]interface{}{
+		"domainName": domainName,
+		"givenName":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"title":      "Consultant",
+	}
+
+	testContactValsUpdate := map[string]interface{}{}
+	for k, v := range testContactVals {
+		testContactValsUpdate[k] = v
+	}
+	testContactValsUpdate["title"] = "Senior Consultant"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDomainSharedContact_basic(testContactVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_domain_shared_contact.contact", "emails.#", "1"),
+					resource.TestCheckResourceAttr("googleworkspace_domain_shared_contact.contact", "emails.0.type", "work"),
+					resource.TestCheckResourceAttr("googleworkspace_domain_shared_contact.contact", "organizations.0.title", "Consultant"),
+					resource.TestCheckResourceAttrSet("googleworkspace_domain_shared_contact.contact", "contact_id"),
+				),
+			},
+			{
+				ResourceName:            "googleworkspace_domain_shared_contact.contact",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"etag"},
+			},
+			{
+				Config: testAccResourceDomainSharedContact_basic(testContactValsUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_domain_shared_contact.contact", "organizations.0.title", "Senior Consultant"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceDomainSharedContact_basic(testContactVals map[string]interface{}) string {
+	return Nprintf(`
+provider "googleworkspace" {
+  oauth_scopes = ["https://www.google.com/m8/feeds/contacts/"]
+}
+
+resource "googleworkspace_domain_shared_contact" "contact" {
+  domain      = "%{domainName}"
+  given_name  = "%{givenName}"
+  family_name = "Contact"
+
+  emails {
+    address = "%{givenName}@example.com"
+    primary = true
+  }
+
+  phone_numbers {
+    number = "+1 555 0100"
+    type   = "mobile"
+  }
+
+  organizations {
+    name  = "Example Corp"
+    title = "%{title}"
+  }
+}
+`, testContactVals)
+}
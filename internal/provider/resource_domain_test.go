@@ -5,6 +5,7 @@ package googleworkspace
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -31,6 +32,24 @@ func TestAccResourceDomain(t *testing.T) {
 	})
 }
 
+func TestAccResourceDomain_makePrimaryUnverified(t *testing.T) {
+	t.Parallel()
+
+	domainName := fmt.Sprintf("tf-test-%s.com", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceDomain_makePrimary(domainName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("must be verified before it can be made the primary domain"),
+			},
+		},
+	})
+}
+
 func testAccResourceDomain(domainName string) string {
 	return fmt.Sprintf(`
 resource "googleworkspace_domain" "my-domain" {
@@ -38,3 +57,12 @@ resource "googleworkspace_domain" "my-domain" {
 }
 `, domainName)
 }
+
+func testAccResourceDomain_makePrimary(domainName string) string {
+	return fmt.Sprintf(`
+resource "googleworkspace_domain" "my-domain" {
+  domain_name  = "%s"
+  make_primary = true
+}
+`, domainName)
+}
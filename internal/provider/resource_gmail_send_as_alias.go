@@ -161,7 +161,7 @@ func resourceGmailSendAsAliasCreate(ctx context.Context, d *schema.ResourceData,
 		SmtpMsa:        expandSmtpMsa(d.Get("smtp_msa").([]interface{})),
 	}).Do()
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	d.Set("send_as_email", sendAs.SendAsEmail)
@@ -197,7 +197,7 @@ func resourceGmailSendAsAliasUpdate(ctx context.Context, d *schema.ResourceData,
 		SmtpMsa:        expandSmtpMsa(d.Get("smtp_msa").([]interface{})),
 	}).Do()
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	log.Printf("[DEBUG] Finished updating Gmail Send As Alias %q", d.Id())
@@ -223,7 +223,7 @@ func resourceGmailSendAsAliasRead(ctx context.Context, d *schema.ResourceData, m
 
 	sendAs, err := sendAsAliasService.Get("me", d.Get("send_as_email").(string)).Do()
 	if err != nil {
-		return handleNotFoundError(err, d, d.Id())
+		return handleReadNotFoundError(err, d, d.Id())
 	}
 
 	log.Printf("[DEBUG] Finished getting Gmail Send As Alias %q", d.Id())
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -81,9 +82,36 @@ func resourceGroup() *schema.Resource {
 				Computed:    true,
 			},
 			"aliases": {
-				Description: "asps.list of group's email addresses.",
+				Description: "asps.list of group's email addresses. The non-editable aliases, such as the addresses " +
+					"Google generates for the group in the account's domain aliases, are listed in `non_editable_aliases` " +
+					"instead.",
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"retain_old_email_as_alias": {
+				Description: "Whether the previous email is added as an alias when `email` changes, so mail sent " +
+					"to the old address keeps being delivered. The alias is tracked in `retained_aliases` rather " +
+					"than `aliases`. Unlike for users, Google doesn't add the alias when a group is renamed, so it " +
+					"defaults to `false` where `googleworkspace_user` defaults to `true`, both keeping the API's behavior.",
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"adopt_existing": {
+				Description: "Whether an existing group with the same email is adopted, rather than failing to create " +
+					"the group. The adopted group's `name` and `description` are updated to the configured ones, " +
+					"and `aliases` are added.",
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"retained_aliases": {
+				Description: "The previous emails kept as aliases, see `retain_old_email_as_alias`.",
 				Type:        schema.TypeList,
-				Optional:    true,
+				Computed:    true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -126,33 +154,48 @@ func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		Description: d.Get("description").(string),
 	}
 
-	group, err := groupsService.Insert(&groupObj).Do()
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
 	// The etag changes with each insert, so we want to monitor how many changes we should see
 	// when we're checking for eventual consistency
 	numInserts := 1
 
+	var existingAliases []string
+	group, err := groupsService.Insert(&groupObj).Do()
+	if isApiErrorWithCode(err, http.StatusConflict) && d.Get("adopt_existing").(bool) {
+		log.Printf("[DEBUG] Group %q already exists, adopting it", email)
+
+		group, err = adoptGroup(groupsService, &groupObj)
+		if err != nil {
+			return apiErrorDiagnostics(err)
+		}
+
+		existingAliases = group.Aliases
+	}
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
 	d.SetId(group.Id)
 
-	aliases := d.Get("aliases.#").(int)
+	aliases := listOfInterfacestoStrings(d.Get("aliases"))
 
-	if aliases > 0 {
+	if len(aliases) > 0 {
 		aliasesService, diags := GetGroupAliasService(groupsService)
 		if diags.HasError() {
 			return diags
 		}
 
-		for i := 0; i < aliases; i++ {
+		for _, alias := range aliases {
 			aliasObj := directory.Alias{
-				Alias: d.Get(fmt.Sprintf("aliases.%d", i)).(string),
+				Alias: alias,
+			}
+
+			if stringInSlice(existingAliases, aliasObj.Alias) {
+				continue
 			}
 
 			_, err := aliasesService.Insert(d.Id(), &aliasObj).Do()
 			if err != nil {
-				return diag.FromErr(err)
+				return apiErrorDiagnostics(err)
 			}
 			numInserts += 1
 		}
@@ -165,14 +208,14 @@ func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		resourceType: "group",
 		timeout:      d.Timeout(schema.TimeoutCreate),
 	}
-	err = retryTimeDuration(ctx, d.Timeout(schema.TimeoutCreate), func() error {
+	err = retryConsistencyCheck(ctx, d.Timeout(schema.TimeoutCreate), func() error {
 		var retryErr error
 
 		if cc.reachedConsistency(numInserts) {
 			return nil
 		}
 
-		newGroup, retryErr := groupsService.Get(d.Id()).IfNoneMatch(cc.lastEtag).Do()
+		newGroup, retryErr := groupsService.Get(d.Id()).Fields(consistencyCheckFields).IfNoneMatch(cc.lastEtag).Do()
 		if googleapi.IsNotModified(retryErr) {
 			cc.currConsistent += 1
 		} else if isNotFound(retryErr) {
@@ -188,7 +231,7 @@ func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	})
 
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	log.Printf("[DEBUG] Finished creating Group %q: %#v", d.Id(), email)
@@ -214,7 +257,7 @@ func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interfa
 
 	group, err := groupsService.Get(d.Id()).Do()
 	if err != nil {
-		return handleNotFoundError(err, d, d.Get("email").(string))
+		return handleReadNotFoundError(err, d, d.Get("email").(string))
 	}
 
 	d.Set("email", group.Email)
@@ -222,12 +265,22 @@ func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	d.Set("description", group.Description)
 	d.Set("admin_created", group.AdminCreated)
 	d.Set("direct_members_count", group.DirectMembersCount)
-	d.Set("aliases", group.Aliases)
+	d.Set("aliases", filterRetainedAliases(d, editableGroupAliases(d, group)))
+
+	// retain_old_email_as_alias and adopt_existing are not returned by the API, default them for imported groups
+	if rawState := d.GetRawState(); !d.IsNewResource() && (rawState.IsNull() || rawState.GetAttr("retain_old_email_as_alias").IsNull()) {
+		d.Set("retain_old_email_as_alias", false)
+	}
+	if rawState := d.GetRawState(); !d.IsNewResource() && (rawState.IsNull() || rawState.GetAttr("adopt_existing").IsNull()) {
+		d.Set("adopt_existing", false)
+	}
 	d.Set("non_editable_aliases", group.NonEditableAliases)
 	d.Set("etag", group.Etag)
 
 	d.SetId(group.Id)
 
+	client.groupMemberships.setGroup(group.Id, group.Email, append(group.Aliases, group.NonEditableAliases...))
+
 	return diags
 }
 
@@ -267,8 +320,8 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	numInserts := 0
 	if d.HasChange("aliases") {
 		old, new := d.GetChange("aliases")
-		oldAliases := listOfInterfacestoStrings(old.([]interface{}))
-		newAliases := listOfInterfacestoStrings(new.([]interface{}))
+		oldAliases := listOfInterfacestoStrings(old)
+		newAliases := listOfInterfacestoStrings(new)
 
 		aliasesService, diags := GetGroupAliasService(groupsService)
 		if diags.HasError() {
@@ -283,7 +336,7 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 
 			err := aliasesService.Delete(d.Id(), alias).Do()
 			if err != nil {
-				return diag.FromErr(err)
+				return apiErrorDiagnostics(err)
 			}
 		}
 
@@ -299,7 +352,7 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 
 			_, err := aliasesService.Insert(d.Id(), &aliasObj).Do()
 			if err != nil {
-				return diag.FromErr(err)
+				return apiErrorDiagnostics(err)
 			}
 			numInserts += 1
 		}
@@ -308,13 +361,37 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	if &groupObj != new(directory.Group) {
 		group, err := groupsService.Update(d.Id(), &groupObj).Do()
 		if err != nil {
-			return diag.FromErr(err)
+			return apiErrorDiagnostics(err)
 		}
 		numInserts += 1
 
 		d.SetId(group.Id)
 	}
 
+	// Unlike users, renaming a group doesn't keep its previous email as an alias
+	if o, n := d.GetChange("email"); d.Get("retain_old_email_as_alias").(bool) && o.(string) != "" && o.(string) != n.(string) {
+		oldEmail := o.(string)
+
+		aliasesService, diags := GetGroupAliasService(groupsService)
+		if diags.HasError() {
+			return diags
+		}
+
+		log.Printf("[DEBUG] Adding alias %q for renamed Group %q", oldEmail, d.Id())
+		_, err := aliasesService.Insert(d.Id(), &directory.Alias{Alias: oldEmail}).Do()
+		if err != nil && !isApiErrorWithCode(err, http.StatusConflict) {
+			return apiErrorDiagnostics(err)
+		}
+		if err == nil {
+			numInserts += 1
+		}
+
+		retained := listOfInterfacestoStrings(d.Get("retained_aliases"))
+		if !stringInSlice(retained, oldEmail) {
+			d.Set("retained_aliases", append(retained, oldEmail))
+		}
+	}
+
 	// UPDATE will respond with the Group that will be created, however, it is eventually consistent
 	// After UPDATE, the etag is updated along with the Group (and any aliases),
 	// once we get a consistent etag, we can feel confident that our Group is also consistent
@@ -322,14 +399,14 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		resourceType: "group",
 		timeout:      d.Timeout(schema.TimeoutUpdate),
 	}
-	err := retryTimeDuration(ctx, d.Timeout(schema.TimeoutUpdate), func() error {
+	err := retryConsistencyCheck(ctx, d.Timeout(schema.TimeoutUpdate), func() error {
 		var retryErr error
 
 		if cc.reachedConsistency(numInserts) {
 			return nil
 		}
 
-		newGroup, retryErr := groupsService.Get(d.Id()).IfNoneMatch(cc.lastEtag).Do()
+		newGroup, retryErr := groupsService.Get(d.Id()).Fields(consistencyCheckFields).IfNoneMatch(cc.lastEtag).Do()
 		if googleapi.IsNotModified(retryErr) {
 			cc.currConsistent += 1
 		} else if retryErr != nil {
@@ -342,7 +419,7 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	})
 
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	log.Printf("[DEBUG] Finished creating Group %q: %#v", d.Id(), email)
@@ -350,6 +427,57 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	return resourceGroupRead(ctx, d, meta)
 }
 
+// editableGroupAliases leaves out the non-editable aliases, such as the addresses Google generates for the group
+// in the account's domain aliases, which would otherwise show as perpetual diffs unless they're configured or
+// retained.
+func editableGroupAliases(d *schema.ResourceData, group *directory.Group) []string {
+	configured := append(listOfInterfacestoStrings(d.Get("aliases")), listOfInterfacestoStrings(d.Get("retained_aliases"))...)
+
+	result := []string{}
+	for _, alias := range group.Aliases {
+		if stringInSlice(group.NonEditableAliases, alias) && !stringInSlice(configured, alias) {
+			continue
+		}
+
+		result = append(result, alias)
+	}
+
+	return result
+}
+
+// adoptGroup gets the existing group with the email of groupObj, and updates its name and description
+// when they're configured and differ
+func adoptGroup(groupsService *directory.GroupsService, groupObj *directory.Group) (*directory.Group, error) {
+	group, err := groupsService.Get(groupObj.Email).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	update := directory.Group{}
+	if groupObj.Name != "" && groupObj.Name != group.Name {
+		update.Name = groupObj.Name
+	}
+	if groupObj.Description != "" && groupObj.Description != group.Description {
+		update.Description = groupObj.Description
+	}
+
+	if update.Name == "" && update.Description == "" {
+		return group, nil
+	}
+
+	log.Printf("[DEBUG] Updating adopted Group %q", group.Id)
+
+	updated, err := groupsService.Update(group.Id, &update).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	// the aliases of the update's response aren't reliable
+	updated.Aliases = group.Aliases
+
+	return updated, nil
+}
+
 func resourceGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
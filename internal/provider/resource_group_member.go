@@ -22,13 +22,16 @@ func resourceGroupMember() *schema.Resource {
 	return &schema.Resource{
 		// This description is used by the documentation generator and the language server.
 		Description: "Group Member resource manages Google Workspace Groups Members. Group Member resides under the " +
-			"`https://www.googleapis.com/auth/admin.directory.group` client scope.",
+			"`https://www.googleapis.com/auth/admin.directory.group` client scope. Members of the same group are " +
+			"added, updated and removed one at a time, as concurrent writes to a group's members conflict.",
 
 		CreateContext: resourceGroupMemberCreate,
 		ReadContext:   resourceGroupMemberRead,
 		UpdateContext: resourceGroupMemberUpdate,
 		DeleteContext: resourceGroupMemberDelete,
 
+		CustomizeDiff: resourceGroupMemberCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
@@ -47,11 +50,12 @@ func resourceGroupMember() *schema.Resource {
 			},
 			"email": {
 				Description: "The member's email address. A member can be a user or another group. This property is " +
-					"required when adding a member to a group. The email must be unique and cannot be an alias of " +
-					"another group. If the email address is changed, the API automatically reflects the email address changes.",
+					"required when adding a member of type `USER` or `GROUP` to a group, and must not be set for members of " +
+					"type `CUSTOMER`. The email must be unique and cannot be an alias of another group. If the email " +
+					"address is changed, the API automatically reflects the email address changes.",
 				Type:     schema.TypeString,
 				ForceNew: true,
-				Required: true,
+				Optional: true,
 			},
 			"role": {
 				Description: "The member's role in a group. The API returns an error for cycles in group memberships. " +
@@ -107,9 +111,28 @@ func resourceGroupMember() *schema.Resource {
 					"DISABLED", "NONE"}, false)),
 			},
 			"member_id": {
-				Description: "The unique ID of the group member. A member id can be used as a member request URI's memberKey.",
-				Type:        schema.TypeString,
-				Computed:    true,
+				Description: "The unique ID of the group member. A member id can be used as a member request URI's memberKey. " +
+					"This property is required when adding a member of type `CUSTOMER`, in which case it is the customer ID " +
+					"of the account whose users all become members of the group.",
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Optional: true,
+				Computed: true,
+			},
+			"allow_existing_member": {
+				Description: "If the member already belongs to the group when the resource is created, adopt the existing " +
+					"membership into state and update its `role` and `delivery_settings` instead of failing.",
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"wait_for_active": {
+				Description: "Whether to wait, when the member is created, until its `status` is no longer `PENDING`, e.g. " +
+					"while an external member hasn't accepted their invitation yet. Creating the member fails when it's " +
+					"still pending after the create timeout.",
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			// Adding a computed id simply to override the `optional` id that gets added in the SDK
 			// that will then display improperly in the docs
@@ -144,16 +167,36 @@ func resourceGroupMemberCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	memberObj := directory.Member{
 		Email:            d.Get("email").(string),
+		Id:               d.Get("member_id").(string),
 		Role:             d.Get("role").(string),
 		Type:             d.Get("type").(string),
 		DeliverySettings: d.Get("delivery_settings").(string),
 	}
 
+	// concurrent writes to the same group's members conflict, the lock is only held while writing
+	groupMembersMutexKV.Lock(groupMembersMutexKey(groupId))
+
 	member, err := membersService.Insert(groupId, &memberObj).Do()
 
-	// If we receive a 409 that the member already exists, ignore it, we'll import it next
+	// If we receive a 409 that the member already exists, adopt the existing member when allowed to
+	if isApiErrorWithCode(err, 409) && d.Get("allow_existing_member").(bool) {
+		memberKey := memberObj.Email
+		if memberKey == "" {
+			memberKey = memberObj.Id
+		}
+
+		log.Printf("[DEBUG] Group Member %q already exists in group %s, adopting it", memberKey, groupId)
+
+		member, err = membersService.Patch(groupId, memberKey, &directory.Member{
+			Role:             memberObj.Role,
+			DeliverySettings: memberObj.DeliverySettings,
+		}).Do()
+	}
+
+	groupMembersMutexKV.Unlock(groupMembersMutexKey(groupId))
+
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	d.Set("member_id", member.Id)
@@ -166,14 +209,14 @@ func resourceGroupMemberCreate(ctx context.Context, d *schema.ResourceData, meta
 		resourceType: "group_member",
 		timeout:      d.Timeout(schema.TimeoutCreate),
 	}
-	err = retryTimeDuration(ctx, d.Timeout(schema.TimeoutCreate), func() error {
+	err = retryConsistencyCheck(ctx, d.Timeout(schema.TimeoutCreate), func() error {
 		var retryErr error
 
 		if cc.reachedConsistency(1) {
 			return nil
 		}
 
-		newMember, retryErr := membersService.Get(groupId, member.Id).IfNoneMatch(cc.lastEtag).Do()
+		newMember, retryErr := membersService.Get(groupId, member.Id).Fields(consistencyCheckFields).IfNoneMatch(cc.lastEtag).Do()
 		if googleapi.IsNotModified(retryErr) {
 			cc.currConsistent += 1
 		} else if isNotFound(retryErr) {
@@ -189,7 +232,28 @@ func resourceGroupMemberCreate(ctx context.Context, d *schema.ResourceData, meta
 	})
 
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
+	}
+
+	// dependent resources may rely on the effective membership, which pending members don't have yet
+	if d.Get("wait_for_active").(bool) {
+		log.Printf("[DEBUG] Waiting for Group Member %q to no longer be pending", member.Id)
+
+		err = retryTimeDuration(ctx, d.Timeout(schema.TimeoutCreate), func() error {
+			newMember, retryErr := membersService.Get(groupId, member.Id).Fields("status").Do()
+			if retryErr != nil {
+				return retryErr
+			}
+
+			if newMember.Status == "PENDING" {
+				return fmt.Errorf("timed out while waiting for group member %s to no longer be pending", member.Id)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return apiErrorDiagnostics(err)
+		}
 	}
 
 	log.Printf("[DEBUG] Finished creating Group Member %q: %#v", member.Id, email)
@@ -218,7 +282,7 @@ func resourceGroupMemberRead(ctx context.Context, d *schema.ResourceData, meta i
 
 	member, err := membersService.Get(groupId, memberId).Do()
 	if err != nil {
-		return handleNotFoundError(err, d, d.Id())
+		return handleReadNotFoundError(err, d, d.Id())
 	}
 
 	d.Set("email", member.Email)
@@ -228,6 +292,14 @@ func resourceGroupMemberRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("status", member.Status)
 	d.Set("delivery_settings", member.DeliverySettings)
 	d.Set("member_id", member.Id)
+	// allow_existing_member is not returned by the API, default it for imported members
+	if _, ok := d.GetOk("allow_existing_member"); !ok {
+		d.Set("allow_existing_member", false)
+	}
+	// wait_for_active is not returned by the API either
+	if _, ok := d.GetOk("wait_for_active"); !ok {
+		d.Set("wait_for_active", false)
+	}
 
 	d.SetId(fmt.Sprintf("groups/%s/members/%s", groupId, member.Id))
 
@@ -275,9 +347,11 @@ func resourceGroupMemberUpdate(ctx context.Context, d *schema.ResourceData, meta
 	if &memberObj != new(directory.Member) {
 		groupId := d.Get("group_id").(string)
 		memberId := d.Get("member_id").(string)
+		groupMembersMutexKV.Lock(groupMembersMutexKey(groupId))
 		member, err := membersService.Update(groupId, memberId, &memberObj).Do()
+		groupMembersMutexKV.Unlock(groupMembersMutexKey(groupId))
 		if err != nil {
-			return diag.FromErr(err)
+			return apiErrorDiagnostics(err)
 		}
 
 		d.SetId(fmt.Sprintf("groups/%s/members/%s", groupId, member.Id))
@@ -289,14 +363,14 @@ func resourceGroupMemberUpdate(ctx context.Context, d *schema.ResourceData, meta
 			resourceType: "group_member",
 			timeout:      d.Timeout(schema.TimeoutUpdate),
 		}
-		err = retryTimeDuration(ctx, d.Timeout(schema.TimeoutUpdate), func() error {
+		err = retryConsistencyCheck(ctx, d.Timeout(schema.TimeoutUpdate), func() error {
 			var retryErr error
 
 			if cc.reachedConsistency(1) {
 				return nil
 			}
 
-			newMember, retryErr := membersService.Get(groupId, member.Id).IfNoneMatch(cc.lastEtag).Do()
+			newMember, retryErr := membersService.Get(groupId, member.Id).Fields(consistencyCheckFields).IfNoneMatch(cc.lastEtag).Do()
 			if googleapi.IsNotModified(retryErr) {
 				cc.currConsistent += 1
 			} else if retryErr != nil {
@@ -309,7 +383,7 @@ func resourceGroupMemberUpdate(ctx context.Context, d *schema.ResourceData, meta
 		})
 
 		if err != nil {
-			return diag.FromErr(err)
+			return apiErrorDiagnostics(err)
 		}
 	}
 
@@ -339,7 +413,9 @@ func resourceGroupMemberDelete(ctx context.Context, d *schema.ResourceData, meta
 		return diags
 	}
 
+	groupMembersMutexKV.Lock(groupMembersMutexKey(groupId))
 	err := membersService.Delete(groupId, memberId).Do()
+	groupMembersMutexKV.Unlock(groupMembersMutexKey(groupId))
 	if err != nil {
 		return handleNotFoundError(err, d, d.Id())
 	}
@@ -349,6 +425,95 @@ func resourceGroupMemberDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+// Members of type CUSTOMER are identified by the customer ID and have no email,
+// all other members require an email.
+func resourceGroupMemberCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+
+	memberType := diff.Get("type").(string)
+	email := rawConfig.GetAttr("email")
+	memberId := rawConfig.GetAttr("member_id")
+
+	if memberType == "CUSTOMER" {
+		if !email.IsNull() {
+			return fmt.Errorf("email must not be set for group members of type CUSTOMER")
+		}
+
+		if memberId.IsNull() {
+			return fmt.Errorf("member_id is required for group members of type CUSTOMER")
+		}
+
+		return nil
+	}
+
+	if email.IsNull() {
+		return fmt.Errorf("email is required for group members of type %s", memberType)
+	}
+
+	// every membership remaining after the plan is added to the provider's memberships, so that a cycle is found
+	// whichever of its memberships is planned last. The group_id of a group created in the same apply isn't known
+	// when planning, the membership is checked when Terraform plans it again during the apply, once the group exists
+	if memberType == "GROUP" && diff.NewValueKnown("group_id") && diff.NewValueKnown("email") {
+		groupId, email := diff.Get("group_id").(string), diff.Get("email").(string)
+
+		// unchanged memberships are only added, so plans of large nested groups don't look up every group
+		if !diff.HasChange("group_id") && !diff.HasChange("email") {
+			meta.(*apiClient).groupMemberships.addMembership(groupId, email)
+			return nil
+		}
+
+		return checkGroupMembershipCycle(ctx, meta, groupId, email)
+	}
+
+	return nil
+}
+
+// checkGroupMembershipCycle returns an error if adding the groups memberEmails to the group groupId would create a
+// membership cycle with the other memberships planned, and adds the memberships to them otherwise. Memberships
+// that aren't managed by the provider are left to the API, which rejects cycles when applying.
+func checkGroupMembershipCycle(ctx context.Context, meta interface{}, groupId string, memberEmails ...string) error {
+	client := meta.(*apiClient)
+
+	// the group is looked up so that memberships referencing it by ID or alias are matched, the check is still
+	// done with the group_id as is if the lookup fails
+	if group, err := getGroupForMembershipCheck(ctx, client, groupId); err != nil {
+		log.Printf("[WARN] unable to get group %s to check its memberships for cycles: %s", groupId, err)
+	} else if group != nil {
+		client.groupMemberships.setGroup(group.Id, group.Email, append(group.Aliases, group.NonEditableAliases...))
+	}
+
+	for _, memberEmail := range memberEmails {
+		if err := client.groupMemberships.checkAndAddMembership(groupId, memberEmail); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getGroupForMembershipCheck returns nil if the group doesn't exist yet
+func getGroupForMembershipCheck(ctx context.Context, client *apiClient, groupId string) (*directory.Group, error) {
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return nil, fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	groupsService, diags := GetGroupsService(directoryService)
+	if diags.HasError() {
+		return nil, fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	group, err := groupsService.Get(groupId).Fields("id", "email", "aliases", "nonEditableAliases").Context(ctx).Do()
+	if isNotFound(err) {
+		return nil, nil
+	}
+
+	return group, err
+}
+
 func resourceGroupMemberImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	parts := strings.Split(d.Id(), "/")
 
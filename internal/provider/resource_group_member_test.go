@@ -6,6 +6,7 @@ package googleworkspace
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -95,6 +96,45 @@ func TestAccResourceGroupMember_full(t *testing.T) {
 	})
 }
 
+func TestAccResourceGroupMember_customer(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testGroupVals := map[string]interface{}{
+		"domainName": domainName,
+		"groupEmail": fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"customerId": getTestCustomerFromEnv(),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccResourceGroupMemberExists("googleworkspace_group_member.my-group-member"),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceGroupMember_customer(testGroupVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_group_member.my-group-member", "type", "CUSTOMER"),
+					resource.TestCheckResourceAttr("googleworkspace_group_member.my-group-member", "email", ""),
+				),
+			},
+			{
+				ResourceName:            "googleworkspace_group_member.my-group-member",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"etag"},
+			},
+		},
+	})
+}
+
 func testAccResourceGroupMemberExists(resource string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resource]
@@ -133,6 +173,157 @@ func testAccResourceGroupMemberExists(resource string) resource.TestCheckFunc {
 	}
 }
 
+func TestAccResourceGroupMember_allowExistingMember(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testGroupVals := map[string]interface{}{
+		"domainName": domainName,
+		"userEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"groupEmail": fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":   acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceGroupMember_allowExistingMember(testGroupVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("googleworkspace_group_member.adopted", "member_id",
+						"googleworkspace_group_member.my-group-member", "member_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupMember_waitForActive(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testGroupVals := map[string]interface{}{
+		"domainName": domainName,
+		"userEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"groupEmail": fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":   acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceGroupMember_waitForActive(testGroupVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_group_member.my-group-member", "status", "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupMember_cycle(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testGroupVals := map[string]interface{}{
+		"domainName":  domainName,
+		"groupEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"group2Email": fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceGroupMember_cycle(testGroupVals, false),
+			},
+			{
+				Config:      testAccResourceGroupMember_cycle(testGroupVals, true),
+				ExpectError: regexp.MustCompile("would create a membership cycle"),
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupMember_cycleNewGroups(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testGroupVals := map[string]interface{}{
+		"domainName":  domainName,
+		"groupEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"group2Email": fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// both groups and both memberships are created in the same apply
+				Config: testAccResourceGroupMember_cycle(testGroupVals, true),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("would create a membership cycle(.|\\n)*(%s|%s)@",
+					testGroupVals["groupEmail"], testGroupVals["group2Email"])),
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupMember_concurrent(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testGroupVals := map[string]interface{}{
+		"domainName":   domainName,
+		"groupEmail":   fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"memberPrefix": fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+	}
+
+	// the members are all added to the same group in parallel
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceGroupMember_concurrent(testGroupVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("googleworkspace_group_member.members.0", "member_id"),
+					resource.TestCheckResourceAttrSet("googleworkspace_group_member.members.9", "member_id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccResourceGroupMember_basic(testGroupVals map[string]interface{}) string {
 	return Nprintf(`
 resource "googleworkspace_group" "my-group" {
@@ -156,6 +347,20 @@ resource "googleworkspace_group_member" "my-group-member" {
 `, testGroupVals)
 }
 
+func testAccResourceGroupMember_customer(testGroupVals map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_group" "my-group" {
+  email = "%{groupEmail}@%{domainName}"
+}
+
+resource "googleworkspace_group_member" "my-group-member" {
+  group_id  = googleworkspace_group.my-group.id
+  type      = "CUSTOMER"
+  member_id = "%{customerId}"
+}
+`, testGroupVals)
+}
+
 func testAccResourceGroupMember_full(testGroupVals map[string]interface{}) string {
 	return Nprintf(`
 resource "googleworkspace_group" "my-group" {
@@ -209,3 +414,110 @@ resource "googleworkspace_group_member" "my-group-member" {
 }
 `, testGroupVals)
 }
+
+func testAccResourceGroupMember_allowExistingMember(testGroupVals map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_group" "my-group" {
+  email = "%{groupEmail}@%{domainName}"
+}
+
+resource "googleworkspace_user" "my-new-user" {
+  primary_email = "%{userEmail}@%{domainName}"
+  password = "%{password}"
+
+  name {
+    family_name = "Scott"
+    given_name = "Michael"
+  }
+}
+
+resource "googleworkspace_group_member" "my-group-member" {
+  group_id = googleworkspace_group.my-group.id
+  email = googleworkspace_user.my-new-user.primary_email
+}
+
+resource "googleworkspace_group_member" "adopted" {
+  group_id = googleworkspace_group.my-group.id
+  email = googleworkspace_user.my-new-user.primary_email
+
+  allow_existing_member = true
+
+  depends_on = [googleworkspace_group_member.my-group-member]
+}
+`, testGroupVals)
+}
+
+func testAccResourceGroupMember_waitForActive(testGroupVals map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_group" "my-group" {
+  email = "%{groupEmail}@%{domainName}"
+}
+
+resource "googleworkspace_user" "my-new-user" {
+  primary_email = "%{userEmail}@%{domainName}"
+  password = "%{password}"
+
+  name {
+    family_name = "Scott"
+    given_name = "Michael"
+  }
+}
+
+resource "googleworkspace_group_member" "my-group-member" {
+  group_id = googleworkspace_group.my-group.id
+  email = googleworkspace_user.my-new-user.primary_email
+
+  wait_for_active = true
+}
+`, testGroupVals)
+}
+
+func testAccResourceGroupMember_cycle(testGroupVals map[string]interface{}, cycle bool) string {
+	config := Nprintf(`
+resource "googleworkspace_group" "my-group" {
+  email = "%{groupEmail}@%{domainName}"
+}
+
+resource "googleworkspace_group" "my-group-2" {
+  email = "%{group2Email}@%{domainName}"
+}
+
+resource "googleworkspace_group_member" "my-group-member" {
+  group_id = googleworkspace_group.my-group.id
+  email = googleworkspace_group.my-group-2.email
+  type = "GROUP"
+}
+`, testGroupVals)
+
+	if cycle {
+		config += `
+resource "googleworkspace_group_member" "cycle" {
+  group_id = googleworkspace_group.my-group-2.id
+  email = googleworkspace_group.my-group.email
+  type = "GROUP"
+}
+`
+	}
+
+	return config
+}
+
+func testAccResourceGroupMember_concurrent(testGroupVals map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_group" "my-group" {
+  email = "%{groupEmail}@%{domainName}"
+}
+
+resource "googleworkspace_group" "members" {
+  count = 10
+  email = "%{memberPrefix}-${count.index}@%{domainName}"
+}
+
+resource "googleworkspace_group_member" "members" {
+  count    = 10
+  group_id = googleworkspace_group.my-group.id
+  email    = googleworkspace_group.members[count.index].email
+  type     = "GROUP"
+}
+`, testGroupVals)
+}
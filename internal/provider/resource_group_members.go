@@ -5,10 +5,14 @@ package googleworkspace
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"reflect"
+	"regexp"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -20,6 +24,14 @@ import (
 
 const deliverySettingsDefault = "ALL_MAIL"
 
+// The number of members whose delivery settings are requested at once
+const groupMembersReadConcurrency = 5
+
+const (
+	groupMembersModeAuthoritative    = "AUTHORITATIVE"
+	groupMembersModeNonAuthoritative = "NON_AUTHORITATIVE"
+)
+
 type MemberChange struct {
 	Old, New map[string]interface{}
 }
@@ -35,10 +47,19 @@ func resourceGroupMembers() *schema.Resource {
 		UpdateContext: resourceGroupMembersUpdate,
 		DeleteContext: resourceGroupMembersDelete,
 
+		// members are written one at a time, large groups may need longer
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceGroupMembersImport,
 		},
 
+		CustomizeDiff: resourceGroupMembersCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"group_id": {
 				Description: "Identifies the group in the API request. The value can be the group's email address, " +
@@ -47,6 +68,29 @@ func resourceGroupMembers() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"mode": {
+				Description: "Defines how the membership of the group is managed. Acceptable values are: " +
+					"\n\t- `AUTHORITATIVE`: The `members` are the only members of the group, members added outside of " +
+					"Terraform are removed on the next apply. " +
+					"\n\t- `NON_AUTHORITATIVE`: The `members` are guaranteed to be members of the group, members added " +
+					"outside of Terraform are left untouched.",
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  groupMembersModeAuthoritative,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{groupMembersModeAuthoritative,
+					groupMembersModeNonAuthoritative}, false)),
+			},
+			"ignore_members": {
+				Description: "A list of regular expressions matched against member emails. Members that match one of the " +
+					"expressions and are not declared in `members` are neither added nor removed, e.g. service accounts " +
+					"or members provisioned through SCIM.",
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsValidRegExp),
+				},
+			},
 			"members": {
 				Description: "The members of the group",
 				Type:        schema.TypeSet,
@@ -153,6 +197,10 @@ func resourceGroupMembersCreate(ctx context.Context, d *schema.ResourceData, met
 		return diags
 	}
 
+	// concurrent writes to the same group's members conflict, e.g. with googleworkspace_group_member
+	groupMembersMutexKV.Lock(groupMembersMutexKey(groupId))
+	defer groupMembersMutexKV.Unlock(groupMembersMutexKey(groupId))
+
 	members := d.Get("members").(*schema.Set)
 	for _, mMap := range members.List() {
 		memb := mMap.(map[string]interface{})
@@ -166,9 +214,9 @@ func resourceGroupMembersCreate(ctx context.Context, d *schema.ResourceData, met
 
 		log.Printf("[DEBUG] Creating Group Member %q in group %s: %#v", memberObj.Email, groupId, memberObj.Email)
 
-		_, err := membersService.Insert(groupId, &memberObj).Do()
+		_, err := membersService.Insert(groupId, &memberObj).Context(ctx).Do()
 		if err != nil {
-			return diag.FromErr(err)
+			return apiErrorDiagnostics(err)
 		}
 	}
 
@@ -201,48 +249,104 @@ func resourceGroupMembersRead(ctx context.Context, d *schema.ResourceData, meta
 	if includeDM, ok := d.GetOk("include_derived_membership"); ok {
 		includeDerivedMembership = includeDM.(bool)
 	}
+	// limit is only available in the datasource as well
+	limit := 0
+	if l, ok := d.GetOk("limit"); ok {
+		limit = l.(int)
+	}
+
 	var result []*directory.Member
-	membersCall := membersService.List(groupId).MaxResults(200).IncludeDerivedMembership(includeDerivedMembership)
+	membersCall := membersService.List(groupId).MaxResults(dataSourcePageSize(limit, membersMaxResults)).IncludeDerivedMembership(includeDerivedMembership)
 
 	err := membersCall.Pages(ctx, func(resp *directory.Members) error {
 		for _, member := range resp.Members {
+			if limit > 0 && len(result) >= limit {
+				return errDataSourceLimitReached
+			}
+
 			result = append(result, member)
 		}
 
 		return nil
 	})
-	if err != nil {
-		return handleNotFoundError(err, d, d.Id())
+	if err != nil && !errors.Is(err, errDataSourceLimitReached) {
+		return handleReadNotFoundError(err, d, d.Id())
 	}
 
 	configMembers := d.Get("members").(*schema.Set)
 
-	members := make([]interface{}, len(result))
-	for i, member := range result {
+	// mode is only available in the resource, the datasource always returns every member
+	mode := groupMembersModeAuthoritative
+	if m, ok := d.GetOk("mode"); ok {
+		mode = m.(string)
+	}
+
+	ignoreMembers := []*regexp.Regexp{}
+	if im, ok := d.GetOk("ignore_members"); ok {
+		for _, pattern := range im.([]interface{}) {
+			re, err := regexp.Compile(pattern.(string))
+			if err != nil {
+				return diag.FromErr(err)
+			}
 
-		// Use value if present or default as "delivery_settings" is not provided by API
-		deliverySettings := deliverySettingsDefault
+			ignoreMembers = append(ignoreMembers, re)
+		}
+	}
 
+	tracked := []*directory.Member{}
+	for _, member := range result {
+		managed := false
 		for _, cm := range configMembers.List() {
-			cMem := cm.(map[string]interface{})
-			if cMem["email"].(string) == member.Email {
-				if cMem["delivery_settings"] == "" {
-					continue
-				}
-
-				deliverySettings = cMem["delivery_settings"].(string)
+			if cm.(map[string]interface{})["email"].(string) == member.Email {
+				managed = true
 				break
 			}
 		}
 
-		members[i] = map[string]interface{}{
+		// In non-authoritative mode, members that were added outside of Terraform are not tracked
+		if mode == groupMembersModeNonAuthoritative && !managed {
+			continue
+		}
+
+		// Members matching an ignore pattern are managed outside of Terraform
+		if !managed && memberMatchesAny(member.Email, ignoreMembers) {
+			continue
+		}
+
+		tracked = append(tracked, member)
+	}
+
+	// members.list doesn't return delivery settings, the resource gets them per member so changes made
+	// outside of Terraform show as drift. The datasource, which can list large or derived memberships, doesn't.
+	var deliverySettings []string
+	if _, ok := d.GetOk("mode"); ok {
+		deliverySettings, err = getMembersDeliverySettings(ctx, membersService, groupId, tracked)
+		if err != nil {
+			// the group was found when listing its members, a member that isn't found is skipped
+			return apiErrorDiagnostics(err)
+		}
+	}
+
+	members := []interface{}{}
+	for i, member := range tracked {
+		memberDeliverySettings := deliverySettingsDefault
+		if deliverySettings != nil {
+			memberDeliverySettings = deliverySettings[i]
+		}
+
+		// the member was removed since it was listed
+		if memberDeliverySettings == "" {
+			continue
+		}
+
+		members = append(members, map[string]interface{}{
 			"email":             member.Email,
 			"role":              member.Role,
 			"type":              member.Type,
 			"status":            member.Status,
-			"delivery_settings": deliverySettings,
+			"delivery_settings": memberDeliverySettings,
 			"id":                member.Id,
-		}
+		})
 	}
 
 	if err := d.Set("members", members); err != nil {
@@ -278,6 +382,10 @@ func resourceGroupMembersUpdate(ctx context.Context, d *schema.ResourceData, met
 		return diags
 	}
 
+	// serialized with the other writes to the group's members
+	groupMembersMutexKV.Lock(groupMembersMutexKey(groupId))
+	defer groupMembersMutexKV.Unlock(groupMembersMutexKey(groupId))
+
 	o, n := d.GetChange("members")
 	vals := make(map[string]*MemberChange)
 	for _, raw := range o.(*schema.Set).List() {
@@ -306,9 +414,9 @@ func resourceGroupMembersUpdate(ctx context.Context, d *schema.ResourceData, met
 
 			log.Printf("[DEBUG] Creating Group Member %q in group %s: %#v", memberObj.Email, groupId, memberObj.Email)
 
-			_, err := membersService.Insert(groupId, &memberObj).Do()
+			_, err := membersService.Insert(groupId, &memberObj).Context(ctx).Do()
 			if err != nil {
-				return diag.FromErr(err)
+				return apiErrorDiagnostics(err)
 			}
 			continue
 		}
@@ -316,9 +424,9 @@ func resourceGroupMembersUpdate(ctx context.Context, d *schema.ResourceData, met
 		if change.New == nil {
 			memberKey := change.Old["id"].(string)
 			log.Printf("[DEBUG] Remove Group Member %q from group %s: %#v", name, groupId, memberKey)
-			err := membersService.Delete(groupId, memberKey).Do()
+			err := membersService.Delete(groupId, memberKey).Context(ctx).Do()
 			if err != nil {
-				return diag.FromErr(err)
+				return apiErrorDiagnostics(err)
 			}
 			continue
 		}
@@ -334,9 +442,9 @@ func resourceGroupMembersUpdate(ctx context.Context, d *schema.ResourceData, met
 			DeliverySettings: change.New["delivery_settings"].(string),
 		}
 
-		_, err := membersService.Update(groupId, change.Old["id"].(string), &memberObj).Do()
+		_, err := membersService.Update(groupId, change.Old["id"].(string), &memberObj).Context(ctx).Do()
 		if err != nil {
-			return diag.FromErr(err)
+			return apiErrorDiagnostics(err)
 		}
 
 		d.SetId(fmt.Sprintf("groups/%s", groupId))
@@ -366,10 +474,13 @@ func resourceGroupMembersDelete(ctx context.Context, d *schema.ResourceData, met
 		return diags
 	}
 
+	groupMembersMutexKV.Lock(groupMembersMutexKey(groupId))
+	defer groupMembersMutexKV.Unlock(groupMembersMutexKey(groupId))
+
 	for _, raw := range members.List() {
 		member := raw.(map[string]interface{})
 		memberKey := member["id"].(string)
-		err := membersService.Delete(groupId, memberKey).Do()
+		err := membersService.Delete(groupId, memberKey).Context(ctx).Do()
 		if err != nil {
 			return handleNotFoundError(err, d, d.Id())
 		}
@@ -381,15 +492,129 @@ func resourceGroupMembersDelete(ctx context.Context, d *schema.ResourceData, met
 	return diags
 }
 
+// resourceGroupMembersImport accepts "groups/<group_id>" or the bare group_id, where group_id is the group's email
+// address, alias, or unique ID, and should match the group_id of the configuration
 func resourceGroupMembersImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	parts := strings.Split(d.Id(), "/")
-
-	// id is of format "groups/<group_id>"
-	if len(parts) != 2 {
+	groupId := strings.TrimPrefix(d.Id(), "groups/")
+	if groupId == "" || strings.Contains(groupId, "/") {
 		return nil, fmt.Errorf("Group Member Id (%s) is not of the correct format (groups/<group_id>)", d.Id())
 	}
 
-	d.Set("group_id", parts[1])
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return nil, fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	groupsService, diags := GetGroupsService(directoryService)
+	if diags.HasError() {
+		return nil, fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	// an unknown group would otherwise be imported with an empty members set
+	if _, err := groupsService.Get(groupId).Fields("id").Do(); err != nil {
+		return nil, fmt.Errorf("error getting group %s: %w", groupId, err)
+	}
+
+	d.SetId(fmt.Sprintf("groups/%s", groupId))
+	d.Set("group_id", groupId)
+	d.Set("mode", groupMembersModeAuthoritative)
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// getMembersDeliverySettings gets the delivery settings of members, in the same order, as members.list doesn't
+// return them. A semaphore bounds the number of members.get requests in flight. Members removed since they were
+// listed are gone rather than an error, their delivery setting is left empty.
+func getMembersDeliverySettings(ctx context.Context, membersService *directory.MembersService, groupId string, members []*directory.Member) ([]string, error) {
+	results := make([]string, len(members))
+	errs := make([]error, len(members))
+
+	sem := make(chan struct{}, groupMembersReadConcurrency)
+	var wg sync.WaitGroup
+	for i, member := range members {
+		// customer members don't have delivery settings
+		if member.Type == "CUSTOMER" {
+			results[i] = deliverySettingsDefault
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, memberId string) {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			m, err := membersService.Get(groupId, memberId).Fields("deliverySettings").Context(ctx).Do()
+			if isNotFound(err) {
+				log.Printf("[DEBUG] Member %s of group %s was removed since it was listed", memberId, groupId)
+				return
+			}
+			if err != nil {
+				errs[i] = fmt.Errorf("error getting delivery settings of member %s: %w", memberId, err)
+				return
+			}
+
+			results[i] = m.DeliverySettings
+			if results[i] == "" {
+				results[i] = deliverySettingsDefault
+			}
+		}(i, member.Id)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
+
+func resourceGroupMembersCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// as for googleworkspace_group_member, memberships of groups created in the same apply are checked when
+	// Terraform plans them again during the apply
+	if !diff.NewValueKnown("group_id") || !diff.NewValueKnown("members") {
+		return nil
+	}
+
+	// every group remaining a member is checked, as for googleworkspace_group_member
+	var emails []string
+	for _, m := range diff.Get("members").(*schema.Set).List() {
+		member := m.(map[string]interface{})
+		if email := member["email"].(string); member["type"].(string) == "GROUP" && email != "" {
+			emails = append(emails, email)
+		}
+	}
+
+	if len(emails) == 0 {
+		return nil
+	}
+
+	groupId := diff.Get("group_id").(string)
+
+	// unchanged memberships are only added, as for googleworkspace_group_member
+	if !diff.HasChange("group_id") && !diff.HasChange("members") {
+		client := meta.(*apiClient)
+		for _, email := range emails {
+			client.groupMemberships.addMembership(groupId, email)
+		}
+
+		return nil
+	}
+
+	return checkGroupMembershipCycle(ctx, meta, groupId, emails...)
+}
+
+func memberMatchesAny(email string, patterns []*regexp.Regexp) bool {
+	for _, re := range patterns {
+		if re.MatchString(email) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -4,7 +4,10 @@
 package googleworkspace
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"strings"
 	"testing"
@@ -12,8 +15,48 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	directory "google.golang.org/api/admin/directory/v1"
+	"google.golang.org/api/option"
 )
 
+func TestGetMembersDeliverySettings_memberRemoved(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/groups/01abc/members/1"):
+			w.Write([]byte(`{"deliverySettings": "DIGEST"}`))
+		case strings.HasSuffix(r.URL.Path, "/groups/01abc/members/2"):
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error": {"code": 404, "message": "Resource Not Found: memberKey"}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer ts.Close()
+
+	directoryService, err := directory.NewService(context.Background(), option.WithHTTPClient(ts.Client()), option.WithEndpoint(ts.URL+"/"))
+	if err != nil {
+		t.Fatalf("unable to create the directory service: %s", err)
+	}
+
+	members := []*directory.Member{{Id: "1", Type: "USER"}, {Id: "2", Type: "USER"}, {Id: "3", Type: "CUSTOMER"}}
+	results, err := getMembersDeliverySettings(context.Background(), directoryService.Members, "01abc", members)
+	if err != nil {
+		t.Fatalf("a member removed since it was listed returned an error: %s", err)
+	}
+
+	expected := []string{"DIGEST", "", deliverySettingsDefault}
+	for i := range expected {
+		if results[i] != expected[i] {
+			t.Errorf("expected delivery settings %q, got %q", expected, results)
+			break
+		}
+	}
+}
+
 func TestAccResourceGroupMembers_basic(t *testing.T) {
 	t.Parallel()
 
@@ -160,8 +203,180 @@ func TestAccResourceGroupMembers_full(t *testing.T) {
 				}),
 			},
 			{
-				ResourceName: "googleworkspace_group_members.my-group-members",
-				ImportState:  true,
+				// delivery settings are imported as well
+				ResourceName:      "googleworkspace_group_members.my-group-members",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupMembers_importByEmail(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testGroupVals := map[string]interface{}{
+		"userEmail":  fmt.Sprintf("tf-test-%s@%s", acctest.RandString(10), domainName),
+		"groupEmail": fmt.Sprintf("tf-test-%s@%s", acctest.RandString(10), domainName),
+		"password":   acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceGroupMembers_importByEmail(testGroupVals),
+			},
+			{
+				ResourceName:      "googleworkspace_group_members.my-group-members",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("groups/%s", testGroupVals["groupEmail"]),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "googleworkspace_group_members.my-group-members",
+				ImportState:       true,
+				ImportStateId:     testGroupVals["groupEmail"].(string),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupMembers_deliverySettingsDrift(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testGroupVals := map[string]interface{}{
+		"userEmail":  fmt.Sprintf("tf-test-%s@%s", acctest.RandString(10), domainName),
+		"groupEmail": fmt.Sprintf("tf-test-%s@%s", acctest.RandString(10), domainName),
+		"password":   acctest.RandString(10),
+	}
+
+	// changes the delivery settings of the member, as in the UI
+	disableDelivery := func() {
+		client, err := googleworkspaceTestClient()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		directoryService, diags := client.NewDirectoryService()
+		if diags.HasError() {
+			t.Fatal(diags[0].Summary)
+		}
+
+		membersService, diags := GetMembersService(directoryService)
+		if diags.HasError() {
+			t.Fatal(diags[0].Summary)
+		}
+
+		_, err = membersService.Patch(testGroupVals["groupEmail"].(string), testGroupVals["userEmail"].(string), &directory.Member{
+			DeliverySettings: "DISABLED",
+		}).Do()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceGroupMembers_importByEmail(testGroupVals),
+			},
+			{
+				// the changed delivery settings are detected as drift and applied again
+				PreConfig: disableDelivery,
+				Config:    testAccResourceGroupMembers_importByEmail(testGroupVals),
+				Check: resource.TestCheckTypeSetElemNestedAttrs("googleworkspace_group_members.my-group-members", "members.*",
+					map[string]string{
+						"email":             testGroupVals["userEmail"].(string),
+						"delivery_settings": "DIGEST",
+					}),
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupMembers_nonAuthoritative(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testGroupVals := map[string]interface{}{
+		"userEmail1": fmt.Sprintf("tf-test-%s@%s", acctest.RandString(10), domainName),
+		"userEmail2": fmt.Sprintf("tf-test-%s@%s", acctest.RandString(10), domainName),
+		"groupEmail": fmt.Sprintf("tf-test-%s@%s", acctest.RandString(10), domainName),
+		"password":   acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// the member managed by googleworkspace_group_member must not show up as drift
+				Config: testAccResourceGroupMembers_nonAuthoritative(testGroupVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_group_members.my-group-members", "mode", "NON_AUTHORITATIVE"),
+					resource.TestCheckResourceAttr("googleworkspace_group_members.my-group-members", "members.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("googleworkspace_group_members.my-group-members", "members.*",
+						map[string]string{
+							"email": testGroupVals["userEmail1"].(string),
+						}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupMembers_ignoreMembers(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testGroupVals := map[string]interface{}{
+		"userEmail1": fmt.Sprintf("tf-test-%s@%s", acctest.RandString(10), domainName),
+		"userEmail2": fmt.Sprintf("tf-test-scim-%s@%s", acctest.RandString(10), domainName),
+		"groupEmail": fmt.Sprintf("tf-test-%s@%s", acctest.RandString(10), domainName),
+		"password":   acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// the member matching the ignore pattern must not show up as drift
+				Config: testAccResourceGroupMembers_ignoreMembers(testGroupVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_group_members.my-group-members", "members.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("googleworkspace_group_members.my-group-members", "members.*",
+						map[string]string{
+							"email": testGroupVals["userEmail1"].(string),
+						}),
+				),
 			},
 		},
 	})
@@ -377,3 +592,118 @@ resource "googleworkspace_group_members" "my-group-members" {
 }
 `, testGroupVals)
 }
+
+func testAccResourceGroupMembers_importByEmail(testGroupVals map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_group" "my-group" {
+  email = "%{groupEmail}"
+}
+
+resource "googleworkspace_user" "my-new-user" {
+  primary_email = "%{userEmail}"
+  password = "%{password}"
+
+  name {
+    family_name = "Scott"
+    given_name = "Michael"
+  }
+}
+
+resource "googleworkspace_group_members" "my-group-members" {
+  group_id = googleworkspace_group.my-group.email
+
+	members {
+		email = googleworkspace_user.my-new-user.primary_email
+		delivery_settings = "DIGEST"
+	}
+}
+`, testGroupVals)
+}
+
+func testAccResourceGroupMembers_nonAuthoritative(testGroupVals map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_group" "my-group" {
+  email = "%{groupEmail}"
+}
+
+resource "googleworkspace_user" "my-new-user1" {
+  primary_email = "%{userEmail1}"
+  password = "%{password}"
+
+  name {
+    family_name = "Scott"
+    given_name = "Michael"
+  }
+}
+
+resource "googleworkspace_user" "my-new-user2" {
+  primary_email = "%{userEmail2}"
+  password = "%{password}"
+
+  name {
+    family_name = "Scott"
+    given_name = "Michael"
+  }
+}
+
+resource "googleworkspace_group_member" "my-group-member" {
+  group_id = googleworkspace_group.my-group.id
+  email    = googleworkspace_user.my-new-user2.primary_email
+}
+
+resource "googleworkspace_group_members" "my-group-members" {
+  group_id = googleworkspace_group.my-group.id
+  mode     = "NON_AUTHORITATIVE"
+
+	members {
+		email = googleworkspace_user.my-new-user1.primary_email
+	}
+
+	depends_on = [googleworkspace_group_member.my-group-member]
+}
+`, testGroupVals)
+}
+
+func testAccResourceGroupMembers_ignoreMembers(testGroupVals map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_group" "my-group" {
+  email = "%{groupEmail}"
+}
+
+resource "googleworkspace_user" "my-new-user1" {
+  primary_email = "%{userEmail1}"
+  password = "%{password}"
+
+  name {
+    family_name = "Scott"
+    given_name = "Michael"
+  }
+}
+
+resource "googleworkspace_user" "my-new-user2" {
+  primary_email = "%{userEmail2}"
+  password = "%{password}"
+
+  name {
+    family_name = "Scott"
+    given_name = "Michael"
+  }
+}
+
+resource "googleworkspace_group_member" "my-group-member" {
+  group_id = googleworkspace_group.my-group.id
+  email    = googleworkspace_user.my-new-user2.primary_email
+}
+
+resource "googleworkspace_group_members" "my-group-members" {
+  group_id       = googleworkspace_group.my-group.id
+  ignore_members = ["^tf-test-scim-"]
+
+	members {
+		email = googleworkspace_user.my-new-user1.primary_email
+	}
+
+	depends_on = [googleworkspace_group_member.my-group-member]
+}
+`, testGroupVals)
+}
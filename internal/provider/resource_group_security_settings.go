@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"google.golang.org/api/cloudidentity/v1"
+)
+
+func resourceGroupSecuritySettings() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Group Security Settings resource manages the Cloud Identity security settings of a Google Workspace " +
+			"group, such as the member restriction. Group Security Settings requires the " +
+			"`https://www.googleapis.com/auth/cloud-identity.groups` client scope.",
+
+		CreateContext: resourceGroupSecuritySettingsCreate,
+		ReadContext:   resourceGroupSecuritySettingsRead,
+		UpdateContext: resourceGroupSecuritySettingsUpdate,
+		DeleteContext: resourceGroupSecuritySettingsDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Description: "The unique ID of the group.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"member_restriction_query": {
+				Description: "A CEL expression that restricts which users and groups can be members of the group, " +
+					"for example `member.type == 1 || member.customer_id == 'C01234567'`. Member types are " +
+					"`1` for users, `2` for service accounts and `3` for groups. An empty query removes the restriction.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: diffSuppressMemberRestrictionQuery,
+			},
+			"member_restriction_evaluation_state": {
+				Description: "Whether the group's current members comply with the member restriction. Possible values are: " +
+					"\n\t- `EVALUATING`: The restriction is being evaluated. " +
+					"\n\t- `COMPLIANT`: All members comply with the restriction. " +
+					"\n\t- `FORWARD_COMPLIANT`: Some existing members do not comply with the restriction, " +
+					"but new members will be restricted. " +
+					"\n\t- `NON_COMPLIANT`: Some members do not comply with the restriction.",
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// Adding a computed id simply to override the `optional` id that gets added in the SDK
+			// that will then display improperly in the docs
+			"id": {
+				Description: "The ID of this resource.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceGroupSecuritySettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	groupId := d.Get("group_id").(string)
+	log.Printf("[DEBUG] Creating Group Security Settings %q", groupId)
+
+	err := updateGroupSecuritySettings(ctx, meta, groupId, d.Get("member_restriction_query").(string), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	d.SetId(groupId)
+
+	log.Printf("[DEBUG] Finished creating Group Security Settings %q", d.Id())
+
+	return resourceGroupSecuritySettingsRead(ctx, d, meta)
+}
+
+func resourceGroupSecuritySettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// use the meta value to retrieve your client from the provider configure method
+	client := meta.(*apiClient)
+
+	cloudIdentityService, diags := client.NewCloudIdentityService()
+	if diags.HasError() {
+		return diags
+	}
+
+	groupsService, diags := GetCloudIdentityGroupsService(cloudIdentityService)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Getting Group Security Settings %q", d.Id())
+
+	securitySettings, err := groupsService.GetSecuritySettings(groupSecuritySettingsName(d.Id())).Do()
+	if err != nil {
+		return handleReadNotFoundError(err, d, d.Id())
+	}
+
+	if securitySettings == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("No security settings were returned for group %s.", d.Id()),
+		})
+
+		return diags
+	}
+
+	query := ""
+	evaluationState := ""
+	if securitySettings.MemberRestriction != nil {
+		query = securitySettings.MemberRestriction.Query
+
+		if securitySettings.MemberRestriction.Evaluation != nil {
+			evaluationState = securitySettings.MemberRestriction.Evaluation.State
+		}
+	}
+
+	d.Set("group_id", d.Id())
+	d.Set("member_restriction_query", query)
+	d.Set("member_restriction_evaluation_state", evaluationState)
+
+	log.Printf("[DEBUG] Finished getting Group Security Settings %q", d.Id())
+
+	return diags
+}
+
+func resourceGroupSecuritySettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Updating Group Security Settings %q", d.Id())
+
+	if d.HasChange("member_restriction_query") {
+		err := updateGroupSecuritySettings(ctx, meta, d.Id(), d.Get("member_restriction_query").(string), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return apiErrorDiagnostics(err)
+		}
+	}
+
+	log.Printf("[DEBUG] Finished updating Group Security Settings %q", d.Id())
+
+	return resourceGroupSecuritySettingsRead(ctx, d, meta)
+}
+
+func resourceGroupSecuritySettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Removing member restriction from Group Security Settings %q", d.Id())
+
+	// Security settings can't be deleted, so destroying the resource lifts the member restriction,
+	// which is already gone along with the group
+	err := updateGroupSecuritySettings(ctx, meta, d.Id(), "", d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, d.Id())
+	}
+
+	log.Printf("[DEBUG] Finished removing member restriction from Group Security Settings %q", d.Id())
+
+	return nil
+}
+
+// updateGroupSecuritySettings sets the member restriction and waits for the operation updating it to be done
+func updateGroupSecuritySettings(ctx context.Context, meta interface{}, groupId, query string, timeout time.Duration) error {
+	// use the meta value to retrieve your client from the provider configure method
+	client := meta.(*apiClient)
+
+	cloudIdentityService, diags := client.NewCloudIdentityService()
+	if diags.HasError() {
+		return fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	groupsService, diags := GetCloudIdentityGroupsService(cloudIdentityService)
+	if diags.HasError() {
+		return fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	securitySettingsObj := cloudidentity.SecuritySettings{
+		MemberRestriction: &cloudidentity.MemberRestriction{
+			Query:           query,
+			ForceSendFields: []string{"Query"},
+		},
+	}
+
+	op, err := groupsService.UpdateSecuritySettings(groupSecuritySettingsName(groupId), &securitySettingsObj).
+		UpdateMask("member_restriction.query").Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	if !op.Done {
+		// the generated client has no operations service, so the settings are read until they're updated
+		err := retryTimeDuration(ctx, timeout, func() error {
+			securitySettings, retryErr := groupsService.GetSecuritySettings(groupSecuritySettingsName(groupId)).Context(ctx).Do()
+			if retryErr != nil {
+				return retryErr
+			}
+
+			current := ""
+			if securitySettings.MemberRestriction != nil {
+				current = securitySettings.MemberRestriction.Query
+			}
+
+			if normalizeMemberRestrictionQuery(current) != normalizeMemberRestrictionQuery(query) {
+				return fmt.Errorf("timed out while waiting for the security settings of group %s to be updated", groupId)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("error waiting for the security settings of group %s to be updated: %w", groupId, err)
+		}
+
+		return nil
+	}
+
+	if op.Error != nil {
+		return fmt.Errorf("operation %s failed with code %d: %s", op.Name, op.Error.Code, op.Error.Message)
+	}
+
+	return nil
+}
+
+// normalizeMemberRestrictionQuery leaves out the whitespace and quoting style of a query, which the API may
+// change when storing it
+func normalizeMemberRestrictionQuery(query string) string {
+	return strings.ReplaceAll(strings.Join(strings.Fields(query), ""), `"`, "'")
+}
+
+func diffSuppressMemberRestrictionQuery(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeMemberRestrictionQuery(old) == normalizeMemberRestrictionQuery(new)
+}
+
+func groupSecuritySettingsName(groupId string) string {
+	return fmt.Sprintf("groups/%s/securitySettings", groupId)
+}
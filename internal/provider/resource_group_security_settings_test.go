@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceGroupSecuritySettings_basic(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testGroupVals := map[string]interface{}{
+		"domainName": domainName,
+		"email":      fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"customerId": getTestCustomerFromEnv(),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceGroupSecuritySettings_basic(testGroupVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_group_security_settings.my-group-security-settings",
+						"member_restriction_query", "member.type == 1"),
+				),
+			},
+			{
+				ResourceName:      "googleworkspace_group_security_settings.my-group-security-settings",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the evaluation of the restriction may change in the background
+				ImportStateVerifyIgnore: []string{"member_restriction_evaluation_state"},
+			},
+			{
+				Config: testAccResourceGroupSecuritySettings_update(testGroupVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_group_security_settings.my-group-security-settings",
+						"member_restriction_query", fmt.Sprintf("member.customer_id == '%s'", testGroupVals["customerId"])),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceGroupSecuritySettings_basic(testGroupVals map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_group" "my-group" {
+  email = "%{email}@%{domainName}"
+}
+
+resource "googleworkspace_group_security_settings" "my-group-security-settings" {
+  group_id = googleworkspace_group.my-group.id
+
+  member_restriction_query = "member.type == 1"
+}
+`, testGroupVals)
+}
+
+func testAccResourceGroupSecuritySettings_update(testGroupVals map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_group" "my-group" {
+  email = "%{email}@%{domainName}"
+}
+
+resource "googleworkspace_group_security_settings" "my-group-security-settings" {
+  group_id = googleworkspace_group.my-group.id
+
+  member_restriction_query = "member.customer_id == '%{customerId}'"
+}
+`, testGroupVals)
+}
+
+func TestNormalizeMemberRestrictionQuery(t *testing.T) {
+	cases := map[string]struct {
+		old, new string
+		same     bool
+	}{
+		"whitespace":      {old: "member.type == 1", new: "member.type==1", same: true},
+		"quotes":          {old: `member.customer_id == "C01234567"`, new: "member.customer_id == 'C01234567'", same: true},
+		"different query": {old: "member.type == 1", new: "member.type == 3", same: false},
+	}
+
+	for name, tc := range cases {
+		if same := normalizeMemberRestrictionQuery(tc.old) == normalizeMemberRestrictionQuery(tc.new); same != tc.same {
+			t.Errorf("%s: expected %q and %q to be the same query: %t", name, tc.old, tc.new, tc.same)
+		}
+	}
+}
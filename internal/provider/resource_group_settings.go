@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -29,6 +30,8 @@ func resourceGroupSettings() *schema.Resource {
 		UpdateContext: resourceGroupSettingsUpdate,
 		DeleteContext: resourceGroupSettingsDelete,
 
+		CustomizeDiff: resourceGroupSettingsCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
@@ -321,6 +324,90 @@ func resourceGroupSettings() *schema.Resource {
 				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"ALL_MEMBERS",
 					"OWNERS_AND_MANAGERS", "MANAGERS_ONLY", "OWNERS_ONLY", "NONE"}, true)),
 			},
+			"who_can_ban_users": {
+				Description: "Specifies who can deny membership to users. This permission will be deprecated once it is merged " +
+					"into the `who_can_moderate_members` setting. " +
+					"Possible values are: " +
+					"\n\t- `ALL_MEMBERS`" +
+					"\n\t- `OWNERS_AND_MANAGERS`" +
+					"\n\t- `OWNERS_ONLY`" +
+					"\n\t- `NONE`",
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"ALL_MEMBERS",
+					"OWNERS_AND_MANAGERS", "OWNERS_ONLY", "NONE"}, true)),
+			},
+			"who_can_delete_any_post": {
+				Description: "Specifies who can delete replies to topics. (Authors can always delete their own posts.) This " +
+					"permission will be deprecated once it is merged into the `who_can_moderate_content` setting. " +
+					"Possible values are: " +
+					"\n\t- `ALL_MEMBERS`" +
+					"\n\t- `OWNERS_AND_MANAGERS`" +
+					"\n\t- `OWNERS_ONLY`" +
+					"\n\t- `NONE`",
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"ALL_MEMBERS",
+					"OWNERS_AND_MANAGERS", "OWNERS_ONLY", "NONE"}, true)),
+			},
+			"who_can_delete_topics": {
+				Description: "Specifies who can delete topics. This permission will be deprecated once it is merged into the " +
+					"`who_can_moderate_content` setting. " +
+					"Possible values are: " +
+					"\n\t- `ALL_MEMBERS`" +
+					"\n\t- `OWNERS_AND_MANAGERS`" +
+					"\n\t- `OWNERS_ONLY`" +
+					"\n\t- `NONE`",
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"ALL_MEMBERS",
+					"OWNERS_AND_MANAGERS", "OWNERS_ONLY", "NONE"}, true)),
+			},
+			"who_can_lock_topics": {
+				Description: "Specifies who can prevent users from posting replies to topics. This permission will be deprecated " +
+					"once it is merged into the `who_can_moderate_content` setting. " +
+					"Possible values are: " +
+					"\n\t- `ALL_MEMBERS`" +
+					"\n\t- `OWNERS_AND_MANAGERS`" +
+					"\n\t- `OWNERS_ONLY`" +
+					"\n\t- `NONE`",
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"ALL_MEMBERS",
+					"OWNERS_AND_MANAGERS", "OWNERS_ONLY", "NONE"}, true)),
+			},
+			"who_can_move_topics_in": {
+				Description: "Specifies who can move topics into the group or forum. This permission will be deprecated once it is " +
+					"merged into the `who_can_moderate_content` setting. " +
+					"Possible values are: " +
+					"\n\t- `ALL_MEMBERS`" +
+					"\n\t- `OWNERS_AND_MANAGERS`" +
+					"\n\t- `OWNERS_ONLY`" +
+					"\n\t- `NONE`",
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"ALL_MEMBERS",
+					"OWNERS_AND_MANAGERS", "OWNERS_ONLY", "NONE"}, true)),
+			},
+			"who_can_move_topics_out": {
+				Description: "Specifies who can move topics out of the group or forum. This permission will be deprecated once it " +
+					"is merged into the `who_can_moderate_content` setting. " +
+					"Possible values are: " +
+					"\n\t- `ALL_MEMBERS`" +
+					"\n\t- `OWNERS_AND_MANAGERS`" +
+					"\n\t- `OWNERS_ONLY`" +
+					"\n\t- `NONE`",
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"ALL_MEMBERS",
+					"OWNERS_AND_MANAGERS", "OWNERS_ONLY", "NONE"}, true)),
+			},
 			"custom_roles_enabled_for_settings_to_be_merged": {
 				Description: "Specifies whether the group has a custom role that's included in one of the settings " +
 					"being merged.",
@@ -333,6 +420,15 @@ func resourceGroupSettings() *schema.Resource {
 				Optional:    true,
 				Default:     false,
 			},
+			"default_sender": {
+				Description: "Default sender for members who can post messages as the group. Possible values are: " +
+					"\n\t- `DEFAULT_SELF`: By default messages will be sent from the user. " +
+					"\n\t- `GROUP`: By default messages will be sent from the group.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"DEFAULT_SELF", "GROUP"}, true)),
+			},
 			"who_can_discover_group": {
 				Description: "Specifies the set of users for whom this group is discoverable. Possible values are: " +
 					"\n\t- `ANYONE_CAN_DISCOVER`" +
@@ -344,6 +440,14 @@ func resourceGroupSettings() *schema.Resource {
 				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"ANYONE_CAN_DISCOVER",
 					"ALL_IN_DOMAIN_CAN_DISCOVER", "ALL_MEMBERS_CAN_DISCOVER"}, true)),
 			},
+			"restore_defaults_on_destroy": {
+				Description: "If true, the group's settings are restored to Google's documented defaults when this " +
+					"resource is destroyed. If false, destroying this resource only removes it from the Terraform state " +
+					"and the group keeps its last applied settings.",
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			// Adding a computed id simply to override the `optional` id that gets added in the SDK
 			// that will then display improperly in the docs
 			"id": {
@@ -355,6 +459,40 @@ func resourceGroupSettings() *schema.Resource {
 	}
 }
 
+// resourceGroupSettingsCustomizeDiff checks the settings that depend on each other, which the API otherwise
+// rejects with obscure errors mid-apply
+func resourceGroupSettingsCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.NewValueKnown("reply_to") && diff.NewValueKnown("custom_reply_to") &&
+		strings.EqualFold(diff.Get("reply_to").(string), "REPLY_TO_CUSTOM") && diff.Get("custom_reply_to").(string) == "" {
+		return fmt.Errorf("`custom_reply_to` must be set when `reply_to` is REPLY_TO_CUSTOM")
+	}
+
+	// who_can_post_message is computed, so only a configured value is checked against archive_only
+	rawConfig := diff.GetRawConfig()
+	if !rawConfig.IsNull() && rawConfig.IsKnown() && diff.NewValueKnown("archive_only") {
+		whoCanPostMessage := rawConfig.GetAttr("who_can_post_message")
+		if !whoCanPostMessage.IsNull() && whoCanPostMessage.IsKnown() {
+			noneCanPost := strings.EqualFold(whoCanPostMessage.AsString(), "NONE_CAN_POST")
+			archiveOnly := diff.Get("archive_only").(bool)
+
+			if archiveOnly && !noneCanPost {
+				return fmt.Errorf("`who_can_post_message` must be NONE_CAN_POST when `archive_only` is true")
+			}
+
+			if !archiveOnly && noneCanPost {
+				return fmt.Errorf("`who_can_post_message` can only be NONE_CAN_POST when `archive_only` is true")
+			}
+		}
+	}
+
+	if diff.NewValueKnown("default_message_deny_notification_text") && diff.NewValueKnown("send_message_deny_notification") &&
+		diff.Get("default_message_deny_notification_text").(string) != "" && !diff.Get("send_message_deny_notification").(bool) {
+		return fmt.Errorf("`default_message_deny_notification_text` requires `send_message_deny_notification` to be true")
+	}
+
+	return nil
+}
+
 func resourceGroupSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -405,15 +543,35 @@ func resourceGroupSettingsCreate(ctx context.Context, d *schema.ResourceData, me
 		CustomRolesEnabledForSettingsToBeMerged: strconv.FormatBool(d.Get("custom_roles_enabled_for_settings_to_be_merged").(bool)),
 		EnableCollaborativeInbox:                strconv.FormatBool(d.Get("enable_collaborative_inbox").(bool)),
 		WhoCanDiscoverGroup:                     d.Get("who_can_discover_group").(string),
+		DefaultSender:                           d.Get("default_sender").(string),
+		WhoCanBanUsers:                          d.Get("who_can_ban_users").(string),
+		WhoCanDeleteAnyPost:                     d.Get("who_can_delete_any_post").(string),
+		WhoCanDeleteTopics:                      d.Get("who_can_delete_topics").(string),
+		WhoCanLockTopics:                        d.Get("who_can_lock_topics").(string),
+		WhoCanMoveTopicsIn:                      d.Get("who_can_move_topics_in").(string),
+		WhoCanMoveTopicsOut:                     d.Get("who_can_move_topics_out").(string),
 
 		ForceSendFields: []string{"AllowExternalMembers", "AllowWebPosting", "IsArchived", "ArchiveOnly",
 			"IncludeCustomFooter", "SendMessageDenyNotification", "MembersCanPostAsTheGroup", "IncludeInGlobalAddressList",
 			"CustomRolesEnabledForSettingsToBeMerged", "EnableCollaborativeInbox"},
 	}
 
+	// the group may have been created in the same apply, and not be visible to the Groups Settings API yet
+	err := retryTimeDuration(ctx, d.Timeout(schema.TimeoutCreate), func() error {
+		_, retryErr := groupsService.Get(email).Fields("email").Do()
+		if isNotFound(retryErr) {
+			return fmt.Errorf("timed out while waiting for group %s to exist", email)
+		}
+
+		return retryErr
+	})
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
 	groupSettings, err := groupsService.Update(email, &groupSettingsObj).Do()
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	d.SetId(groupSettings.Email)
@@ -423,7 +581,7 @@ func resourceGroupSettingsCreate(ctx context.Context, d *schema.ResourceData, me
 		timeout:      d.Timeout(schema.TimeoutCreate),
 		resourceType: "group_settings",
 	}
-	err = retryTimeDuration(ctx, d.Timeout(schema.TimeoutCreate), func() error {
+	err = retryConsistencyCheck(ctx, d.Timeout(schema.TimeoutCreate), func() error {
 		if cc.reachedConsistency(numInserts) {
 			return nil
 		}
@@ -444,7 +602,7 @@ func resourceGroupSettingsCreate(ctx context.Context, d *schema.ResourceData, me
 	})
 
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	log.Printf("[DEBUG] Finished creating Group Settings %q: %#v", d.Id(), email)
@@ -453,108 +611,60 @@ func resourceGroupSettingsCreate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceGroupSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
+	id := d.Id()
 
-	// use the meta value to retrieve your client from the provider configure method
-	client := meta.(*apiClient)
-
-	groupsSettingsService, diags := client.NewGroupsSettingsService()
+	diags := readGroupSettings(ctx, d, meta, true)
 	if diags.HasError() {
 		return diags
 	}
 
-	groupsService, diags := GetGroupsSettingsService(groupsSettingsService)
-	if diags.HasError() {
-		return diags
+	if d.Id() == "" {
+		return append(diags, removedFromStateWarning(id))
 	}
 
-	group, err := groupsService.Get(d.Id()).Do()
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	// Convert strings to bools
-	allowExternalMembers, err := strconv.ParseBool(group.AllowExternalMembers)
-	if err != nil {
-		return diag.FromErr(err)
+	// restore_defaults_on_destroy is not returned by the API, default it for imported settings
+	if _, ok := d.GetOk("restore_defaults_on_destroy"); !ok {
+		d.Set("restore_defaults_on_destroy", false)
 	}
 
-	allowWebPosting, err := strconv.ParseBool(group.AllowWebPosting)
-	if err != nil {
-		return diag.FromErr(err)
-	}
+	return diags
+}
 
-	isArchived, err := strconv.ParseBool(group.IsArchived)
-	if err != nil {
-		return diag.FromErr(err)
-	}
+// readGroupSettings reads the settings returned by the API, shared by the resource and the data source.
+// A group that isn't found clears the ID if removeIfNotFound is set, and is an error otherwise.
+func readGroupSettings(ctx context.Context, d *schema.ResourceData, meta interface{}, removeIfNotFound bool) diag.Diagnostics {
+	var diags diag.Diagnostics
 
-	archiveOnly, err := strconv.ParseBool(group.ArchiveOnly)
-	if err != nil {
-		return diag.FromErr(err)
-	}
+	// use the meta value to retrieve your client from the provider configure method
+	client := meta.(*apiClient)
 
-	includeCustomFooter, err := strconv.ParseBool(group.IncludeCustomFooter)
-	if err != nil {
-		return diag.FromErr(err)
+	groupsSettingsService, diags := client.NewGroupsSettingsService()
+	if diags.HasError() {
+		return diags
 	}
 
-	sendMessageDenyNotification, err := strconv.ParseBool(group.SendMessageDenyNotification)
-	if err != nil {
-		return diag.FromErr(err)
+	groupsService, diags := GetGroupsSettingsService(groupsSettingsService)
+	if diags.HasError() {
+		return diags
 	}
 
-	membersCanPostAsTheGroup, err := strconv.ParseBool(group.MembersCanPostAsTheGroup)
-	if err != nil {
-		return diag.FromErr(err)
+	group, err := groupsService.Get(d.Id()).Do()
+	if err != nil && removeIfNotFound {
+		return handleNotFoundError(err, d, d.Id())
 	}
-
-	includeInGlobalAddressList, err := strconv.ParseBool(group.IncludeInGlobalAddressList)
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
-	customRolesEnabledForSettingsToBeMerged, err := strconv.ParseBool(group.CustomRolesEnabledForSettingsToBeMerged)
+	settings, err := flattenGroupSettings(group)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	enableCollaborativeInbox, err := strconv.ParseBool(group.EnableCollaborativeInbox)
-	if err != nil {
-		return diag.FromErr(err)
+	for k, v := range settings {
+		d.Set(k, v)
 	}
 
-	d.Set("email", group.Email)
-	d.Set("name", group.Name)
-	d.Set("description", group.Description)
-	d.Set("who_can_join", group.WhoCanJoin)
-	d.Set("who_can_view_membership", group.WhoCanViewMembership)
-	d.Set("who_can_view_group", group.WhoCanViewGroup)
-	d.Set("allow_external_members", allowExternalMembers)
-	d.Set("who_can_post_message", group.WhoCanPostMessage)
-	d.Set("allow_web_posting", allowWebPosting)
-	d.Set("primary_language", group.PrimaryLanguage)
-	d.Set("is_archived", isArchived)
-	d.Set("archive_only", archiveOnly)
-	d.Set("message_moderation_level", group.MessageModerationLevel)
-	d.Set("spam_moderation_level", group.SpamModerationLevel)
-	d.Set("reply_to", group.ReplyTo)
-	d.Set("custom_reply_to", group.CustomReplyTo)
-	d.Set("include_custom_footer", includeCustomFooter)
-	d.Set("custom_footer_text", group.CustomFooterText)
-	d.Set("send_message_deny_notification", sendMessageDenyNotification)
-	d.Set("default_message_deny_notification_text", group.DefaultMessageDenyNotificationText)
-	d.Set("members_can_post_as_the_group", membersCanPostAsTheGroup)
-	d.Set("include_in_global_address_list", includeInGlobalAddressList)
-	d.Set("who_can_leave_group", group.WhoCanLeaveGroup)
-	d.Set("who_can_contact_owner", group.WhoCanContactOwner)
-	d.Set("who_can_moderate_members", group.WhoCanModerateMembers)
-	d.Set("who_can_moderate_content", group.WhoCanModerateContent)
-	d.Set("who_can_assist_content", group.WhoCanAssistContent)
-	d.Set("custom_roles_enabled_for_settings_to_be_merged", customRolesEnabledForSettingsToBeMerged)
-	d.Set("enable_collaborative_inbox", enableCollaborativeInbox)
-	d.Set("who_can_discover_group", group.WhoCanDiscoverGroup)
-
 	d.SetId(group.Email)
 
 	return diags
@@ -696,13 +806,41 @@ func resourceGroupSettingsUpdate(ctx context.Context, d *schema.ResourceData, me
 		groupSettingsObj.WhoCanDiscoverGroup = d.Get("who_can_discover_group").(string)
 	}
 
+	if d.HasChange("default_sender") {
+		groupSettingsObj.DefaultSender = d.Get("default_sender").(string)
+	}
+
+	if d.HasChange("who_can_ban_users") {
+		groupSettingsObj.WhoCanBanUsers = d.Get("who_can_ban_users").(string)
+	}
+
+	if d.HasChange("who_can_delete_any_post") {
+		groupSettingsObj.WhoCanDeleteAnyPost = d.Get("who_can_delete_any_post").(string)
+	}
+
+	if d.HasChange("who_can_delete_topics") {
+		groupSettingsObj.WhoCanDeleteTopics = d.Get("who_can_delete_topics").(string)
+	}
+
+	if d.HasChange("who_can_lock_topics") {
+		groupSettingsObj.WhoCanLockTopics = d.Get("who_can_lock_topics").(string)
+	}
+
+	if d.HasChange("who_can_move_topics_in") {
+		groupSettingsObj.WhoCanMoveTopicsIn = d.Get("who_can_move_topics_in").(string)
+	}
+
+	if d.HasChange("who_can_move_topics_out") {
+		groupSettingsObj.WhoCanMoveTopicsOut = d.Get("who_can_move_topics_out").(string)
+	}
+
 	if len(forceSendFields) > 0 {
 		groupSettingsObj.ForceSendFields = forceSendFields
 	}
 
 	groupSettings, err := groupsService.Update(email, &groupSettingsObj).Do()
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	d.SetId(groupSettings.Email)
@@ -712,7 +850,7 @@ func resourceGroupSettingsUpdate(ctx context.Context, d *schema.ResourceData, me
 		timeout:      d.Timeout(schema.TimeoutUpdate),
 		resourceType: "group_settings",
 	}
-	err = retryTimeDuration(ctx, d.Timeout(schema.TimeoutUpdate), func() error {
+	err = retryConsistencyCheck(ctx, d.Timeout(schema.TimeoutUpdate), func() error {
 		if cc.reachedConsistency(numInserts) {
 			return nil
 		}
@@ -730,7 +868,7 @@ func resourceGroupSettingsUpdate(ctx context.Context, d *schema.ResourceData, me
 	})
 
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	log.Printf("[DEBUG] Finished updating Group Settings %q: %#v", d.Id(), email)
@@ -739,10 +877,139 @@ func resourceGroupSettingsUpdate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceGroupSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !d.Get("restore_defaults_on_destroy").(bool) {
+		log.Printf("[DEBUG] Removing Group Settings from state for %q", d.Id())
 
-	log.Printf("[DEBUG] Removing Group Settings from state for %q", d.Id())
+		d.SetId("")
+
+		return nil
+	}
+
+	// use the meta value to retrieve your client from the provider configure method
+	client := meta.(*apiClient)
+
+	log.Printf("[DEBUG] Restoring default Group Settings for %q", d.Id())
+
+	groupsSettingsService, diags := client.NewGroupsSettingsService()
+	if diags.HasError() {
+		return diags
+	}
+
+	groupsService, diags := GetGroupsSettingsService(groupsSettingsService)
+	if diags.HasError() {
+		return diags
+	}
+
+	_, err := groupsService.Update(d.Id(), defaultGroupSettings()).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, d.Id())
+	}
+
+	log.Printf("[DEBUG] Finished restoring default Group Settings for %q", d.Id())
 
 	d.SetId("")
 
-	return nil
+	return diags
+}
+
+// defaultGroupSettings returns the settings Google documents as the defaults for a newly
+// created group. These match the defaults of the resource schema.
+func defaultGroupSettings() *groupssettings.Groups {
+	return &groupssettings.Groups{
+		WhoCanJoin:                         "CAN_REQUEST_TO_JOIN",
+		WhoCanViewMembership:               "ALL_MEMBERS_CAN_VIEW",
+		WhoCanViewGroup:                    "ALL_MEMBERS_CAN_VIEW",
+		AllowExternalMembers:               "false",
+		AllowWebPosting:                    "true",
+		IsArchived:                         "false",
+		ArchiveOnly:                        "false",
+		MessageModerationLevel:             "MODERATE_NONE",
+		SpamModerationLevel:                "MODERATE",
+		ReplyTo:                            "REPLY_TO_IGNORE",
+		CustomReplyTo:                      "",
+		IncludeCustomFooter:                "false",
+		CustomFooterText:                   "",
+		SendMessageDenyNotification:        "false",
+		DefaultMessageDenyNotificationText: "",
+		MembersCanPostAsTheGroup:           "false",
+		IncludeInGlobalAddressList:         "true",
+		WhoCanLeaveGroup:                   "ALL_MEMBERS_CAN_LEAVE",
+		WhoCanContactOwner:                 "ANYONE_CAN_CONTACT",
+		WhoCanModerateMembers:              "OWNERS_AND_MANAGERS",
+		WhoCanModerateContent:              "OWNERS_AND_MANAGERS",
+		WhoCanAssistContent:                "NONE",
+		EnableCollaborativeInbox:           "false",
+		WhoCanDiscoverGroup:                "ALL_IN_DOMAIN_CAN_DISCOVER",
+		DefaultSender:                      "DEFAULT_SELF",
+		WhoCanBanUsers:                     "OWNERS_AND_MANAGERS",
+		WhoCanDeleteAnyPost:                "OWNERS_AND_MANAGERS",
+		WhoCanDeleteTopics:                 "OWNERS_AND_MANAGERS",
+		WhoCanLockTopics:                   "OWNERS_AND_MANAGERS",
+		WhoCanMoveTopicsIn:                 "OWNERS_AND_MANAGERS",
+		WhoCanMoveTopicsOut:                "OWNERS_AND_MANAGERS",
+
+		ForceSendFields: []string{"AllowExternalMembers", "AllowWebPosting", "IsArchived", "ArchiveOnly",
+			"IncludeCustomFooter", "SendMessageDenyNotification", "MembersCanPostAsTheGroup", "IncludeInGlobalAddressList",
+			"EnableCollaborativeInbox", "CustomReplyTo", "CustomFooterText", "DefaultMessageDenyNotificationText"},
+	}
+}
+
+// flattenGroupSettings converts the settings of a group to their schema values, the API
+// returns booleans as strings
+func flattenGroupSettings(group *groupssettings.Groups) (map[string]interface{}, error) {
+	boolSettings := map[string]string{
+		"allow_external_members":                         group.AllowExternalMembers,
+		"allow_web_posting":                              group.AllowWebPosting,
+		"is_archived":                                    group.IsArchived,
+		"archive_only":                                   group.ArchiveOnly,
+		"include_custom_footer":                          group.IncludeCustomFooter,
+		"send_message_deny_notification":                 group.SendMessageDenyNotification,
+		"members_can_post_as_the_group":                  group.MembersCanPostAsTheGroup,
+		"include_in_global_address_list":                 group.IncludeInGlobalAddressList,
+		"custom_roles_enabled_for_settings_to_be_merged": group.CustomRolesEnabledForSettingsToBeMerged,
+		"enable_collaborative_inbox":                     group.EnableCollaborativeInbox,
+	}
+
+	result := map[string]interface{}{
+		"email":                                  group.Email,
+		"name":                                   group.Name,
+		"description":                            group.Description,
+		"who_can_join":                           group.WhoCanJoin,
+		"who_can_view_membership":                group.WhoCanViewMembership,
+		"who_can_view_group":                     group.WhoCanViewGroup,
+		"who_can_post_message":                   group.WhoCanPostMessage,
+		"primary_language":                       group.PrimaryLanguage,
+		"message_moderation_level":               group.MessageModerationLevel,
+		"spam_moderation_level":                  group.SpamModerationLevel,
+		"reply_to":                               group.ReplyTo,
+		"custom_reply_to":                        group.CustomReplyTo,
+		"custom_footer_text":                     group.CustomFooterText,
+		"default_message_deny_notification_text": group.DefaultMessageDenyNotificationText,
+		"who_can_leave_group":                    group.WhoCanLeaveGroup,
+		"who_can_contact_owner":                  group.WhoCanContactOwner,
+		"who_can_moderate_members":               group.WhoCanModerateMembers,
+		"who_can_moderate_content":               group.WhoCanModerateContent,
+		"who_can_assist_content":                 group.WhoCanAssistContent,
+		"who_can_discover_group":                 group.WhoCanDiscoverGroup,
+		"default_sender":                         group.DefaultSender,
+		"who_can_ban_users":                      group.WhoCanBanUsers,
+		"who_can_delete_any_post":                group.WhoCanDeleteAnyPost,
+		"who_can_delete_topics":                  group.WhoCanDeleteTopics,
+		"who_can_lock_topics":                    group.WhoCanLockTopics,
+		"who_can_move_topics_in":                 group.WhoCanMoveTopicsIn,
+		"who_can_move_topics_out":                group.WhoCanMoveTopicsOut,
+	}
+
+	for k, v := range boolSettings {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q of %s: %w", v, k, err)
+		}
+
+		result[k] = b
+	}
+
+	return result, nil
 }
@@ -6,6 +6,7 @@ package googleworkspace
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -42,6 +43,60 @@ func TestAccResourceGroupSettings_basic(t *testing.T) {
 	})
 }
 
+func TestAccResourceGroupSettings_groupGone(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testGroupVals := map[string]interface{}{
+		"domainName": domainName,
+		"email":      fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+	}
+
+	deleteGroup := func() {
+		client, err := googleworkspaceTestClient()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		directoryService, diags := client.NewDirectoryService()
+		if diags.HasError() {
+			t.Fatal(diags[0].Summary)
+		}
+
+		groupsService, diags := GetGroupsService(directoryService)
+		if diags.HasError() {
+			t.Fatal(diags[0].Summary)
+		}
+
+		err = groupsService.Delete(fmt.Sprintf("%s@%s", testGroupVals["email"], domainName)).Do()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceGroupSettings_basic(testGroupVals),
+			},
+			{
+				// the settings of a deleted group are removed from state rather than failing the refresh
+				PreConfig:          deleteGroup,
+				Config:             testAccResourceGroupSettings_basic(testGroupVals),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func TestAccResourceGroupSettings_full(t *testing.T) {
 	t.Parallel()
 
@@ -126,6 +181,91 @@ func TestAccResourceGroupSettings_archive(t *testing.T) {
 	})
 }
 
+func TestAccResourceGroupSettings_crossFieldValidation(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testGroupVals := map[string]interface{}{
+		"domainName": domainName,
+		"email":      fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceGroupSettings_settings(testGroupVals, `reply_to = "REPLY_TO_CUSTOM"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("`custom_reply_to` must be set"),
+			},
+			{
+				Config: testAccResourceGroupSettings_settings(testGroupVals, `
+  archive_only         = true
+  who_can_post_message = "ALL_MEMBERS_CAN_POST"
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("`who_can_post_message` must be NONE_CAN_POST"),
+			},
+			{
+				Config:      testAccResourceGroupSettings_settings(testGroupVals, `who_can_post_message = "NONE_CAN_POST"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("`who_can_post_message` can only be NONE_CAN_POST"),
+			},
+			{
+				Config:      testAccResourceGroupSettings_settings(testGroupVals, `default_message_deny_notification_text = "denied"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("requires `send_message_deny_notification` to be true"),
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupSettings_restoreDefaultsOnDestroy(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testGroupVals := map[string]interface{}{
+		"domainName": domainName,
+		"email":      fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceGroupSettings_restoreDefaultsOnDestroy(testGroupVals),
+			},
+			{
+				ResourceName:      "googleworkspace_group_settings.my-group-settings",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// restore_defaults_on_destroy is not returned by the API
+				ImportStateVerifyIgnore: []string{"restore_defaults_on_destroy"},
+			},
+			{
+				Config: testAccResourceGroupSettings_restoredDefaults(testGroupVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.googleworkspace_group_settings.my-group-settings", "who_can_join", "CAN_REQUEST_TO_JOIN"),
+					resource.TestCheckResourceAttr("data.googleworkspace_group_settings.my-group-settings", "allow_external_members", "false"),
+					resource.TestCheckResourceAttr("data.googleworkspace_group_settings.my-group-settings", "reply_to", "REPLY_TO_IGNORE"),
+				),
+			},
+		},
+	})
+}
+
 // Some of the values are undocumented, this test will let us know
 // if it's because they intend to be removed
 func TestAccResourceGroupSettings_undocumented(t *testing.T) {
@@ -170,6 +310,22 @@ resource "googleworkspace_group_settings" "my-group-settings" {
 `, testGroupVals)
 }
 
+func testAccResourceGroupSettings_settings(testGroupVals map[string]interface{}, settings string) string {
+	testGroupVals["settings"] = settings
+
+	return Nprintf(`
+resource "googleworkspace_group" "my-group" {
+  email = "%{email}@%{domainName}"
+}
+
+resource "googleworkspace_group_settings" "my-group-settings" {
+  email = googleworkspace_group.my-group.email
+
+  %{settings}
+}
+`, testGroupVals)
+}
+
 func testAccResourceGroupSettings_full(testGroupVals map[string]interface{}) string {
 	return Nprintf(`
 resource "googleworkspace_group" "my-group" {
@@ -188,6 +344,7 @@ resource "googleworkspace_group_settings" "my-group-settings" {
   members_can_post_as_the_group = true
   include_in_global_address_list = false
   enable_collaborative_inbox = true
+  default_sender = "GROUP"
 
   primary_language = "en"
   custom_reply_to = "my-custom@example.com"
@@ -207,6 +364,12 @@ resource "googleworkspace_group_settings" "my-group-settings" {
   who_can_moderate_content = "NONE"
   who_can_assist_content = "OWNERS_ONLY"
   who_can_discover_group = "ALL_MEMBERS_CAN_DISCOVER"
+  who_can_ban_users = "OWNERS_ONLY"
+  who_can_delete_any_post = "OWNERS_ONLY"
+  who_can_delete_topics = "OWNERS_ONLY"
+  who_can_lock_topics = "OWNERS_ONLY"
+  who_can_move_topics_in = "OWNERS_ONLY"
+  who_can_move_topics_out = "OWNERS_ONLY"
 
   timeouts {
     create = "10m"
@@ -248,6 +411,12 @@ resource "googleworkspace_group_settings" "my-group-settings" {
   who_can_moderate_content = "ALL_MEMBERS"
   who_can_assist_content = "OWNERS_AND_MANAGERS"
   who_can_discover_group = "ANYONE_CAN_DISCOVER"
+  who_can_ban_users = "OWNERS_AND_MANAGERS"
+  who_can_delete_any_post = "OWNERS_AND_MANAGERS"
+  who_can_delete_topics = "NONE"
+  who_can_lock_topics = "OWNERS_AND_MANAGERS"
+  who_can_move_topics_in = "NONE"
+  who_can_move_topics_out = "NONE"
 
   timeouts {
     create = "10m"
@@ -310,6 +479,36 @@ resource "googleworkspace_group_settings" "my-group-settings" {
 `, testGroupVals)
 }
 
+func testAccResourceGroupSettings_restoreDefaultsOnDestroy(testGroupVals map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_group" "my-group" {
+  email = "%{email}@%{domainName}"
+}
+
+resource "googleworkspace_group_settings" "my-group-settings" {
+  email = googleworkspace_group.my-group.email
+
+  allow_external_members = true
+  who_can_join = "INVITED_CAN_JOIN"
+  reply_to = "REPLY_TO_SENDER"
+
+  restore_defaults_on_destroy = true
+}
+`, testGroupVals)
+}
+
+func testAccResourceGroupSettings_restoredDefaults(testGroupVals map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_group" "my-group" {
+  email = "%{email}@%{domainName}"
+}
+
+data "googleworkspace_group_settings" "my-group-settings" {
+  email = googleworkspace_group.my-group.email
+}
+`, testGroupVals)
+}
+
 func testAccResourceGroupSettings_undocumented(testGroupVals map[string]interface{}) string {
 	return Nprintf(`
 resource "googleworkspace_group" "my-group" {
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-googleworkspace/internal/fakeworkspace"
 )
 
 func TestAccResourceGroup_basic(t *testing.T) {
@@ -59,6 +60,35 @@ func TestAccResourceGroup_basic(t *testing.T) {
 	})
 }
 
+// Runs against a fake of the APIs, so it doesn't need a Workspace tenant
+func TestAccResourceGroup_fake(t *testing.T) {
+	t.Parallel()
+
+	ts := fakeworkspace.NewTestServer()
+	defer ts.Close()
+
+	testGroupVals := map[string]interface{}{
+		"domainName": "example.com",
+		"email":      fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeProviderConfig(ts.URL) + testAccResourceGroup_full(testGroupVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_group.my-group", "name", "tf-test-name"),
+					resource.TestCheckResourceAttr("googleworkspace_group.my-group", "aliases.#", "2"),
+				),
+			},
+			{
+				Config: testFakeProviderConfig(ts.URL) + testAccResourceGroup_fullUpdate(testGroupVals),
+			},
+		},
+	})
+}
+
 func checkGroupImportState() resource.ImportStateCheckFunc {
 	return resource.ImportStateCheckFunc(
 		func(state []*terraform.InstanceState) error {
@@ -117,6 +147,144 @@ func TestAccResourceGroup_full(t *testing.T) {
 	})
 }
 
+func TestAccResourceGroup_aliasesOrder(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testGroupVals := map[string]interface{}{
+		"domainName": domainName,
+		"email":      fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceGroup_full(testGroupVals),
+			},
+			{
+				// aliases are a set, so reordering them doesn't show as a diff
+				Config:   testAccResourceGroup_fullReordered(testGroupVals),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceGroup_rename(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testGroupVals := map[string]interface{}{
+		"domainName": domainName,
+		"email":      fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+	}
+
+	oldEmail := fmt.Sprintf("%s@%s", testGroupVals["email"].(string), domainName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceGroup_rename(testGroupVals, ""),
+			},
+			{
+				// the old email is kept as an alias, without showing in aliases
+				Config: testAccResourceGroup_rename(testGroupVals, "-renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_group.my-group", "retained_aliases.#", "1"),
+					resource.TestCheckResourceAttr("googleworkspace_group.my-group", "retained_aliases.0", oldEmail),
+					resource.TestCheckResourceAttr("googleworkspace_group.my-group", "aliases.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceGroup_adoptExisting(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testGroupVals := map[string]interface{}{
+		"domainName": domainName,
+		"email":      fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceGroup_adoptExisting(testGroupVals, false),
+			},
+			{
+				Config: testAccResourceGroup_adoptExisting(testGroupVals, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("googleworkspace_group.adopted", "id",
+						"googleworkspace_group.existing", "id"),
+					resource.TestCheckResourceAttr("googleworkspace_group.adopted", "name", "tf-adopted-name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceGroup_adoptExisting(testGroupVals map[string]interface{}, adopt bool) string {
+	config := Nprintf(`
+# stands in for a group created in the console
+resource "googleworkspace_group" "existing" {
+  email = "%{email}@%{domainName}"
+  name  = "tf-test-name"
+
+  lifecycle {
+    ignore_changes = [name]
+  }
+}
+`, testGroupVals)
+
+	if !adopt {
+		return config
+	}
+
+	return config + Nprintf(`
+resource "googleworkspace_group" "adopted" {
+  email = googleworkspace_group.existing.email
+  name  = "tf-adopted-name"
+
+  adopt_existing = true
+}
+`, testGroupVals)
+}
+
+func testAccResourceGroup_rename(testGroupVals map[string]interface{}, suffix string) string {
+	testGroupVals["suffix"] = suffix
+
+	return Nprintf(`
+resource "googleworkspace_group" "my-group" {
+  email = "%{email}%{suffix}@%{domainName}"
+
+  retain_old_email_as_alias = true
+}
+`, testGroupVals)
+}
+
 func testAccResourceGroup_basic(testGroupVals map[string]interface{}) string {
 	return Nprintf(`
 resource "googleworkspace_group" "my-group" {
@@ -137,6 +305,18 @@ resource "googleworkspace_group" "my-group" {
 `, testGroupVals)
 }
 
+func testAccResourceGroup_fullReordered(testGroupVals map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_group" "my-group" {
+  email = "%{email}@%{domainName}"
+  name  = "tf-test-name"
+  description = "my test description"
+
+  aliases = ["%{email}-alias-2@%{domainName}", "%{email}-alias-1@%{domainName}"]
+}
+`, testGroupVals)
+}
+
 func testAccResourceGroup_fullUpdate(testGroupVals map[string]interface{}) string {
 	return Nprintf(`
 resource "googleworkspace_group" "my-group" {
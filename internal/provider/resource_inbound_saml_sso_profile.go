@@ -0,0 +1,264 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceInboundSamlSsoProfile() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Inbound SAML SSO Profile resource manages the configuration of a third-party SAML 2.0 identity " +
+			"provider users can sign in with. The profile is created for the provider's `customer_id`, which must be " +
+			"the customer ID rather than `my_customer`. Inbound SAML SSO Profile requires the " +
+			"`https://www.googleapis.com/auth/cloud-identity.inboundsso` client scope.",
+
+		CreateContext: resourceInboundSamlSsoProfileCreate,
+		ReadContext:   resourceInboundSamlSsoProfileRead,
+		UpdateContext: resourceInboundSamlSsoProfileUpdate,
+		DeleteContext: resourceInboundSamlSsoProfileDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The resource name of the profile, in the format `inboundSamlSsoProfiles/{profile}`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"display_name": {
+				Description: "Human-readable name of the profile.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"idp_config": {
+				Description: "SAML identity provider configuration.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"entity_id": {
+							Description: "The SAML Entity ID of the identity provider.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"single_sign_on_service_uri": {
+							Description:      "The `SingleSignOnService` endpoint location (sign-in page URL) of the identity provider.",
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithHTTPS),
+						},
+						"logout_redirect_uri": {
+							Description:      "The URL users are redirected to when they sign out.",
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithHTTPS),
+						},
+						"change_password_uri": {
+							Description:      "The URL users are sent to when changing their password.",
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithHTTPS),
+						},
+					},
+				},
+			},
+			"sp_config": {
+				Description: "SAML service provider configuration for this profile, to be set in the identity provider.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"entity_id": {
+							Description: "The SAML Entity ID for this service provider.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"assertion_consumer_service_uri": {
+							Description: "The SAML Assertion Consumer Service (ACS) URL to be used for the IDP-initiated login.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			// Adding a computed id simply to override the `optional` id that gets added in the SDK
+			// that will then display improperly in the docs
+			"id": {
+				Description: "The ID of this resource.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceInboundSamlSsoProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	inboundSsoService, diags := client.NewInboundSsoService()
+	if diags.HasError() {
+		return diags
+	}
+
+	displayName := d.Get("display_name").(string)
+	log.Printf("[DEBUG] Creating Inbound SAML SSO Profile %q", displayName)
+
+	profile, err := inboundSsoService.CreateProfile(ctx, &inboundSamlSsoProfile{
+		Customer:    fmt.Sprintf("customers/%s", client.Customer),
+		DisplayName: displayName,
+		IdpConfig:   expandSamlIdpConfig(d.Get("idp_config").([]interface{})),
+	})
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	d.SetId(profile.Name)
+
+	log.Printf("[DEBUG] Finished creating Inbound SAML SSO Profile %q: %#v", d.Id(), displayName)
+
+	return resourceInboundSamlSsoProfileRead(ctx, d, meta)
+}
+
+func resourceInboundSamlSsoProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	inboundSsoService, diags := client.NewInboundSsoService()
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Getting Inbound SAML SSO Profile %q", d.Id())
+
+	profile, err := inboundSsoService.GetProfile(ctx, d.Id())
+	if err != nil {
+		return handleReadNotFoundError(err, d, d.Id())
+	}
+
+	d.Set("name", profile.Name)
+	d.Set("display_name", profile.DisplayName)
+	d.Set("idp_config", flattenSamlIdpConfig(profile.IdpConfig))
+	d.Set("sp_config", flattenSamlSpConfig(profile.SpConfig))
+	d.SetId(profile.Name)
+
+	log.Printf("[DEBUG] Finished getting Inbound SAML SSO Profile %q: %#v", d.Id(), profile.DisplayName)
+
+	return diags
+}
+
+func resourceInboundSamlSsoProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	inboundSsoService, diags := client.NewInboundSsoService()
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Updating Inbound SAML SSO Profile %q", d.Id())
+
+	var updateMask []string
+	if d.HasChange("display_name") {
+		updateMask = append(updateMask, "displayName")
+	}
+
+	for _, field := range []struct{ attr, mask string }{
+		{"idp_config.0.entity_id", "idpConfig.entityId"},
+		{"idp_config.0.single_sign_on_service_uri", "idpConfig.singleSignOnServiceUri"},
+		{"idp_config.0.logout_redirect_uri", "idpConfig.logoutRedirectUri"},
+		{"idp_config.0.change_password_uri", "idpConfig.changePasswordUri"},
+	} {
+		if d.HasChange(field.attr) {
+			updateMask = append(updateMask, field.mask)
+		}
+	}
+
+	if len(updateMask) > 0 {
+		err := inboundSsoService.PatchProfile(ctx, d.Id(), &inboundSamlSsoProfile{
+			DisplayName: d.Get("display_name").(string),
+			IdpConfig:   expandSamlIdpConfig(d.Get("idp_config").([]interface{})),
+		}, updateMask)
+		if err != nil {
+			return apiErrorDiagnostics(err)
+		}
+	}
+
+	log.Printf("[DEBUG] Finished updating Inbound SAML SSO Profile %q", d.Id())
+
+	return resourceInboundSamlSsoProfileRead(ctx, d, meta)
+}
+
+func resourceInboundSamlSsoProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := meta.(*apiClient)
+
+	inboundSsoService, diags := client.NewInboundSsoService()
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Deleting Inbound SAML SSO Profile %q", d.Id())
+
+	err := inboundSsoService.DeleteProfile(ctx, d.Id())
+	if err != nil {
+		return handleNotFoundError(err, d, d.Id())
+	}
+
+	log.Printf("[DEBUG] Finished deleting Inbound SAML SSO Profile %q", d.Id())
+
+	return diags
+}
+
+func expandSamlIdpConfig(v []interface{}) *samlIdpConfig {
+	if len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	config := v[0].(map[string]interface{})
+
+	return &samlIdpConfig{
+		EntityId:               config["entity_id"].(string),
+		SingleSignOnServiceUri: config["single_sign_on_service_uri"].(string),
+		LogoutRedirectUri:      config["logout_redirect_uri"].(string),
+		ChangePasswordUri:      config["change_password_uri"].(string),
+	}
+}
+
+func flattenSamlIdpConfig(config *samlIdpConfig) []interface{} {
+	if config == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"entity_id":                  config.EntityId,
+			"single_sign_on_service_uri": config.SingleSignOnServiceUri,
+			"logout_redirect_uri":        config.LogoutRedirectUri,
+			"change_password_uri":        config.ChangePasswordUri,
+		},
+	}
+}
+
+func flattenSamlSpConfig(config *samlSpConfig) []interface{} {
+	if config == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"entity_id":                      config.EntityId,
+			"assertion_consumer_service_uri": config.AssertionConsumerServiceUri,
+		},
+	}
+}
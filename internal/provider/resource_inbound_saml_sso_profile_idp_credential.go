@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceInboundSamlSsoProfileIdpCredential() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Inbound SAML SSO Profile IdP Credential resource manages the X.509 certificates used to verify " +
+			"the assertions of a SAML identity provider. A profile can have up to 2 credentials, so certificates " +
+			"can be rotated without downtime by adding the new certificate with `create_before_destroy` before " +
+			"removing the previous one. The certificate can't be read back from the API, so the resource can't be " +
+			"imported. Inbound SAML SSO Profile IdP Credential requires the " +
+			"`https://www.googleapis.com/auth/cloud-identity.inboundsso` client scope.",
+
+		CreateContext: resourceInboundSamlSsoProfileIdpCredentialCreate,
+		ReadContext:   resourceInboundSamlSsoProfileIdpCredentialRead,
+		DeleteContext: resourceInboundSamlSsoProfileIdpCredentialDelete,
+
+		Schema: map[string]*schema.Schema{
+			"profile": {
+				Description: "The resource name of the profile, in the format `inboundSamlSsoProfiles/{profile}`.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"pem_data": {
+				Description:      "The PEM encoded X.509 certificate of the identity provider.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateIdpCredentialPemData,
+			},
+			"name": {
+				Description: "The resource name of the credential, in the format " +
+					"`inboundSamlSsoProfiles/{profile}/idpCredentials/{credential}`.",
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_type": {
+				Description: "The type of the certificate's key, `RSA` or `DSA`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"key_size": {
+				Description: "The size of the certificate's key in bits.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"update_time": {
+				Description: "The time the credential was last updated.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			// Adding a computed id simply to override the `optional` id that gets added in the SDK
+			// that will then display improperly in the docs
+			"id": {
+				Description: "The ID of this resource.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceInboundSamlSsoProfileIdpCredentialCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	inboundSsoService, diags := client.NewInboundSsoService()
+	if diags.HasError() {
+		return diags
+	}
+
+	profile := d.Get("profile").(string)
+	log.Printf("[DEBUG] Creating Inbound SAML SSO Profile IdP Credential in %q", profile)
+
+	credential, err := inboundSsoService.AddIdpCredential(ctx, profile, d.Get("pem_data").(string))
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	d.SetId(credential.Name)
+
+	log.Printf("[DEBUG] Finished creating Inbound SAML SSO Profile IdP Credential %q", d.Id())
+
+	return resourceInboundSamlSsoProfileIdpCredentialRead(ctx, d, meta)
+}
+
+func resourceInboundSamlSsoProfileIdpCredentialRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	inboundSsoService, diags := client.NewInboundSsoService()
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Getting Inbound SAML SSO Profile IdP Credential %q", d.Id())
+
+	credential, err := inboundSsoService.GetIdpCredential(ctx, d.Id())
+	if err != nil {
+		return handleReadNotFoundError(err, d, d.Id())
+	}
+
+	d.Set("name", credential.Name)
+	d.Set("update_time", credential.UpdateTime)
+
+	switch {
+	case credential.RsaKeyInfo != nil:
+		d.Set("key_type", "RSA")
+		d.Set("key_size", credential.RsaKeyInfo.KeySize)
+	case credential.DsaKeyInfo != nil:
+		d.Set("key_type", "DSA")
+		d.Set("key_size", credential.DsaKeyInfo.KeySize)
+	}
+
+	log.Printf("[DEBUG] Finished getting Inbound SAML SSO Profile IdP Credential %q", d.Id())
+
+	return diags
+}
+
+func resourceInboundSamlSsoProfileIdpCredentialDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := meta.(*apiClient)
+
+	inboundSsoService, diags := client.NewInboundSsoService()
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Deleting Inbound SAML SSO Profile IdP Credential %q", d.Id())
+
+	err := inboundSsoService.DeleteIdpCredential(ctx, d.Id())
+	if err != nil {
+		return handleNotFoundError(err, d, d.Id())
+	}
+
+	log.Printf("[DEBUG] Finished deleting Inbound SAML SSO Profile IdP Credential %q", d.Id())
+
+	return diags
+}
+
+// validateIdpCredentialPemData ensures the value is a PEM encoded X.509 certificate, so invalid
+// certificates are caught when planning rather than when rotating them
+func validateIdpCredentialPemData(v interface{}, p cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	block, _ := pem.Decode([]byte(v.(string)))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "pem_data must be a PEM encoded certificate",
+			AttributePath: p,
+		})
+	}
+
+	if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("pem_data is not a valid X.509 certificate: %s", err),
+			AttributePath: p,
+		})
+	}
+
+	return diags
+}
@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestValidateIdpCredentialPemData(t *testing.T) {
+	if diags := validateIdpCredentialPemData(testIdpCertificate(t), cty.Path{}); diags.HasError() {
+		t.Errorf("expected the certificate to be valid, got %v", diags)
+	}
+
+	if diags := validateIdpCredentialPemData("not a certificate", cty.Path{}); !diags.HasError() {
+		t.Errorf("expected the value to be invalid")
+	}
+}
+
+func TestAccResourceInboundSamlSsoProfileIdpCredential_rotation(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testCredentialVals := map[string]interface{}{
+		"displayName": fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"entityId":    "https://idp.example.com/" + acctest.RandString(10),
+		"pemData":     testIdpCertificate(t),
+	}
+
+	testCredentialValsRotated := map[string]interface{}{}
+	for k, v := range testCredentialVals {
+		testCredentialValsRotated[k] = v
+	}
+	testCredentialValsRotated["pemData"] = testIdpCertificate(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceInboundSamlSsoProfileIdpCredential(testCredentialVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_inbound_saml_sso_profile_idp_credential.credential", "key_type", "RSA"),
+					resource.TestCheckResourceAttr("googleworkspace_inbound_saml_sso_profile_idp_credential.credential", "key_size", "2048"),
+				),
+			},
+			{
+				Config: testAccResourceInboundSamlSsoProfileIdpCredential(testCredentialValsRotated),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("googleworkspace_inbound_saml_sso_profile_idp_credential.credential", "update_time"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceInboundSamlSsoProfileIdpCredential(testCredentialVals map[string]interface{}) string {
+	return Nprintf(`
+provider "googleworkspace" {
+  oauth_scopes = ["https://www.googleapis.com/auth/cloud-identity.inboundsso"]
+}
+
+resource "googleworkspace_inbound_saml_sso_profile" "profile" {
+  display_name = "%{displayName}"
+
+  idp_config {
+    entity_id                  = "%{entityId}"
+    single_sign_on_service_uri = "https://idp.example.com/sso"
+  }
+}
+
+resource "googleworkspace_inbound_saml_sso_profile_idp_credential" "credential" {
+  profile  = googleworkspace_inbound_saml_sso_profile.profile.name
+  pem_data = <<EOT
+%{pemData}EOT
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, testCredentialVals)
+}
+
+// testIdpCertificate returns a new self-signed PEM encoded certificate
+func testIdpCertificate(t *testing.T) string {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "idp.example.com"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("unable to create certificate: %v", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
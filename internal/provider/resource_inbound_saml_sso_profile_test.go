@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceInboundSamlSsoProfile_basic(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testProfileVals := map[string]interface{}{
+		"displayName": fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"entityId":    "https://idp.example.com/" + acctest.RandString(10),
+		"ssoUrl":      "https://idp.example.com/sso",
+	}
+
+	testProfileValsUpdate := map[string]interface{}{}
+	for k, v := range testProfileVals {
+		testProfileValsUpdate[k] = v
+	}
+	testProfileValsUpdate["ssoUrl"] = "https://idp.example.com/sso/saml"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceInboundSamlSsoProfile(testProfileVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("googleworkspace_inbound_saml_sso_profile.profile", "sp_config.0.entity_id"),
+					resource.TestCheckResourceAttrSet("googleworkspace_inbound_saml_sso_profile.profile", "sp_config.0.assertion_consumer_service_uri"),
+				),
+			},
+			{
+				Config: testAccResourceInboundSamlSsoProfile(testProfileValsUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_inbound_saml_sso_profile.profile", "idp_config.0.single_sign_on_service_uri", "https://idp.example.com/sso/saml"),
+				),
+			},
+			{
+				ResourceName:      "googleworkspace_inbound_saml_sso_profile.profile",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceInboundSamlSsoProfile(testProfileVals map[string]interface{}) string {
+	return Nprintf(`
+provider "googleworkspace" {
+  oauth_scopes = ["https://www.googleapis.com/auth/cloud-identity.inboundsso"]
+}
+
+resource "googleworkspace_inbound_saml_sso_profile" "profile" {
+  display_name = "%{displayName}"
+
+  idp_config {
+    entity_id                  = "%{entityId}"
+    single_sign_on_service_uri = "%{ssoUrl}"
+  }
+}
+`, testProfileVals)
+}
@@ -11,8 +11,13 @@ import (
 	directory "google.golang.org/api/admin/directory/v1"
 	"google.golang.org/api/googleapi"
 	"log"
+	"strings"
+	"time"
 )
 
+// chromeosdevices.moveDevicesToOu accepts at most 50 devices per request
+const orgUnitMoveDevicesBatchSize = 50
+
 func resourceOrgUnit() *schema.Resource {
 	return &schema.Resource{
 		// This description is used by the documentation generator and the language server.
@@ -24,6 +29,13 @@ func resourceOrgUnit() *schema.Resource {
 		UpdateContext: resourceOrgUnitUpdate,
 		DeleteContext: resourceOrgUnitDelete,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			// force_destroy moves the org unit's users and devices one request at a time
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -87,6 +99,21 @@ func resourceOrgUnit() *schema.Resource {
 				Computed:     true,
 				ExactlyOneOf: []string{"parent_org_unit_id", "parent_org_unit_path"},
 			},
+			"force_destroy": {
+				Description: "If true, users and Chrome OS devices in the organizational unit are moved to " +
+					"`force_destroy_target_org_unit_path` before the organizational unit is deleted. Moving devices requires " +
+					"the `https://www.googleapis.com/auth/admin.directory.device.chromeos` client scope, devices are left in " +
+					"place if the scope is missing.",
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"force_destroy_target_org_unit_path": {
+				Description: "The full path of the organizational unit that users and devices are moved to when " +
+					"`force_destroy` is set. Defaults to the parent organizational unit.",
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			// Adding a computed id simply to override the `optional` id that gets added in the SDK
 			// that will then display improperly in the docs
 			"id": {
@@ -132,7 +159,7 @@ func resourceOrgUnitCreate(ctx context.Context, d *schema.ResourceData, meta int
 	var orgUnit *directory.OrgUnit
 	orgUnit, err := orgUnitsService.Insert(client.Customer, &orgUnitObj).Do()
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	d.SetId(orgUnit.OrgUnitId)
@@ -145,14 +172,14 @@ func resourceOrgUnitCreate(ctx context.Context, d *schema.ResourceData, meta int
 		resourceType: "org unit",
 		timeout:      d.Timeout(schema.TimeoutCreate),
 	}
-	err = retryTimeDuration(ctx, d.Timeout(schema.TimeoutCreate), func() error {
+	err = retryConsistencyCheck(ctx, d.Timeout(schema.TimeoutCreate), func() error {
 		var retryErr error
 
 		if cc.reachedConsistency(numInserts) {
 			return nil
 		}
 
-		newOrgUnit, retryErr := orgUnitsService.Get(client.Customer, d.Id()).IfNoneMatch(cc.lastEtag).Do()
+		newOrgUnit, retryErr := orgUnitsService.Get(client.Customer, d.Id()).Fields(consistencyCheckFields).IfNoneMatch(cc.lastEtag).Do()
 		if googleapi.IsNotModified(retryErr) {
 			cc.currConsistent += 1
 		} else if isNotFound(retryErr) {
@@ -190,7 +217,7 @@ func resourceOrgUnitRead(ctx context.Context, d *schema.ResourceData, meta inter
 
 	orgUnit, err := orgUnitsService.Get(client.Customer, d.Id()).Do()
 	if err != nil {
-		return handleNotFoundError(err, d, d.Id())
+		return handleReadNotFoundError(err, d, d.Id())
 	}
 
 	d.Set("name", orgUnit.Name)
@@ -202,6 +229,11 @@ func resourceOrgUnitRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.Set("parent_org_unit_id", orgUnit.ParentOrgUnitId)
 	d.Set("parent_org_unit_path", orgUnit.ParentOrgUnitPath)
 
+	// force_destroy is not returned by the API, default it for imported org units
+	if _, ok := d.GetOk("force_destroy"); !ok {
+		d.Set("force_destroy", false)
+	}
+
 	d.SetId(orgUnit.OrgUnitId)
 
 	return diags
@@ -248,7 +280,7 @@ func resourceOrgUnitUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	if &orgUnitObj != new(directory.OrgUnit) {
 		orgUnit, err := orgUnitsService.Update(client.Customer, d.Id(), &orgUnitObj).Do()
 		if err != nil {
-			return diag.FromErr(err)
+			return apiErrorDiagnostics(err)
 		}
 
 		d.SetId(orgUnit.OrgUnitId)
@@ -262,14 +294,14 @@ func resourceOrgUnitUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		resourceType: "group",
 		timeout:      d.Timeout(schema.TimeoutUpdate),
 	}
-	err := retryTimeDuration(ctx, d.Timeout(schema.TimeoutUpdate), func() error {
+	err := retryConsistencyCheck(ctx, d.Timeout(schema.TimeoutUpdate), func() error {
 		var retryErr error
 
 		if cc.reachedConsistency(numInserts) {
 			return nil
 		}
 
-		newOrgUnit, retryErr := orgUnitsService.Get(client.Customer, d.Id()).IfNoneMatch(cc.lastEtag).Do()
+		newOrgUnit, retryErr := orgUnitsService.Get(client.Customer, d.Id()).Fields(consistencyCheckFields).IfNoneMatch(cc.lastEtag).Do()
 		if googleapi.IsNotModified(retryErr) {
 			cc.currConsistent += 1
 		} else if retryErr != nil {
@@ -282,7 +314,7 @@ func resourceOrgUnitUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	})
 
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	log.Printf("[DEBUG] Finished creating OrgUnit %q: %#v", d.Id(), ouName)
@@ -309,6 +341,18 @@ func resourceOrgUnitDelete(ctx context.Context, d *schema.ResourceData, meta int
 		return diags
 	}
 
+	if d.Get("force_destroy").(bool) {
+		targetPath := d.Get("force_destroy_target_org_unit_path").(string)
+		if targetPath == "" {
+			targetPath = d.Get("parent_org_unit_path").(string)
+		}
+
+		diags = moveOrgUnitContents(ctx, client, directoryService, d.Get("org_unit_path").(string), targetPath)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
 	err := orgUnitsService.Delete(client.Customer, d.Id()).Do()
 	if err != nil {
 		return handleNotFoundError(err, d, d.Id())
@@ -318,3 +362,78 @@ func resourceOrgUnitDelete(ctx context.Context, d *schema.ResourceData, meta int
 
 	return diags
 }
+
+// moveOrgUnitContents moves the users and Chrome OS devices that belong directly to orgUnitPath to targetPath
+func moveOrgUnitContents(ctx context.Context, client *apiClient, directoryService *directory.Service, orgUnitPath, targetPath string) diag.Diagnostics {
+	usersService, diags := GetUsersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	var userIds []string
+	query := fmt.Sprintf("orgUnitPath=%s", celStringLiteral(orgUnitPath))
+	err := usersService.List().Customer(client.Customer).Query(query).
+		Fields("nextPageToken", "users(id,orgUnitPath)").
+		Pages(ctx, func(resp *directory.Users) error {
+			for _, user := range resp.Users {
+				// the query also matches users in child org units
+				if strings.EqualFold(user.OrgUnitPath, orgUnitPath) {
+					userIds = append(userIds, user.Id)
+				}
+			}
+
+			return nil
+		})
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	for _, userId := range userIds {
+		log.Printf("[DEBUG] Moving User %q from OrgUnit %q to %q", userId, orgUnitPath, targetPath)
+
+		_, err := usersService.Patch(userId, &directory.User{OrgUnitPath: targetPath}).Context(ctx).Do()
+		if err != nil {
+			return apiErrorDiagnostics(err)
+		}
+	}
+
+	chromeosDevicesService, diags := GetChromeosDevicesService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	var deviceIds []string
+	err = chromeosDevicesService.List(client.Customer).OrgUnitPath(orgUnitPath).IncludeChildOrgunits(false).
+		Fields("nextPageToken", "chromeosdevices(deviceId)").
+		Pages(ctx, func(resp *directory.ChromeOsDevices) error {
+			for _, device := range resp.Chromeosdevices {
+				deviceIds = append(deviceIds, device.DeviceId)
+			}
+
+			return nil
+		})
+	if isApiErrorWithCode(err, 403) {
+		log.Printf("[WARN] Unable to list Chrome OS devices in OrgUnit %q, devices are not moved: %s", orgUnitPath, err)
+		return diags
+	} else if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	for i := 0; i < len(deviceIds); i += orgUnitMoveDevicesBatchSize {
+		end := i + orgUnitMoveDevicesBatchSize
+		if end > len(deviceIds) {
+			end = len(deviceIds)
+		}
+
+		log.Printf("[DEBUG] Moving %d Chrome OS devices from OrgUnit %q to %q", end-i, orgUnitPath, targetPath)
+
+		err := chromeosDevicesService.MoveDevicesToOu(client.Customer, targetPath, &directory.ChromeOsMoveDevicesToOu{
+			DeviceIds: deviceIds[i:end],
+		}).Context(ctx).Do()
+		if err != nil {
+			return apiErrorDiagnostics(err)
+		}
+	}
+
+	return diags
+}
@@ -5,6 +5,7 @@ package googleworkspace
 
 import (
 	"fmt"
+	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -71,6 +72,46 @@ func TestAccResourceOrgUnit_full(t *testing.T) {
 	})
 }
 
+func TestAccResourceOrgUnit_forceDestroy(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testOrgUnitVals := map[string]interface{}{
+		"ouName":    fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"userEmail": fmt.Sprintf("tf-test-%s@%s", acctest.RandString(10), domainName),
+		"password":  acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceOrgUnit_forceDestroy(testOrgUnitVals, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "org_unit_path",
+						fmt.Sprintf("/%s", testOrgUnitVals["ouName"])),
+				),
+			},
+			{
+				// destroying the org unit moves the user to the parent org unit
+				Config: testAccResourceOrgUnit_forceDestroy(testOrgUnitVals, false),
+			},
+			{
+				Config: testAccResourceOrgUnit_forceDestroy(testOrgUnitVals, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "org_unit_path", "/"),
+				),
+			},
+		},
+	})
+}
+
 func testAccResourceOrgUnitMemberExists(resource string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resource]
@@ -132,3 +173,41 @@ resource "googleworkspace_org_unit" "my-org-unit" {
 }
 `, ouName)
 }
+
+func testAccResourceOrgUnit_forceDestroy(testOrgUnitVals map[string]interface{}, withOrgUnit bool) string {
+	// the user is created in the org unit, once the org unit is removed the path is only kept in the config
+	orgUnitPath := `"/%{ouName}"`
+	if withOrgUnit {
+		orgUnitPath = "googleworkspace_org_unit.my-org-unit.org_unit_path"
+	}
+
+	config := Nprintf(`
+resource "googleworkspace_user" "my-new-user" {
+  primary_email = "%{userEmail}"
+  password = "%{password}"
+  org_unit_path = `+orgUnitPath+`
+
+  name {
+    family_name = "Scott"
+    given_name = "Michael"
+  }
+
+  lifecycle {
+    ignore_changes = [org_unit_path]
+  }
+}
+`, testOrgUnitVals)
+
+	if withOrgUnit {
+		config += Nprintf(`
+resource "googleworkspace_org_unit" "my-org-unit" {
+  name = "%{ouName}"
+  parent_org_unit_path = "/"
+
+  force_destroy = true
+}
+`, testOrgUnitVals)
+	}
+
+	return config
+}
@@ -0,0 +1,507 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+func resourceOrgUnitTree() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "OrgUnit Tree resource manages a hierarchy of Google Workspace OrgUnits under a parent " +
+			"organizational unit. Parents are created before their children and deleted after them, so large " +
+			"hierarchies don't need `depends_on` chains between `googleworkspace_org_unit` resources. Org Unit resides " +
+			"under the `https://www.googleapis.com/auth/admin.directory.orgunit` client scope.",
+
+		CreateContext: resourceOrgUnitTreeCreate,
+		ReadContext:   resourceOrgUnitTreeRead,
+		UpdateContext: resourceOrgUnitTreeUpdate,
+		DeleteContext: resourceOrgUnitTreeDelete,
+
+		CustomizeDiff: resourceOrgUnitTreeCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceOrgUnitTreeImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"parent_org_unit_path": {
+				Description: "The full path of the existing organizational unit the tree is created under, " +
+					"for example `/` or `/corp`.",
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"org_units": {
+				Description: "The organizational units of the tree. Organizational units are identified by their " +
+					"`path`, changing the path of an organizational unit replaces it.",
+				Type:     schema.TypeSet,
+				Required: true,
+				Set:      orgUnitTreeHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Description: "The path of the organizational unit relative to `parent_org_unit_path`, for " +
+								"example `engineering/apps`. The parent of a nested path must be part of the tree as well.",
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(
+								regexp.MustCompile(`^[^/]+(/[^/]+)*$`), "must be a relative path without leading or trailing slashes")),
+						},
+						"description": {
+							Description: "Description of the organizational unit.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"block_inheritance": {
+							Description: "Determines if a sub-organizational unit can inherit the settings of the parent organization.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+						"org_unit_id": {
+							Description: "The unique ID of the organizational unit.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"org_unit_path": {
+							Description: "The full path to the organizational unit.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			// Adding a computed id simply to override the `optional` id that gets added in the SDK
+			// that will then display improperly in the docs
+			"id": {
+				Description: "The ID of this resource.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// org units in the tree are identified by their relative path
+func orgUnitTreeHash(v interface{}) int {
+	return schema.HashString(v.(map[string]interface{})["path"].(string))
+}
+
+func resourceOrgUnitTreeCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() || !rawConfig.GetAttr("org_units").IsWhollyKnown() {
+		return nil
+	}
+
+	paths := map[string]bool{}
+	for _, ou := range diff.Get("org_units").(*schema.Set).List() {
+		paths[ou.(map[string]interface{})["path"].(string)] = true
+	}
+
+	for p := range paths {
+		if parent := path.Dir(p); parent != "." && !paths[parent] {
+			return fmt.Errorf("org unit %q is nested under %q, which is not part of the tree", p, parent)
+		}
+	}
+
+	return nil
+}
+
+// orgUnitTreeFullPath returns the full path of an org unit in the tree
+func orgUnitTreeFullPath(parentPath, relativePath string) string {
+	return path.Join("/", parentPath, relativePath)
+}
+
+// sortOrgUnitTree sorts org units so that parents come before their children
+func sortOrgUnitTree(orgUnits []interface{}) {
+	sort.SliceStable(orgUnits, func(i, j int) bool {
+		pi := orgUnits[i].(map[string]interface{})["path"].(string)
+		pj := orgUnits[j].(map[string]interface{})["path"].(string)
+
+		if di, dj := strings.Count(pi, "/"), strings.Count(pj, "/"); di != dj {
+			return di < dj
+		}
+
+		return pi < pj
+	})
+}
+
+func resourceOrgUnitTreeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	parentPath := d.Get("parent_org_unit_path").(string)
+	log.Printf("[DEBUG] Creating OrgUnit Tree under %q", parentPath)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	orgUnitsService, diags := GetOrgUnitsService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	orgUnits := d.Get("org_units").(*schema.Set).List()
+	sortOrgUnitTree(orgUnits)
+
+	d.SetId(parentPath)
+
+	var created []interface{}
+	for _, ou := range orgUnits {
+		orgUnit := ou.(map[string]interface{})
+
+		orgUnitId, diags := createOrgUnitTreeOrgUnit(ctx, client, orgUnitsService, parentPath, orgUnit, d.Timeout(schema.TimeoutCreate))
+		if diags.HasError() {
+			// keep track of exactly the org units that were created so far, the tree isn't
+			// in state at all if none were
+			if len(created) == 0 {
+				d.SetId("")
+				return diags
+			}
+
+			d.Set("org_units", created)
+			return diags
+		}
+
+		orgUnit["org_unit_id"] = orgUnitId
+		created = append(created, orgUnit)
+	}
+
+	if err := d.Set("org_units", created); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Finished creating OrgUnit Tree under %q", parentPath)
+
+	return resourceOrgUnitTreeRead(ctx, d, meta)
+}
+
+// createOrgUnitTreeOrgUnit inserts an org unit and waits until it can be used as a parent
+func createOrgUnitTreeOrgUnit(ctx context.Context, client *apiClient, orgUnitsService *directory.OrgunitsService, parentPath string, orgUnit map[string]interface{}, timeout time.Duration) (string, diag.Diagnostics) {
+	relativePath := orgUnit["path"].(string)
+
+	orgUnitObj := directory.OrgUnit{
+		Name:              path.Base(relativePath),
+		Description:       orgUnit["description"].(string),
+		BlockInheritance:  orgUnit["block_inheritance"].(bool),
+		ParentOrgUnitPath: path.Dir(orgUnitTreeFullPath(parentPath, relativePath)),
+	}
+
+	log.Printf("[DEBUG] Creating OrgUnit %q", orgUnitTreeFullPath(parentPath, relativePath))
+
+	var newOrgUnit *directory.OrgUnit
+	err := retryConsistencyCheck(ctx, timeout, func() error {
+		var retryErr error
+
+		newOrgUnit, retryErr = orgUnitsService.Insert(client.Customer, &orgUnitObj).Context(ctx).Do()
+		// the parent org unit may not be visible yet
+		if isNotFound(retryErr) || (isApiErrorWithCode(retryErr, 400) && strings.Contains(strings.ToLower(retryErr.Error()), "parent")) {
+			return fmt.Errorf("timed out while waiting for parent org unit %q to be created", orgUnitObj.ParentOrgUnitPath)
+		}
+
+		return retryErr
+	})
+	if err != nil {
+		return "", apiErrorDiagnostics(err)
+	}
+
+	err = retryConsistencyCheck(ctx, timeout, func() error {
+		_, retryErr := orgUnitsService.Get(client.Customer, newOrgUnit.OrgUnitId).Fields(consistencyCheckFields).Context(ctx).Do()
+		if isNotFound(retryErr) {
+			return fmt.Errorf("timed out while waiting for org unit %q to be created", newOrgUnit.OrgUnitPath)
+		}
+
+		return retryErr
+	})
+	if err != nil {
+		return "", apiErrorDiagnostics(err)
+	}
+
+	return newOrgUnit.OrgUnitId, nil
+}
+
+func resourceOrgUnitTreeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	orgUnitsService, diags := GetOrgUnitsService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	parentPath := d.Id()
+	parentPrefix := strings.TrimSuffix(parentPath, "/") + "/"
+
+	log.Printf("[DEBUG] Getting OrgUnit Tree under %q", parentPath)
+
+	var orgUnits []*directory.OrgUnit
+
+	// only the org units in state are read, org units created outside of Terraform under the
+	// parent are never adopted, see resourceOrgUnitTreeImport
+	current := d.Get("org_units").(*schema.Set).List()
+	for _, ou := range current {
+		orgUnitId := ou.(map[string]interface{})["org_unit_id"].(string)
+		if orgUnitId == "" {
+			continue
+		}
+
+		orgUnit, err := orgUnitsService.Get(client.Customer, orgUnitId).Context(ctx).Do()
+		if isNotFound(err) {
+			log.Printf("[WARN] OrgUnit %q of the tree under %q was not found, removing it from state", orgUnitId, parentPath)
+			continue
+		} else if err != nil {
+			return apiErrorDiagnostics(err)
+		}
+
+		orgUnits = append(orgUnits, orgUnit)
+	}
+
+	var result []interface{}
+	for _, orgUnit := range orgUnits {
+		// the org unit was moved outside of the tree
+		if !strings.HasPrefix(orgUnit.OrgUnitPath, parentPrefix) {
+			log.Printf("[WARN] OrgUnit %q is no longer under %q, removing it from state", orgUnit.OrgUnitPath, parentPath)
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"path":              strings.TrimPrefix(orgUnit.OrgUnitPath, parentPrefix),
+			"description":       orgUnit.Description,
+			"block_inheritance": orgUnit.BlockInheritance,
+			"org_unit_id":       orgUnit.OrgUnitId,
+			"org_unit_path":     orgUnit.OrgUnitPath,
+		})
+	}
+
+	d.Set("parent_org_unit_path", parentPath)
+	if err := d.Set("org_units", result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Finished getting OrgUnit Tree under %q", parentPath)
+
+	return nil
+}
+
+// resourceOrgUnitTreeImport adopts all the org units under the parent, as nothing is known about the tree
+// when importing it
+func resourceOrgUnitTreeImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return nil, fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	orgUnitsService, diags := GetOrgUnitsService(directoryService)
+	if diags.HasError() {
+		return nil, fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	parentPath := d.Id()
+	log.Printf("[DEBUG] Importing OrgUnit Tree under %q", parentPath)
+
+	resp, err := orgUnitsService.List(client.Customer).OrgUnitPath(parentPath).Type("all").Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	parentPrefix := strings.TrimSuffix(parentPath, "/") + "/"
+
+	var orgUnits []interface{}
+	for _, orgUnit := range resp.OrganizationUnits {
+		orgUnits = append(orgUnits, map[string]interface{}{
+			"path":              strings.TrimPrefix(orgUnit.OrgUnitPath, parentPrefix),
+			"description":       orgUnit.Description,
+			"block_inheritance": orgUnit.BlockInheritance,
+			"org_unit_id":       orgUnit.OrgUnitId,
+			"org_unit_path":     orgUnit.OrgUnitPath,
+		})
+	}
+
+	if err := d.Set("org_units", orgUnits); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceOrgUnitTreeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	parentPath := d.Id()
+	log.Printf("[DEBUG] Updating OrgUnit Tree under %q", parentPath)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	orgUnitsService, diags := GetOrgUnitsService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	o, n := d.GetChange("org_units")
+
+	oldOrgUnits := map[string]map[string]interface{}{}
+	for _, ou := range o.(*schema.Set).List() {
+		orgUnit := ou.(map[string]interface{})
+		oldOrgUnits[orgUnit["path"].(string)] = orgUnit
+	}
+
+	newOrgUnitsList := n.(*schema.Set).List()
+	sortOrgUnitTree(newOrgUnitsList)
+
+	newOrgUnits := map[string]bool{}
+	for _, ou := range newOrgUnitsList {
+		newOrgUnits[ou.(map[string]interface{})["path"].(string)] = true
+	}
+
+	// delete removed org units, children first
+	var removed []interface{}
+	for p, orgUnit := range oldOrgUnits {
+		if !newOrgUnits[p] {
+			removed = append(removed, orgUnit)
+		}
+	}
+	sortOrgUnitTree(removed)
+
+	// the org units applied so far, and the old org units not yet deleted or applied, are kept in state if
+	// the update fails, so that org units created by this apply aren't lost
+	var result []interface{}
+	deleted := map[string]bool{}
+	setPartialOrgUnits := func() {
+		orgUnits := append([]interface{}{}, result...)
+
+		applied := map[string]bool{}
+		for _, ou := range result {
+			applied[ou.(map[string]interface{})["path"].(string)] = true
+		}
+
+		for p, orgUnit := range oldOrgUnits {
+			if !deleted[p] && !applied[p] {
+				orgUnits = append(orgUnits, orgUnit)
+			}
+		}
+
+		d.Set("org_units", orgUnits)
+	}
+
+	for i := len(removed) - 1; i >= 0; i-- {
+		orgUnit := removed[i].(map[string]interface{})
+
+		log.Printf("[DEBUG] Deleting OrgUnit %q", orgUnit["org_unit_path"])
+
+		err := orgUnitsService.Delete(client.Customer, orgUnit["org_unit_id"].(string)).Context(ctx).Do()
+		if err != nil && !isNotFound(err) {
+			setPartialOrgUnits()
+			return apiErrorDiagnostics(err)
+		}
+
+		deleted[orgUnit["path"].(string)] = true
+	}
+
+	// create added org units and update changed ones, parents first
+	for _, ou := range newOrgUnitsList {
+		orgUnit := ou.(map[string]interface{})
+
+		old, ok := oldOrgUnits[orgUnit["path"].(string)]
+		if !ok {
+			orgUnitId, diags := createOrgUnitTreeOrgUnit(ctx, client, orgUnitsService, parentPath, orgUnit, d.Timeout(schema.TimeoutUpdate))
+			if diags.HasError() {
+				setPartialOrgUnits()
+				return diags
+			}
+
+			orgUnit["org_unit_id"] = orgUnitId
+			result = append(result, orgUnit)
+			continue
+		}
+
+		orgUnit["org_unit_id"] = old["org_unit_id"]
+
+		if old["description"] == orgUnit["description"] && old["block_inheritance"] == orgUnit["block_inheritance"] {
+			result = append(result, orgUnit)
+			continue
+		}
+
+		log.Printf("[DEBUG] Updating OrgUnit %q", old["org_unit_path"])
+
+		_, err := orgUnitsService.Update(client.Customer, old["org_unit_id"].(string), &directory.OrgUnit{
+			Description:      orgUnit["description"].(string),
+			BlockInheritance: orgUnit["block_inheritance"].(bool),
+			ForceSendFields:  []string{"Description", "BlockInheritance"},
+		}).Context(ctx).Do()
+		if err != nil {
+			setPartialOrgUnits()
+			return apiErrorDiagnostics(err)
+		}
+
+		result = append(result, orgUnit)
+	}
+
+	if err := d.Set("org_units", result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Finished updating OrgUnit Tree under %q", parentPath)
+
+	return resourceOrgUnitTreeRead(ctx, d, meta)
+}
+
+func resourceOrgUnitTreeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	parentPath := d.Id()
+	log.Printf("[DEBUG] Deleting OrgUnit Tree under %q", parentPath)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	orgUnitsService, diags := GetOrgUnitsService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	orgUnits := d.Get("org_units").(*schema.Set).List()
+	sortOrgUnitTree(orgUnits)
+
+	// children first
+	for i := len(orgUnits) - 1; i >= 0; i-- {
+		orgUnit := orgUnits[i].(map[string]interface{})
+
+		log.Printf("[DEBUG] Deleting OrgUnit %q", orgUnit["org_unit_path"])
+
+		err := orgUnitsService.Delete(client.Customer, orgUnit["org_unit_id"].(string)).Context(ctx).Do()
+		if err != nil && !isNotFound(err) {
+			return apiErrorDiagnostics(err)
+		}
+	}
+
+	log.Printf("[DEBUG] Finished deleting OrgUnit Tree under %q", parentPath)
+
+	return nil
+}
@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+func TestAccResourceOrgUnitTree_basic(t *testing.T) {
+	t.Parallel()
+
+	ouName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceOrgUnitTree_basic(ouName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_org_unit_tree.tree", "org_units.#", "4"),
+					resource.TestCheckTypeSetElemNestedAttrs("googleworkspace_org_unit_tree.tree", "org_units.*", map[string]string{
+						"path":          "engineering/apps/mobile",
+						"org_unit_path": fmt.Sprintf("/%s/engineering/apps/mobile", ouName),
+					}),
+				),
+			},
+			{
+				ResourceName:      "googleworkspace_org_unit_tree.tree",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("/%s", ouName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResourceOrgUnitTree_update(ouName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_org_unit_tree.tree", "org_units.#", "4"),
+					resource.TestCheckTypeSetElemNestedAttrs("googleworkspace_org_unit_tree.tree", "org_units.*", map[string]string{
+						"path":        "engineering",
+						"description": "Engineering and apps",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("googleworkspace_org_unit_tree.tree", "org_units.*", map[string]string{
+						"path":        "sales/emea",
+						"description": "EMEA",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceOrgUnitTree_outOfBandOrgUnit(t *testing.T) {
+	t.Parallel()
+
+	ouName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	newOrgUnitsService := func() (*directory.OrgunitsService, string) {
+		client, err := googleworkspaceTestClient()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		directoryService, diags := client.NewDirectoryService()
+		if diags.HasError() {
+			t.Fatal(diags[0].Summary)
+		}
+
+		orgUnitsService, diags := GetOrgUnitsService(directoryService)
+		if diags.HasError() {
+			t.Fatal(diags[0].Summary)
+		}
+
+		return orgUnitsService, client.Customer
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceOrgUnitTree_basic(ouName),
+			},
+			{
+				// an org unit created under the parent outside of Terraform isn't adopted by the tree
+				PreConfig: func() {
+					orgUnitsService, customer := newOrgUnitsService()
+					_, err := orgUnitsService.Insert(customer, &directory.OrgUnit{
+						Name:              "marketing",
+						ParentOrgUnitPath: fmt.Sprintf("/%s", ouName),
+					}).Do()
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccResourceOrgUnitTree_basic(ouName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_org_unit_tree.tree", "org_units.#", "4"),
+				),
+			},
+			{
+				// the parent can only be deleted once it has no children left
+				PreConfig: func() {
+					orgUnitsService, customer := newOrgUnitsService()
+					err := orgUnitsService.Delete(customer, fmt.Sprintf("%s/marketing", ouName)).Do()
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccResourceOrgUnitTree_basic(ouName),
+			},
+		},
+	})
+}
+
+func TestAccResourceOrgUnitTree_missingParent(t *testing.T) {
+	t.Parallel()
+
+	ouName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceOrgUnitTree_missingParent(ouName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("which is not part of the tree"),
+			},
+		},
+	})
+}
+
+func testAccResourceOrgUnitTree_basic(ouName string) string {
+	return fmt.Sprintf(`
+resource "googleworkspace_org_unit" "parent" {
+  name = "%s"
+  parent_org_unit_path = "/"
+}
+
+resource "googleworkspace_org_unit_tree" "tree" {
+  parent_org_unit_path = googleworkspace_org_unit.parent.org_unit_path
+
+  org_units {
+    path        = "engineering"
+    description = "Engineering"
+  }
+
+  org_units {
+    path = "engineering/apps"
+  }
+
+  org_units {
+    path = "engineering/apps/mobile"
+  }
+
+  org_units {
+    path = "sales"
+  }
+}
+`, ouName)
+}
+
+func testAccResourceOrgUnitTree_update(ouName string) string {
+	return fmt.Sprintf(`
+resource "googleworkspace_org_unit" "parent" {
+  name = "%s"
+  parent_org_unit_path = "/"
+}
+
+resource "googleworkspace_org_unit_tree" "tree" {
+  parent_org_unit_path = googleworkspace_org_unit.parent.org_unit_path
+
+  org_units {
+    path        = "engineering"
+    description = "Engineering and apps"
+  }
+
+  org_units {
+    path = "engineering/apps"
+  }
+
+  org_units {
+    path = "sales"
+  }
+
+  org_units {
+    path        = "sales/emea"
+    description = "EMEA"
+  }
+}
+`, ouName)
+}
+
+func testAccResourceOrgUnitTree_missingParent(ouName string) string {
+	return fmt.Sprintf(`
+resource "googleworkspace_org_unit_tree" "tree" {
+  parent_org_unit_path = "/%s"
+
+  org_units {
+    path = "engineering/apps"
+  }
+}
+`, ouName)
+}
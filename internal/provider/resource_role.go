@@ -5,8 +5,12 @@ package googleworkspace
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"sort"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -25,6 +29,13 @@ func resourceRole() *schema.Resource {
 		UpdateContext: resourceRoleUpdate,
 		DeleteContext: resourceRoleDelete,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		CustomizeDiff: resourceRoleCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -46,9 +57,11 @@ func resourceRole() *schema.Resource {
 				Optional:    true,
 			},
 			"privileges": {
-				Description: "The set of privileges that are granted to this role.",
-				Required:    true,
-				Type:        schema.TypeSet,
+				Description: "The set of privileges that are granted to this role. Privileges are unordered, and " +
+					"privileges added or removed outside of Terraform, e.g. in the Admin console, are detected as drift. " +
+					"Privileges are checked against the `googleworkspace_privileges` catalog when planning.",
+				Required: true,
+				Type:     schema.TypeSet,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"service_id": {
@@ -83,6 +96,101 @@ func resourceRole() *schema.Resource {
 	}
 }
 
+// resourceRoleCustomizeDiff checks the privileges against the privileges catalog, which the API
+// only does mid-apply
+func resourceRoleCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	client, _ := meta.(*apiClient)
+	if client == nil || !diff.HasChange("privileges") {
+		return nil
+	}
+
+	catalog, err := client.privilegesCatalog(ctx)
+	if err != nil {
+		// the catalog is only used to fail early, the API still validates the privileges on apply
+		log.Printf("[WARN] unable to list the privileges catalog, privileges aren't validated at plan time: %s", err)
+		return nil
+	}
+
+	for _, p := range diff.Get("privileges").(*schema.Set).List() {
+		priv := p.(map[string]interface{})
+		serviceId := priv["service_id"].(string)
+		privilegeName := priv["privilege_name"].(string)
+
+		// values that aren't known yet are empty
+		if serviceId == "" || privilegeName == "" {
+			continue
+		}
+
+		privilegeNames, ok := catalog[serviceId]
+		if !ok {
+			return fmt.Errorf("privilege %s has an unknown service_id %s", privilegeName, serviceId)
+		}
+
+		if stringInSlice(privilegeNames, privilegeName) {
+			continue
+		}
+
+		var services []string
+		for id, names := range catalog {
+			if stringInSlice(names, privilegeName) {
+				services = append(services, id)
+			}
+		}
+		if len(services) > 0 {
+			sort.Strings(services)
+			return fmt.Errorf("privilege %s isn't a privilege of service %s, it's a privilege of service %s",
+				privilegeName, serviceId, strings.Join(services, ", "))
+		}
+
+		if suggestions := closestStrings(privilegeName, privilegeNames, 3); len(suggestions) > 0 {
+			return fmt.Errorf("privilege %s isn't a privilege of service %s, did you mean %s?",
+				privilegeName, serviceId, strings.Join(suggestions, " or "))
+		}
+
+		return fmt.Errorf("privilege %s isn't a privilege of service %s", privilegeName, serviceId)
+	}
+
+	return nil
+}
+
+// privilegesCatalog returns the privilege names of the customer by service ID, including child privileges.
+// It's listed once and reused for the lifetime of the provider.
+func (c *apiClient) privilegesCatalog(ctx context.Context) (map[string][]string, error) {
+	c.privilegesMu.Lock()
+	defer c.privilegesMu.Unlock()
+
+	if c.privileges != nil {
+		return c.privileges, nil
+	}
+
+	directoryService, diags := c.NewDirectoryService()
+	if diags.HasError() {
+		return nil, fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	privilegesService, diags := GetPrivilegesService(directoryService)
+	if diags.HasError() {
+		return nil, fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	log.Printf("[DEBUG] Listing the privileges catalog of %q", c.Customer)
+
+	privileges, err := privilegesService.List(c.Customer).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	catalog := map[string][]string{}
+	for _, priv := range flattenAndPrunePrivileges(privileges.Items, make(map[string]bool)) {
+		p := priv.(map[string]interface{})
+		serviceId := p["service_id"].(string)
+		catalog[serviceId] = append(catalog[serviceId], p["privilege_name"].(string))
+	}
+	c.privileges = catalog
+
+	return catalog, nil
+}
+
 func resourceRoleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*apiClient)
 
@@ -98,9 +206,9 @@ func resourceRoleCreate(ctx context.Context, d *schema.ResourceData, meta interf
 
 	log.Printf("[DEBUG] Creating Role %q", d.Get("name").(string))
 
-	role, err := rolesService.Insert(client.Customer, getRole(d)).Do()
+	role, err := rolesService.Insert(client.Customer, getRole(d)).Context(ctx).Do()
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 	d.SetId(strconv.FormatInt(role.RoleId, 10))
 
@@ -124,9 +232,9 @@ func resourceRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 
 	log.Printf("[DEBUG] Updating Role %q", d.Id())
 
-	_, err := rolesService.Update(client.Customer, d.Id(), getRole(d)).Do()
+	_, err := rolesService.Update(client.Customer, d.Id(), getRole(d)).Context(ctx).Do()
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	log.Printf("[DEBUG] Finished updating Role %q", d.Id())
@@ -151,7 +259,7 @@ func resourceRoleRead(ctx context.Context, d *schema.ResourceData, meta interfac
 
 	role, err := rolesService.Get(client.Customer, d.Id()).Do()
 	if err != nil {
-		return handleNotFoundError(err, d, d.Id())
+		return handleReadNotFoundError(err, d, d.Id())
 	}
 	if role == nil {
 		return diag.Errorf("No Role was returned for %s.", d.Id())
@@ -225,12 +333,21 @@ func setRole(d *schema.ResourceData, role *directory.Role) diag.Diagnostics {
 	d.Set("is_super_admin_role", role.IsSuperAdminRole)
 	d.Set("etag", role.Etag)
 
-	privileges := make([]interface{}, len(role.RolePrivileges))
-	for i, priv := range role.RolePrivileges {
-		privileges[i] = map[string]interface{}{
+	// the API returns the privileges in no particular order and may repeat them, every privilege
+	// it returns is kept so that privileges granted outside of Terraform show up as drift
+	privileges := []interface{}{}
+	seen := map[string]bool{}
+	for _, priv := range role.RolePrivileges {
+		key := priv.ServiceId + "/" + priv.PrivilegeName
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		privileges = append(privileges, map[string]interface{}{
 			"service_id":     priv.ServiceId,
 			"privilege_name": priv.PrivilegeName,
-		}
+		})
 	}
 	if err := d.Set("privileges", privileges); err != nil {
 		diags = append(diags, diag.Diagnostic{
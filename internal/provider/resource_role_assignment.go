@@ -5,6 +5,7 @@ package googleworkspace
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"strconv"
 	"strings"
@@ -24,8 +25,10 @@ func resourceRoleAssignment() *schema.Resource {
 		ReadContext:   resourceRoleAssignmentRead,
 		DeleteContext: resourceRoleAssignmentDelete,
 
+		CustomizeDiff: resourceRoleAssignmentCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceRoleAssignmentImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -46,10 +49,13 @@ func resourceRoleAssignment() *schema.Resource {
 				Computed:    true,
 			},
 			"assigned_to": {
-				Description: "The unique ID of the user this role is assigned to.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
+				Description: "The unique ID of the user or service account this role is assigned to. A service account " +
+					"is assigned a role by its unique ID (its OAuth 2 client ID), which can be looked up from its email " +
+					"with the `googleworkspace_service_account` data source.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateAssignedTo,
 			},
 			"scope_type": {
 				Description: "The scope in which this role is assigned. Valid values are :" +
@@ -65,8 +71,21 @@ func resourceRoleAssignment() *schema.Resource {
 				Description:      "If the role is restricted to an organization unit, this contains the ID for the organization unit the exercise of this role is restricted to.",
 				Type:             schema.TypeString,
 				Optional:         true,
+				Computed:         true,
 				ForceNew:         true,
 				DiffSuppressFunc: diffSuppressOrgUnitId,
+				ConflictsWith:    []string{"org_unit_path"},
+			},
+			"org_unit_path": {
+				Description: "If the role is restricted to an organization unit, the full path of the organization " +
+					"unit the exercise of this role is restricted to. It is resolved to `org_unit_id` when the role is assigned. " +
+					"The root organization unit can't be used, set `scope_type` to `CUSTOMER` instead.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ConflictsWith:    []string{"org_unit_id"},
+				ValidateDiagFunc: validateRoleAssignmentOrgUnitPath,
 			},
 		},
 	}
@@ -78,6 +97,66 @@ func diffSuppressOrgUnitId(k, old, new string, d *schema.ResourceData) bool {
 	return strings.TrimPrefix(old, "id:") == strings.TrimPrefix(new, "id:")
 }
 
+// validateAssignedTo rejects email addresses, which the API only rejects mid-apply
+func validateAssignedTo(v interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	assignedTo := v.(string)
+	if strings.Contains(assignedTo, "@") {
+		detail := "Use the user's `id` instead of their email address."
+		if strings.HasSuffix(strings.ToLower(assignedTo), ".gserviceaccount.com") {
+			detail = "Use the service account's unique ID instead of its email address, it can be looked up " +
+				"with the googleworkspace_service_account data source."
+		}
+
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("%q is not a unique ID", assignedTo),
+			Detail:        detail,
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
+
+// validateRoleAssignmentOrgUnitPath rejects the root org unit, which can't be looked up by path
+func validateRoleAssignmentOrgUnitPath(v interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	orgUnitPath := v.(string)
+	if strings.Trim(orgUnitPath, "/") == "" {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("%q is the root organization unit", orgUnitPath),
+			Detail:        "Assign the role to the whole organization with `scope_type = \"CUSTOMER\"` instead.",
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
+
+func resourceRoleAssignmentCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() || !diff.NewValueKnown("scope_type") {
+		return nil
+	}
+
+	scopeType := strings.ToUpper(diff.Get("scope_type").(string))
+	orgUnitSet := !rawConfig.GetAttr("org_unit_id").IsNull() || !rawConfig.GetAttr("org_unit_path").IsNull()
+
+	if scopeType == "ORG_UNIT" && !orgUnitSet {
+		return fmt.Errorf("if 'scope_type' is set to ORG_UNIT then 'org_unit_id' or 'org_unit_path' must be set")
+	}
+
+	if scopeType == "CUSTOMER" && orgUnitSet {
+		return fmt.Errorf("if 'scope_type' is set to CUSTOMER then 'org_unit_id' and 'org_unit_path' must not be set")
+	}
+
+	return nil
+}
+
 func resourceRolesAssignmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -104,11 +183,26 @@ func resourceRolesAssignmentCreate(ctx context.Context, d *schema.ResourceData,
 
 	scopeType := strings.ToUpper(d.Get("scope_type").(string))
 	orgUnitId := strings.TrimPrefix(d.Get("org_unit_id").(string), "id:")
+
+	if orgUnitPath := d.Get("org_unit_path").(string); orgUnitId == "" && orgUnitPath != "" {
+		orgUnitsService, diags := GetOrgUnitsService(directoryService)
+		if diags.HasError() {
+			return diags
+		}
+
+		orgUnit, err := orgUnitsService.Get(client.Customer, strings.TrimLeft(orgUnitPath, "/")).Do()
+		if err != nil {
+			return apiErrorDiagnostics(err)
+		}
+
+		orgUnitId = strings.TrimPrefix(orgUnit.OrgUnitId, "id:")
+	}
+
 	if scopeType == "ORG_UNIT" && orgUnitId == "" {
 		diags = append(diags, diag.Diagnostic{
 			Severity:      diag.Error,
 			Summary:       "Attribute cannot be empty",
-			Detail:        "if 'scope_type' is set to ORG_UNIT then 'org_unit_id' must be set",
+			Detail:        "if 'scope_type' is set to ORG_UNIT then 'org_unit_id' or 'org_unit_path' must be set",
 			AttributePath: cty.IndexStringPath("org_unit_id"),
 		})
 		return diags
@@ -123,7 +217,7 @@ func resourceRolesAssignmentCreate(ctx context.Context, d *schema.ResourceData,
 
 	ra, err = roleAssignmentsService.Insert(client.Customer, ra).Do()
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	d.SetId(strconv.FormatInt(ra.RoleAssignmentId, 10))
@@ -152,12 +246,17 @@ func resourceRoleAssignmentRead(ctx context.Context, d *schema.ResourceData, met
 
 	ra, err := roleAssignmentsService.Get(client.Customer, d.Id()).Do()
 	if err != nil {
-		return handleNotFoundError(err, d, d.Id())
+		return handleReadNotFoundError(err, d, d.Id())
 	}
 	if ra == nil {
 		return diag.Errorf("No RoleAssignment was returned for %s.", d.Id())
 	}
 
+	// the path of the org unit is only looked up when it isn't known yet or the org unit changed,
+	// rather than on every refresh
+	previousOrgUnitId := strings.TrimPrefix(d.Get("org_unit_id").(string), "id:")
+	orgUnitPath := d.Get("org_unit_path").(string)
+
 	d.SetId(strconv.FormatInt(ra.RoleAssignmentId, 10))
 	d.Set("role_id", strconv.FormatInt(ra.RoleId, 10))
 	d.Set("etag", ra.Etag)
@@ -165,6 +264,31 @@ func resourceRoleAssignmentRead(ctx context.Context, d *schema.ResourceData, met
 	d.Set("scope_type", ra.ScopeType)
 	d.Set("org_unit_id", ra.OrgUnitId)
 
+	orgUnitId := strings.TrimPrefix(ra.OrgUnitId, "id:")
+	switch {
+	case orgUnitId == "":
+		orgUnitPath = ""
+	case orgUnitPath == "" || orgUnitId != previousOrgUnitId:
+		orgUnitsService, diags := GetOrgUnitsService(directoryService)
+		if diags.HasError() {
+			return diags
+		}
+
+		orgUnit, err := orgUnitsService.Get(client.Customer, "id:"+orgUnitId).Do()
+		if err != nil {
+			if !isApiErrorWithCode(err, 404) {
+				return apiErrorDiagnostics(err)
+			}
+
+			// the org unit was deleted outside of Terraform, which shows as drift of the path
+			log.Printf("[WARN] Org unit %q of RoleAssignment %q wasn't found", orgUnitId, d.Id())
+			orgUnitPath = ""
+		} else {
+			orgUnitPath = orgUnit.OrgUnitPath
+		}
+	}
+	d.Set("org_unit_path", orgUnitPath)
+
 	log.Printf("[DEBUG] Finished getting RoleAssignment %q", d.Id())
 
 	return diags
@@ -196,3 +320,57 @@ func resourceRoleAssignmentDelete(ctx context.Context, d *schema.ResourceData, m
 
 	return diags
 }
+
+// The id is either the role assignment id, or "<role_id>/<assignee>" where the assignee is the
+// unique ID or the primary email of the user the role is assigned to
+func resourceRoleAssignmentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) == 1 {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Role Assignment Id (%s) is not of the correct format (<role_assignment_id> or <role_id>/<assignee>)", d.Id())
+	}
+
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return nil, fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	roleAssignmentsService, diags := GetRoleAssignmentsService(directoryService)
+	if diags.HasError() {
+		return nil, fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	roleId, assignee := parts[0], parts[1]
+
+	log.Printf("[DEBUG] Looking up RoleAssignment for role:%s, assignee:%s", roleId, assignee)
+
+	var roleAssignmentIds []string
+	err := roleAssignmentsService.List(client.Customer).RoleId(roleId).UserKey(assignee).Fields("nextPageToken", "items(roleAssignmentId)").Pages(ctx, func(resp *directory.RoleAssignments) error {
+		for _, ra := range resp.Items {
+			roleAssignmentIds = append(roleAssignmentIds, strconv.FormatInt(ra.RoleAssignmentId, 10))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(roleAssignmentIds) == 0 {
+		return nil, fmt.Errorf("no role assignment was found for role %s and assignee %s", roleId, assignee)
+	}
+
+	// the same role may be assigned in several scopes
+	if len(roleAssignmentIds) > 1 {
+		return nil, fmt.Errorf("role %s is assigned to %s more than once (%s), import it by role assignment id", roleId, assignee, strings.Join(roleAssignmentIds, ", "))
+	}
+
+	d.SetId(roleAssignmentIds[0])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccResourceRoleAssignment_basic(t *testing.T) {
@@ -40,10 +41,28 @@ func TestAccResourceRoleAssignment_basic(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"etag"},
 			},
+			{
+				ResourceName:            "googleworkspace_role_assignment.test",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccRoleAssignmentImportIdByAssignee("googleworkspace_role_assignment.test"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"etag"},
+			},
 		},
 	})
 }
 
+func testAccRoleAssignmentImportIdByAssignee(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["role_id"], rs.Primary.Attributes["assigned_to"]), nil
+	}
+}
+
 func TestAccResourceRoleAssignment_orgUnit_invalid(t *testing.T) {
 	t.Parallel()
 
@@ -65,7 +84,84 @@ func TestAccResourceRoleAssignment_orgUnit_invalid(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccRoleAssignment_orgUnit_invalid(data),
-				ExpectError: regexp.MustCompile("Attribute cannot be empty"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("'org_unit_id' or 'org_unit_path' must be set"),
+			},
+			{
+				Config:      testAccRoleAssignment_customer_invalid(data),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("'org_unit_id' and 'org_unit_path' must not be set"),
+			},
+		},
+	})
+}
+
+func TestAccResourceRoleAssignment_assignedToEmail(t *testing.T) {
+	t.Parallel()
+
+	data := map[string]interface{}{
+		"assignedTo": "my-service-account@my-project.iam.gserviceaccount.com",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRoleAssignment_assignedTo(data),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("is not a unique ID"),
+			},
+		},
+	})
+}
+
+func TestAccResourceRoleAssignment_rootOrgUnitPath(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRoleAssignment_rootOrgUnitPath(),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("is the root organization unit"),
+			},
+		},
+	})
+}
+
+func TestAccResourceRoleAssignment_serviceAccount(t *testing.T) {
+	t.Parallel()
+
+	serviceAccount := os.Getenv("GOOGLEWORKSPACE_IMPERSONATED_SERVICE_ACCOUNT")
+
+	if serviceAccount == "" {
+		t.Skip("GOOGLEWORKSPACE_IMPERSONATED_SERVICE_ACCOUNT needs to be set to run this test")
+	}
+
+	data := map[string]interface{}{
+		"serviceAccount": serviceAccount,
+		"roleName":       fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleAssignment_serviceAccount(data),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("googleworkspace_role_assignment.test", "assigned_to",
+						"data.googleworkspace_service_account.test", "unique_id"),
+				),
+			},
+			{
+				ResourceName:            "googleworkspace_role_assignment.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"etag"},
 			},
 		},
 	})
@@ -104,6 +200,43 @@ func TestAccResourceRoleAssignment_orgUnit(t *testing.T) {
 	})
 }
 
+func TestAccResourceRoleAssignment_orgUnitPath(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	data := map[string]interface{}{
+		"domainName": domainName,
+		"userEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"roleName":   fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"ouName":     fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":   acctest.RandString(10),
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleAssignment_orgUnitPath(data),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("googleworkspace_role_assignment.test", "org_unit_path", "googleworkspace_org_unit.test", "org_unit_path"),
+					resource.TestCheckResourceAttrSet("googleworkspace_role_assignment.test", "org_unit_id"),
+				),
+			},
+			{
+				ResourceName:            "googleworkspace_role_assignment.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"etag"},
+			},
+		},
+	})
+}
+
 func testAccRoleAssignment_basic(data map[string]interface{}) string {
 	return Nprintf(`
 resource "googleworkspace_user" "test" {
@@ -127,6 +260,67 @@ resource "googleworkspace_role_assignment" "test" {
 `, data)
 }
 
+func testAccRoleAssignment_assignedTo(data map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_role_assignment" "test" {
+  role_id     = "1234567890"
+  assigned_to = "%{assignedTo}"
+}
+`, data)
+}
+
+func testAccRoleAssignment_rootOrgUnitPath() string {
+	return `
+resource "googleworkspace_role_assignment" "test" {
+  role_id       = "1234567890"
+  assigned_to   = "1234567890"
+  scope_type    = "ORG_UNIT"
+  org_unit_path = "/"
+}
+`
+}
+
+func testAccRoleAssignment_serviceAccount(data map[string]interface{}) string {
+	return Nprintf(`
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/admin.directory.rolemanagement",
+    "https://www.googleapis.com/auth/cloud-platform",
+  ]
+}
+
+data "googleworkspace_privileges" "privileges" {}
+
+locals {
+  read_only_privileges = [
+    for priv in data.googleworkspace_privileges.privileges.items : priv
+    if length(regexall("READ", priv.privilege_name)) > 0
+  ]
+}
+
+resource "googleworkspace_role" "test" {
+  name = "%{roleName}"
+
+  dynamic "privileges" {
+    for_each = local.read_only_privileges
+    content {
+      service_id     = privileges.value["service_id"]
+      privilege_name = privileges.value["privilege_name"]
+    }
+  }
+}
+
+data "googleworkspace_service_account" "test" {
+  email = "%{serviceAccount}"
+}
+
+resource "googleworkspace_role_assignment" "test" {
+  role_id     = googleworkspace_role.test.id
+  assigned_to = data.googleworkspace_service_account.test.unique_id
+}
+`, data)
+}
+
 func testAccRoleAssignment_orgUnit_invalid(data map[string]interface{}) string {
 	return Nprintf(`
 data "googleworkspace_privileges" "privileges" {}
@@ -214,3 +408,75 @@ resource "googleworkspace_role_assignment" "test" {
 }
 `, data)
 }
+
+func testAccRoleAssignment_customer_invalid(data map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_user" "test" {
+  primary_email = "%{userEmail}@%{domainName}"
+  password = "%{password}"
+
+  name {
+    family_name = "Scott"
+    given_name = "Michael"
+  }
+}
+
+data "googleworkspace_role" "test" {
+  name = "_GROUPS_ADMIN_ROLE"
+}
+
+resource "googleworkspace_role_assignment" "test" {
+  role_id = data.googleworkspace_role.test.id
+  assigned_to = googleworkspace_user.test.id
+  scope_type = "CUSTOMER"
+  org_unit_path = "/"
+}
+`, data)
+}
+
+func testAccRoleAssignment_orgUnitPath(data map[string]interface{}) string {
+	return Nprintf(`
+data "googleworkspace_privileges" "privileges" {}
+
+locals {
+  org_scopable_privileges = [
+    for priv in data.googleworkspace_privileges.privileges.items : priv
+    if priv.is_org_unit_scopable
+  ]
+}
+
+resource "googleworkspace_role" "test" {
+  name = "%{roleName}"
+
+  dynamic "privileges" {
+    for_each = local.org_scopable_privileges
+    content {
+      service_id = privileges.value["service_id"]
+      privilege_name = privileges.value["privilege_name"]
+    }
+  }
+}
+
+resource "googleworkspace_user" "test" {
+  primary_email = "%{userEmail}@%{domainName}"
+  password = "%{password}"
+
+  name {
+    family_name = "Scott"
+    given_name = "Michael"
+  }
+}
+
+resource "googleworkspace_org_unit" "test" {
+  name = "%{ouName}"
+  parent_org_unit_path = "/"
+}
+
+resource "googleworkspace_role_assignment" "test" {
+  role_id = googleworkspace_role.test.id
+  assigned_to = googleworkspace_user.test.id
+  scope_type = "ORG_UNIT"
+  org_unit_path = googleworkspace_org_unit.test.org_unit_path
+}
+`, data)
+}
@@ -5,10 +5,13 @@ package googleworkspace
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	directory "google.golang.org/api/admin/directory/v1"
 )
 
 func TestAccResourceRole_basic(t *testing.T) {
@@ -69,6 +72,123 @@ func TestAccResourceRole_full(t *testing.T) {
 	})
 }
 
+func TestAccResourceRole_privilegesDrift(t *testing.T) {
+	t.Parallel()
+
+	roleName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	var roleId string
+
+	// grants the role an extra privilege, as in the Admin console
+	addPrivilege := func() {
+		client, err := googleworkspaceTestClient()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		directoryService, diags := client.NewDirectoryService()
+		if diags.HasError() {
+			t.Fatal(diags[0].Summary)
+		}
+
+		rolesService, diags := GetRolesService(directoryService)
+		if diags.HasError() {
+			t.Fatal(diags[0].Summary)
+		}
+
+		role, err := rolesService.Get(client.Customer, roleId).Do()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		privilegesService, diags := GetPrivilegesService(directoryService)
+		if diags.HasError() {
+			t.Fatal(diags[0].Summary)
+		}
+
+		privileges, err := privilegesService.List(client.Customer).Do()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, priv := range privileges.Items {
+			granted := false
+			for _, rp := range role.RolePrivileges {
+				if rp.ServiceId == priv.ServiceId && rp.PrivilegeName == priv.PrivilegeName {
+					granted = true
+					break
+				}
+			}
+			if granted {
+				continue
+			}
+
+			role.RolePrivileges = append(role.RolePrivileges, &directory.RoleRolePrivileges{
+				ServiceId:     priv.ServiceId,
+				PrivilegeName: priv.PrivilegeName,
+			})
+			break
+		}
+
+		_, err = rolesService.Update(client.Customer, roleId, role).Do()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRole_basic(roleName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_role.test", "privileges.#", "9"),
+					func(s *terraform.State) error {
+						rs, ok := s.RootModule().Resources["googleworkspace_role.test"]
+						if !ok {
+							return fmt.Errorf("can't find googleworkspace_role.test in state")
+						}
+						roleId = rs.Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				// the extra privilege is detected as drift
+				PreConfig:          addPrivilege,
+				Config:             testAccRole_basic(roleName, "test"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// and removed again on apply
+				Config: testAccRole_basic(roleName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_role.test", "privileges.#", "9"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceRole_invalidPrivilege(t *testing.T) {
+	t.Parallel()
+
+	roleName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRole_misspelledPrivilege(roleName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("did you mean USERS_RETRIEVE"),
+			},
+		},
+	})
+}
+
 func testAccRole_basic(name, description string) string {
 	return fmt.Sprintf(`
 data "googleworkspace_privileges" "privileges" {}
@@ -136,3 +256,25 @@ resource "googleworkspace_role" "test" {
 }
 `, name, description)
 }
+
+func testAccRole_misspelledPrivilege(name string) string {
+	return fmt.Sprintf(`
+data "googleworkspace_privileges" "privileges" {}
+
+locals {
+  users_retrieve = [
+    for priv in data.googleworkspace_privileges.privileges.items : priv
+    if priv.privilege_name == "USERS_RETRIEVE"
+  ][0]
+}
+
+resource "googleworkspace_role" "test" {
+  name = "%s"
+
+  privileges {
+    service_id     = local.users_retrieve.service_id
+    privilege_name = "USERS_RETREIVE"
+  }
+}
+`, name)
+}
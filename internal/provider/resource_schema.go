@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -34,9 +35,11 @@ func resourceSchema() *schema.Resource {
 		},
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceSchemaImport,
 		},
 
+		CustomizeDiff: resourceSchemaCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"schema_id": {
 				Description: "The unique identifier of the schema.",
@@ -50,16 +53,17 @@ func resourceSchema() *schema.Resource {
 				Required:    true,
 			},
 			"fields": {
-				Description: "A list of fields in the schema.",
-				Type:        schema.TypeList,
-				Required:    true,
+				Description: "A set of fields in the schema. Fields are identified by their `field_name`, so " +
+					"their order doesn't matter.",
+				Type:     schema.TypeSet,
+				Required: true,
+				Set:      schemaFieldHash,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"field_name": {
 							Description: "The name of the field.",
 							Type:        schema.TypeString,
 							Required:    true,
-							ForceNew:    true,
 						},
 						"field_id": {
 							Description: "The unique identifier of the field.",
@@ -74,10 +78,10 @@ func resourceSchema() *schema.Resource {
 								"\n\t- `EMAIL`" +
 								"\n\t- `INT64`" +
 								"\n\t- `PHONE`" +
-								"\n\t- `STRING`",
+								"\n\t- `STRING`" +
+								"\n\tChanging the type of an existing field forces a new schema.",
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
 								"BOOL", "DATE", "DOUBLE", "EMAIL", "INT64", "PHONE", "STRING"}, true)),
 						},
@@ -204,7 +208,7 @@ func resourceSchemaCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		return nil
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	log.Printf("[DEBUG] Finished creating Schema %q: %#v", d.Id(), schemaName)
@@ -233,7 +237,7 @@ func resourceSchemaRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 	schema, err := schemasService.Get(client.Customer, d.Id()).Do()
 	if err != nil {
-		return handleNotFoundError(err, d, schemaName)
+		return handleReadNotFoundError(err, d, schemaName)
 	}
 
 	if schema == nil {
@@ -295,7 +299,7 @@ func resourceSchemaUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		schemaObj.SchemaId = d.Id()
 
 		err := retryTimeDuration(ctx, d.Timeout(schema.TimeoutUpdate), func() error {
-			definedSchema, retryErr := schemasService.Update(client.Customer, d.Id(), &schemaObj).Do()
+			definedSchema, retryErr := schemasService.Patch(client.Customer, d.Id(), &schemaObj).Do()
 			if retryErr != nil {
 				return retryErr
 			}
@@ -304,7 +308,7 @@ func resourceSchemaUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			return nil
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return apiErrorDiagnostics(err)
 		}
 	}
 
@@ -349,10 +353,70 @@ func resourceSchemaDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	return diags
 }
 
+// resourceSchemaImport accepts the schema's name, as shown in the Admin console and used in custom_schemas,
+// as well as its ID
+func resourceSchemaImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return nil, fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	schemasService, diags := GetSchemasService(directoryService)
+	if diags.HasError() {
+		return nil, fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	log.Printf("[DEBUG] Resolving Schema %q for import", d.Id())
+
+	definedSchema, err := schemasService.Get(client.Customer, d.Id()).Fields("schemaId").Do()
+	if err != nil {
+		return nil, fmt.Errorf("error getting schema %s: %w", d.Id(), err)
+	}
+
+	d.SetId(definedSchema.SchemaId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// Fields can be added, removed or updated in place, but the type of an existing field can't be changed
+func resourceSchemaCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("fields") {
+		return nil
+	}
+
+	o, n := diff.GetChange("fields")
+
+	oldTypes := map[string]string{}
+	for _, f := range o.(*schema.Set).List() {
+		field := f.(map[string]interface{})
+		oldTypes[field["field_name"].(string)] = field["field_type"].(string)
+	}
+
+	for _, f := range n.(*schema.Set).List() {
+		field := f.(map[string]interface{})
+		oldType, ok := oldTypes[field["field_name"].(string)]
+		newType := field["field_type"].(string)
+
+		if ok && newType != "" && !strings.EqualFold(oldType, newType) {
+			return diff.ForceNew("fields")
+		}
+	}
+
+	return nil
+}
+
+// schemaFieldHash identifies fields by their name, so changes to any other attribute
+// are planned as an update of the existing field
+func schemaFieldHash(v interface{}) int {
+	return schema.HashString(v.(map[string]interface{})["field_name"].(string))
+}
+
 // Expand functions
 
 func expandFields(v interface{}) []*directory.SchemaFieldSpec {
-	fields := v.([]interface{})
+	fields := v.(*schema.Set).List()
 
 	if len(fields) == 0 {
 		return nil
@@ -362,6 +426,7 @@ func expandFields(v interface{}) []*directory.SchemaFieldSpec {
 
 	for _, field := range fields {
 		fieldObjs = append(fieldObjs, &directory.SchemaFieldSpec{
+			FieldId:             field.(map[string]interface{})["field_id"].(string),
 			FieldName:           field.(map[string]interface{})["field_name"].(string),
 			FieldType:           field.(map[string]interface{})["field_type"].(string),
 			MultiValued:         field.(map[string]interface{})["multi_valued"].(bool),
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccResourceSchema_basic(t *testing.T) {
@@ -29,6 +30,13 @@ func TestAccResourceSchema_basic(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"etag", "fields.0.etag"},
 			},
+			{
+				ResourceName:            "googleworkspace_schema.my-schema",
+				ImportState:             true,
+				ImportStateId:           schemaName,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"etag", "fields.0.etag"},
+			},
 		},
 	})
 }
@@ -64,6 +72,62 @@ func TestAccResourceSchema_full(t *testing.T) {
 	})
 }
 
+func TestAccResourceSchema_fieldOrder(t *testing.T) {
+	t.Parallel()
+
+	schemaName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSchema_full(schemaName),
+			},
+			{
+				// reordering the fields must not produce a diff
+				Config:   testAccResourceSchema_fullReordered(schemaName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceSchema_fieldUpdates(t *testing.T) {
+	t.Parallel()
+
+	schemaName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	var schemaId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSchema_full(schemaName),
+				Check: func(s *terraform.State) error {
+					schemaId = s.RootModule().Resources["googleworkspace_schema.my-schema"].Primary.ID
+					return nil
+				},
+			},
+			{
+				// adding and removing fields updates the schema in place
+				Config: testAccResourceSchema_fieldsAddedAndRemoved(schemaName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_schema.my-schema", "fields.#", "3"),
+					func(s *terraform.State) error {
+						if id := s.RootModule().Resources["googleworkspace_schema.my-schema"].Primary.ID; id != schemaId {
+							return fmt.Errorf("schema was recreated, id changed from %s to %s", schemaId, id)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func testAccResourceSchema_basic(schemaName string) string {
 	return fmt.Sprintf(`
 resource "googleworkspace_schema" "my-schema" {
@@ -141,3 +205,61 @@ resource "googleworkspace_schema" "my-schema" {
 }
 `, schemaName)
 }
+
+func testAccResourceSchema_fullReordered(schemaName string) string {
+	return fmt.Sprintf(`
+resource "googleworkspace_schema" "my-schema" {
+  schema_name = "%s-updated"
+  display_name = "schema test full"
+
+  fields {
+    field_name = "indexed"
+    field_type = "DOUBLE"
+    indexed = true
+  }
+
+  fields {
+    field_name = "birthday"
+    field_type = "DATE"
+    read_access_type = "ADMINS_AND_SELF"
+  }
+
+  fields {
+    field_name = "favorite_numbers"
+    field_type = "INT64"
+    multi_valued = true
+
+    numeric_indexing_spec {
+      min_value = 1.0
+      max_value = 10.5
+    }
+  }
+}
+`, schemaName)
+}
+
+func testAccResourceSchema_fieldsAddedAndRemoved(schemaName string) string {
+	return fmt.Sprintf(`
+resource "googleworkspace_schema" "my-schema" {
+  schema_name = "%s-updated"
+  display_name = "schema test full"
+
+  fields {
+    field_name = "birthday"
+    field_type = "DATE"
+    read_access_type = "ADMINS_AND_SELF"
+  }
+
+  fields {
+    field_name = "indexed"
+    field_type = "DOUBLE"
+    indexed = true
+  }
+
+  fields {
+    field_name = "nickname"
+    field_type = "STRING"
+  }
+}
+`, schemaName)
+}
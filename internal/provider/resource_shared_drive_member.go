@@ -0,0 +1,257 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"google.golang.org/api/drive/v3"
+)
+
+const sharedDrivePermissionFields = "id,type,role,emailAddress"
+
+func resourceSharedDriveMember() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Shared Drive Member resource manages the members of Google Workspace shared drives. Shared Drive " +
+			"Member resides under the `https://www.googleapis.com/auth/drive` client scope, which isn't one of the " +
+			"provider's default scopes and needs to be added to `oauth_scopes`.",
+
+		CreateContext: resourceSharedDriveMemberCreate,
+		ReadContext:   resourceSharedDriveMemberRead,
+		UpdateContext: resourceSharedDriveMemberUpdate,
+		DeleteContext: resourceSharedDriveMemberDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSharedDriveMemberImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"drive_id": {
+				Description: "The ID of the shared drive.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"email": {
+				Description: "The email address of the user or group the shared drive is shared with.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.EqualFold(old, new)
+				},
+			},
+			"type": {
+				Description: "The type of the member. Acceptable values are `user` and `group`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "user",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"user", "group"},
+					false)),
+			},
+			"role": {
+				Description: "The member's role in the shared drive. Acceptable values are:" +
+					"\n\t- `organizer`: Manager, can manage members and settings and delete files." +
+					"\n\t- `fileOrganizer`: Content manager, can add, edit, move and delete files." +
+					"\n\t- `writer`: Contributor, can add and edit files." +
+					"\n\t- `commenter`: Commenter, can view and comment on files." +
+					"\n\t- `reader`: Viewer, can view files.",
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"organizer", "fileOrganizer",
+					"writer", "commenter", "reader"}, false)),
+			},
+			"use_domain_admin_access": {
+				Description: "Issue the requests as a domain administrator, so the impersonated user doesn't need to be " +
+					"a member of the shared drive.",
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"send_notification_email": {
+				Description: "Whether to send an email to the member when they're added to the shared drive.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"permission_id": {
+				Description: "The ID of the shared drive permission of the member.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			// Adding a computed id simply to override the `optional` id that gets added in the SDK
+			// that will then display improperly in the docs
+			"id": {
+				Description: "The ID of this resource.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceSharedDriveMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	driveService, diags := client.NewDriveService()
+	if diags.HasError() {
+		return diags
+	}
+
+	permissionsService, diags := GetDrivePermissionsService(driveService)
+	if diags.HasError() {
+		return diags
+	}
+
+	driveId := d.Get("drive_id").(string)
+	email := d.Get("email").(string)
+	log.Printf("[DEBUG] Creating Shared Drive Member %q in shared drive %q", email, driveId)
+
+	permission, err := permissionsService.Create(driveId, &drive.Permission{
+		EmailAddress: email,
+		Type:         d.Get("type").(string),
+		Role:         d.Get("role").(string),
+	}).
+		SupportsAllDrives(true).
+		UseDomainAdminAccess(d.Get("use_domain_admin_access").(bool)).
+		SendNotificationEmail(d.Get("send_notification_email").(bool)).
+		Fields(sharedDrivePermissionFields).
+		Do()
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	d.SetId(fmt.Sprintf("drives/%s/permissions/%s", driveId, permission.Id))
+	d.Set("permission_id", permission.Id)
+
+	log.Printf("[DEBUG] Finished creating Shared Drive Member %q: %#v", d.Id(), email)
+
+	return resourceSharedDriveMemberRead(ctx, d, meta)
+}
+
+func resourceSharedDriveMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	driveService, diags := client.NewDriveService()
+	if diags.HasError() {
+		return diags
+	}
+
+	permissionsService, diags := GetDrivePermissionsService(driveService)
+	if diags.HasError() {
+		return diags
+	}
+
+	driveId := d.Get("drive_id").(string)
+	permissionId := d.Get("permission_id").(string)
+
+	log.Printf("[DEBUG] Getting Shared Drive Member %q", d.Id())
+
+	permission, err := permissionsService.Get(driveId, permissionId).
+		SupportsAllDrives(true).
+		UseDomainAdminAccess(d.Get("use_domain_admin_access").(bool)).
+		Fields(sharedDrivePermissionFields).
+		Do()
+	if err != nil {
+		return handleReadNotFoundError(err, d, d.Id())
+	}
+
+	d.Set("email", permission.EmailAddress)
+	d.Set("type", permission.Type)
+	d.Set("role", permission.Role)
+	d.Set("permission_id", permission.Id)
+	d.SetId(fmt.Sprintf("drives/%s/permissions/%s", driveId, permission.Id))
+
+	log.Printf("[DEBUG] Finished getting Shared Drive Member %q: %#v", d.Id(), permission.EmailAddress)
+
+	return diags
+}
+
+func resourceSharedDriveMemberUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	driveService, diags := client.NewDriveService()
+	if diags.HasError() {
+		return diags
+	}
+
+	permissionsService, diags := GetDrivePermissionsService(driveService)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Updating Shared Drive Member %q", d.Id())
+
+	if d.HasChange("role") {
+		_, err := permissionsService.Update(d.Get("drive_id").(string), d.Get("permission_id").(string), &drive.Permission{
+			Role: d.Get("role").(string),
+		}).
+			SupportsAllDrives(true).
+			UseDomainAdminAccess(d.Get("use_domain_admin_access").(bool)).
+			Fields(sharedDrivePermissionFields).
+			Do()
+		if err != nil {
+			return apiErrorDiagnostics(err)
+		}
+	}
+
+	log.Printf("[DEBUG] Finished updating Shared Drive Member %q", d.Id())
+
+	return resourceSharedDriveMemberRead(ctx, d, meta)
+}
+
+func resourceSharedDriveMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := meta.(*apiClient)
+
+	driveService, diags := client.NewDriveService()
+	if diags.HasError() {
+		return diags
+	}
+
+	permissionsService, diags := GetDrivePermissionsService(driveService)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Deleting Shared Drive Member %q", d.Id())
+
+	err := permissionsService.Delete(d.Get("drive_id").(string), d.Get("permission_id").(string)).
+		SupportsAllDrives(true).
+		UseDomainAdminAccess(d.Get("use_domain_admin_access").(bool)).
+		Do()
+	if err != nil {
+		return handleNotFoundError(err, d, d.Id())
+	}
+
+	log.Printf("[DEBUG] Finished deleting Shared Drive Member %q", d.Id())
+
+	return diags
+}
+
+func resourceSharedDriveMemberImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+
+	// id is of format "drives/<drive_id>/permissions/<permission_id>"
+	if len(parts) != 4 || parts[0] != "drives" || parts[2] != "permissions" {
+		return nil, fmt.Errorf("Shared Drive Member Id (%s) is not of the correct format (drives/<drive_id>/permissions/<permission_id>)", d.Id())
+	}
+
+	d.Set("drive_id", parts[1])
+	d.Set("permission_id", parts[3])
+	d.Set("use_domain_admin_access", true)
+	d.Set("send_notification_email", false)
+
+	return []*schema.ResourceData{d}, nil
+}
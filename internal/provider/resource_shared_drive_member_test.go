@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceSharedDriveMember_basic(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+	driveId := os.Getenv("GOOGLEWORKSPACE_SHARED_DRIVE_ID")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	if driveId == "" {
+		t.Skip("GOOGLEWORKSPACE_SHARED_DRIVE_ID needs to be set to run this test")
+	}
+
+	testMemberVals := map[string]interface{}{
+		"domainName": domainName,
+		"driveId":    driveId,
+		"groupEmail": fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"role":       "reader",
+	}
+
+	testMemberValsUpdate := map[string]interface{}{}
+	for k, v := range testMemberVals {
+		testMemberValsUpdate[k] = v
+	}
+	testMemberValsUpdate["role"] = "fileOrganizer"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSharedDriveMember(testMemberVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_shared_drive_member.member", "role", "reader"),
+					resource.TestCheckResourceAttr("googleworkspace_shared_drive_member.member", "type", "group"),
+				),
+			},
+			{
+				Config: testAccResourceSharedDriveMember(testMemberValsUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_shared_drive_member.member", "role", "fileOrganizer"),
+				),
+			},
+			{
+				ResourceName:      "googleworkspace_shared_drive_member.member",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceSharedDriveMember(testMemberVals map[string]interface{}) string {
+	return Nprintf(`
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/admin.directory.group",
+    "https://www.googleapis.com/auth/drive",
+  ]
+}
+
+resource "googleworkspace_group" "my-group" {
+  email = "%{groupEmail}@%{domainName}"
+}
+
+resource "googleworkspace_shared_drive_member" "member" {
+  drive_id = "%{driveId}"
+  email    = googleworkspace_group.my-group.email
+  type     = "group"
+  role     = "%{role}"
+}
+`, testMemberVals)
+}
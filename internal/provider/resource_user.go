@@ -8,11 +8,17 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"math"
 	"net/mail"
 	"reflect"
+	"regexp"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -114,7 +120,7 @@ func transformCustomSchemasTo2DMap(customSchemas []interface{}) map[string]map[s
 }
 
 func resourceUser() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		// This description is used by the documentation generator and the language server.
 		Description: "User resource manages Google Workspace Users. User resides " +
 			"under the `https://www.googleapis.com/auth/admin.directory.user` client scope.",
@@ -124,6 +130,10 @@ func resourceUser() *schema.Resource {
 		UpdateContext: resourceUserUpdate,
 		DeleteContext: resourceUserDelete,
 
+		CustomizeDiff: resourceUserCustomizeDiff,
+
+		SchemaVersion: 1,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
@@ -268,9 +278,6 @@ func resourceUser() *schema.Resource {
 								"Acceptable values: `custom`, `home`, `other`, `work`.",
 							Type:     schema.TypeString,
 							Required: true,
-							ValidateDiagFunc: validation.ToDiagFunc(
-								validation.StringInSlice([]string{"custom", "home", "other", "work"}, false),
-							),
 						},
 					},
 				},
@@ -295,10 +302,6 @@ func resourceUser() *schema.Resource {
 								"Acceptable values: `account`, `custom`, `customer`, `login_id`, `network`, `organization`.",
 							Type:     schema.TypeString,
 							Required: true,
-							ValidateDiagFunc: validation.ToDiagFunc(
-								validation.StringInSlice([]string{"account", "custom", "customer", "login_id",
-									"network", "organization"}, false),
-							),
 						},
 						"value": {
 							Description: "The value of the ID.",
@@ -331,12 +334,6 @@ func resourceUser() *schema.Resource {
 								"`spouse`.",
 							Type:     schema.TypeString,
 							Required: true,
-							ValidateDiagFunc: validation.ToDiagFunc(
-								validation.StringInSlice([]string{"admin_assistant", "assistant", "brother", "child",
-									"custom", "domestic_partner", "dotted_line_manager", "exec_assistant", "father",
-									"friend", "manager", "mother", "parent", "partner", "referred_by", "relative",
-									"sister"}, false),
-							),
 						},
 						"value": {
 							Description: "The name of the person the user is related to.",
@@ -354,6 +351,31 @@ func resourceUser() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"skip_alias_management": {
+				Description: "If true, the user's aliases are neither read nor written, for aliases owned by another " +
+					"system or automatically added for domain aliases. `aliases` can't be set along with it.",
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"aliases"},
+			},
+			"retain_old_email_as_alias": {
+				Description: "Whether the previous primary email is kept as an alias when `primary_email` changes. " +
+					"Google adds the alias automatically, it's tracked in `retained_aliases` rather than `aliases` " +
+					"when kept, and removed otherwise. Unlike for groups, Google adds the alias when a user is renamed, " +
+					"so it defaults to `true` where `googleworkspace_group` defaults to `false`, both keeping the API's behavior.",
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"retained_aliases": {
+				Description: "The previous primary emails kept as aliases, see `retain_old_email_as_alias`.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"is_mailbox_setup": {
 				Description: "Indicates if the user's Google mailbox is created. This property is only applicable " +
 					"if the user has been assigned a Gmail license.",
@@ -446,9 +468,6 @@ func resourceUser() *schema.Resource {
 								"Acceptable values: `custom`, `home`, `other`, `work`.",
 							Type:     schema.TypeString,
 							Required: true,
-							ValidateDiagFunc: validation.ToDiagFunc(
-								validation.StringInSlice([]string{"custom", "home", "other", "work"}, false),
-							),
 						},
 					},
 				},
@@ -524,9 +543,6 @@ func resourceUser() *schema.Resource {
 								"Acceptable values: `domain_only`, `school`, `unknown`, `work`.",
 							Type:     schema.TypeString,
 							Required: true,
-							ValidateDiagFunc: validation.ToDiagFunc(
-								validation.StringInSlice([]string{"domain_only", "school", "unknown", "work"}, false),
-							),
 						},
 					},
 				},
@@ -566,12 +582,6 @@ func resourceUser() *schema.Resource {
 								"`work_pager`.",
 							Type:     schema.TypeString,
 							Required: true,
-							ValidateDiagFunc: validation.ToDiagFunc(
-								validation.StringInSlice([]string{"assistant", "callback", "car", "company_main",
-									"custom", "grand_central", "home", "home_fax", "isdn", "main", "mobile", "other",
-									"other_fax", "pager", "radio", "telex", "tty_tdd", "work", "work_fax",
-									"work_mobile", "work_pager"}, false),
-							),
 						},
 						"value": {
 							Description: "A human-readable phone number. It may be in any telephone number format.",
@@ -603,30 +613,31 @@ func resourceUser() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						"custom_language": {
 							Description: "Other language. A user can provide their own language name if there is no " +
-								"corresponding Google III language code. If this is set, LanguageCode can't be set.",
+								"corresponding Google III language code. If this is set, `language_code` and " +
+								"`preference` can't be set.",
 							Type:     schema.TypeString,
 							Optional: true,
-							// TODO: (mbang) https://github.com/hashicorp/terraform-plugin-sdk/issues/470
-							//ExactlyOneOf: []string{"custom_language", "language_code"},
-							//ConflictsWith: []string{"custom_language", "preference"},
 						},
 						"language_code": {
 							Description: "Language Code. Should be used for storing Google III LanguageCode string " +
-								"representation for language. Illegal values cause SchemaException.",
-							Type:     schema.TypeString,
-							Optional: true,
-							Default:  "en",
-							// TODO: (mbang) https://github.com/hashicorp/terraform-plugin-sdk/issues/470
-							//ExactlyOneOf: []string{"custom_language", "language_code"},
+								"representation for language. Illegal values cause SchemaException. " +
+								"Ignored when `custom_language` is set.",
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          "en",
+							DiffSuppressFunc: diffSuppressCustomLanguage,
 						},
 						"preference": {
 							Description: "If present, controls whether the specified languageCode is the user's " +
-								"preferred language. Allowed values are `preferred` and `not_preferred`.",
-							Type:     schema.TypeString,
-							Optional: true,
-							Default:  "preferred",
-							// TODO: (mbang) https://github.com/hashicorp/terraform-plugin-sdk/issues/470
-							//ConflictsWith: []string{"custom_language", "preference"},
+								"preferred language. Allowed values are `preferred` and `not_preferred`. " +
+								"Ignored when `custom_language` is set.",
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          "preferred",
+							DiffSuppressFunc: diffSuppressCustomLanguage,
+							ValidateDiagFunc: validation.ToDiagFunc(
+								validation.StringInSlice([]string{"preferred", "not_preferred"}, false),
+							),
 						},
 					},
 				},
@@ -649,9 +660,10 @@ func resourceUser() *schema.Resource {
 							Optional:    true,
 						},
 						"gid": {
-							Description: "The default group ID.",
-							Type:        schema.TypeString,
-							Optional:    true,
+							Description:      "The default group ID.",
+							Type:             schema.TypeInt,
+							Optional:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, math.MaxInt32)),
 						},
 						"home_directory": {
 							Description: "The path to the home directory for this account.",
@@ -683,9 +695,13 @@ func resourceUser() *schema.Resource {
 							Optional:    true,
 						},
 						"uid": {
-							Description: "The POSIX compliant user ID.",
-							Type:        schema.TypeString,
-							Optional:    true,
+							Description: "The POSIX compliant user ID. Accounts shouldn't share a `uid` within " +
+								"the same `system_id` as other accounts of the user or other users of the plan. The API accepts " +
+								"duplicates, so they're only logged as warnings at plan time, unless the provider's " +
+								"`enforce_unique_posix_uids` is set. Users that aren't managed by the provider aren't checked.",
+							Type:             schema.TypeInt,
+							Optional:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, math.MaxInt32)),
 						},
 						"username": {
 							Description: "The username of the account.",
@@ -717,12 +733,24 @@ func resourceUser() *schema.Resource {
 				Description: "A list of SSH public keys. The maximum allowed data size is 10Kb.",
 				Type:        schema.TypeList,
 				Optional:    true,
+				// Computed so that CustomizeDiff can mark the expiration of changed keys as unknown
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"expiration_time_usec": {
-							Description: "An expiration time in microseconds since epoch.",
-							Type:        schema.TypeString,
-							Optional:    true,
+							Description: "An expiration time in microseconds since epoch. " +
+								"Computed from `expires` when it's set.",
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"expires": {
+							Description: "The expiration of the key, either as an RFC3339 timestamp such as " +
+								"`2030-01-01T00:00:00Z`, or as a duration such as `720h`. A duration is counted from " +
+								"when the key is added or `expires` is changed. Takes precedence over `expiration_time_usec`.",
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validateSshKeyExpires),
 						},
 						"fingerprint": {
 							Description: "A SHA-256 fingerprint of the SSH public key.",
@@ -730,9 +758,10 @@ func resourceUser() *schema.Resource {
 							Computed:    true,
 						},
 						"key": {
-							Description: "An SSH public key.",
-							Type:        schema.TypeString,
-							Required:    true,
+							Description:      "An SSH public key.",
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: diffSuppressSshPublicKey,
 						},
 					},
 				},
@@ -763,11 +792,6 @@ func resourceUser() *schema.Resource {
 								", `home`, `home_page`, `other`, `profile`, `reservations`, `resume`, `work`.",
 							Type:     schema.TypeString,
 							Required: true,
-							ValidateDiagFunc: validation.ToDiagFunc(
-								validation.StringInSlice([]string{"app_install_page", "blog", "custom", "ftp",
-									"home", "home_page", "other", "profile", "reservations", "resume", "work"},
-									false),
-							),
 						},
 						"value": {
 							Description: "The URL of the website.",
@@ -823,10 +847,6 @@ func resourceUser() *schema.Resource {
 								"Acceptable values: `custom`, `default`, `desk`",
 							Type:     schema.TypeString,
 							Required: true,
-							ValidateDiagFunc: validation.ToDiagFunc(
-								validation.StringInSlice([]string{"custom", "default", "desk"},
-									false),
-							),
 						},
 					},
 				},
@@ -859,10 +879,6 @@ func resourceUser() *schema.Resource {
 								"Acceptable values: `custom`, `mission`, `occupation`, `outlook`",
 							Type:     schema.TypeString,
 							Required: true,
-							ValidateDiagFunc: validation.ToDiagFunc(
-								validation.StringInSlice([]string{"custom", "mission", "occupation", "outlook"},
-									false),
-							),
 						},
 						"value": {
 							Description: "Keyword.",
@@ -924,18 +940,11 @@ func resourceUser() *schema.Resource {
 								"`msn`, `net_meeting`, `qq`, `skype`, `yahoo`.",
 							Type:     schema.TypeString,
 							Required: true,
-							ValidateDiagFunc: validation.ToDiagFunc(
-								validation.StringInSlice([]string{"aim", "custom_protocol", "gtalk", "icq",
-									"jabber", "msn", "net_meeting", "qq", "skype", "yahoo"}, false),
-							),
 						},
 						"type": {
 							Description: "Acceptable values: `custom`, `home`, `other`, `work`.",
 							Type:        schema.TypeString,
 							Required:    true,
-							ValidateDiagFunc: validation.ToDiagFunc(
-								validation.StringInSlice([]string{"custom", "home", "other", "work"}, false),
-							),
 						},
 					},
 				},
@@ -945,6 +954,7 @@ func resourceUser() *schema.Resource {
 				Type:             schema.TypeList,
 				Optional:         true,
 				DiffSuppressFunc: diffSuppressCustomSchemas,
+				ConflictsWith:    []string{"custom_schema_values"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"schema_name": {
@@ -967,6 +977,18 @@ func resourceUser() *schema.Resource {
 					},
 				},
 			},
+			"custom_schema_values": {
+				Description: "Custom fields of the user keyed by `<schema name>.<field name>`, as plain values that are " +
+					"converted to the type of the field in its schema definition, e.g. `\"3\"` for an `INT64` field. " +
+					"Multi-valued fields need to be set with `custom_schemas`.",
+				Type:          schema.TypeMap,
+				Optional:      true,
+				ConflictsWith: []string{"custom_schemas"},
+				ValidateFunc:  validateCustomSchemaValuesKeys,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"is_enrolled_in_2_step_verification": {
 				Description: "Is enrolled in 2-step verification.",
 				Type:        schema.TypeBool,
@@ -990,219 +1012,742 @@ func resourceUser() *schema.Resource {
 				Computed: true,
 			},
 			"recovery_email": {
-				Description: "Recovery email of the user.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description: "Recovery email of the user. The address can't be in one of the customer's " +
+					"domains or domain aliases.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateRecoveryEmail,
 			},
 			"recovery_phone": {
 				Description: "Recovery phone of the user. The phone number must be in the E.164 format, " +
 					"starting with the plus sign (+). Example: +16506661212.",
 				Type:     schema.TypeString,
 				Optional: true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`),
+					"must be in the E.164 format, e.g. +16506661212")),
 			},
 		},
 	}
-}
 
-func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
+	r.StateUpgraders = []schema.StateUpgrader{
+		{
+			Type:    resourceUserResourceV0(r).CoreConfigSchema().ImpliedType(),
+			Upgrade: resourceUserStateUpgradeV0,
+			Version: 0,
+		},
+	}
 
-	// use the meta value to retrieve your client from the provider configure method
-	client := meta.(*apiClient)
+	return r
+}
 
-	if d.Get("password").(string) == "" {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  fmt.Sprintf("Password is required when creating a new user"),
-		})
+// resourceUserResourceV0 returns the schema of the user resource before the uid and gid of posix_accounts
+// were integers
+func resourceUserResourceV0(r *schema.Resource) *schema.Resource {
+	userSchema := make(map[string]*schema.Schema, len(r.Schema))
+	for k, v := range r.Schema {
+		userSchema[k] = v
+	}
 
-		return diags
+	posixAccounts := *r.Schema["posix_accounts"]
+	posixAccountSchema := make(map[string]*schema.Schema)
+	for k, v := range posixAccounts.Elem.(*schema.Resource).Schema {
+		posixAccountSchema[k] = v
+	}
+	for _, k := range []string{"gid", "uid"} {
+		posixAccountSchema[k] = &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		}
 	}
+	posixAccounts.Elem = &schema.Resource{Schema: posixAccountSchema}
+	userSchema["posix_accounts"] = &posixAccounts
 
-	primaryEmail := d.Get("primary_email").(string)
-	log.Printf("[DEBUG] Creating User %q: %#v", d.Id(), primaryEmail)
+	return &schema.Resource{Schema: userSchema}
+}
 
-	directoryService, diags := client.NewDirectoryService()
-	if diags.HasError() {
-		return diags
-	}
+// resourceUserStateUpgradeV0 converts the uid and gid of posix_accounts from strings to integers, unset ones
+// being stored as empty strings
+func resourceUserStateUpgradeV0(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	posixAccounts, _ := rawState["posix_accounts"].([]interface{})
+	for i, v := range posixAccounts {
+		account, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
 
-	usersService, diags := GetUsersService(directoryService)
-	if diags.HasError() {
-		return diags
-	}
+		for _, k := range []string{"gid", "uid"} {
+			id, ok := account[k].(string)
+			if !ok {
+				continue
+			}
 
-	userObj := directory.User{
-		PrimaryEmail:               primaryEmail,
-		Password:                   d.Get("password").(string),
-		HashFunction:               d.Get("hash_function").(string),
-		Suspended:                  d.Get("suspended").(bool),
-		ChangePasswordAtNextLogin:  d.Get("change_password_at_next_login").(bool),
-		IpWhitelisted:              d.Get("ip_allowlist").(bool),
-		Name:                       expandName(d.Get("name")),
-		Emails:                     expandInterfaceObjects(d.Get("emails")),
-		ExternalIds:                expandInterfaceObjects(d.Get("external_ids")),
-		Relations:                  expandInterfaceObjects(d.Get("relations")),
-		Addresses:                  expandInterfaceObjects(d.Get("addresses")),
-		Organizations:              expandInterfaceObjects(d.Get("organizations")),
-		Phones:                     expandInterfaceObjects(d.Get("phones")),
-		Languages:                  expandInterfaceObjects(d.Get("languages")),
-		PosixAccounts:              expandInterfaceObjects(d.Get("posix_accounts")),
-		SshPublicKeys:              expandInterfaceObjects(d.Get("ssh_public_keys")),
-		Websites:                   expandInterfaceObjects(d.Get("websites")),
-		Locations:                  expandInterfaceObjects(d.Get("locations")),
-		IncludeInGlobalAddressList: d.Get("include_in_global_address_list").(bool),
-		Keywords:                   expandInterfaceObjects(d.Get("keywords")),
-		Ims:                        expandInterfaceObjects(d.Get("ims")),
-		Archived:                   d.Get("archived").(bool),
-		OrgUnitPath:                d.Get("org_unit_path").(string),
-		RecoveryEmail:              d.Get("recovery_email").(string),
-		RecoveryPhone:              d.Get("recovery_phone").(string),
+			if id == "" {
+				account[k] = 0
+				continue
+			}
 
-		ForceSendFields: []string{"IncludeInGlobalAddressList"},
-	}
+			n, err := strconv.Atoi(id)
+			if err != nil {
+				return nil, fmt.Errorf("posix_accounts.%d.%s %q isn't a number: %w", i, k, id, err)
+			}
 
-	if len(d.Get("custom_schemas").([]interface{})) > 0 {
-		diags = validateCustomSchemas(d, client)
-		if diags.HasError() {
-			return diags
+			account[k] = n
 		}
+	}
 
-		customSchemas, diags := expandCustomSchemaValues(d.Get("custom_schemas").([]interface{}))
-		if diags.HasError() {
-			return diags
-		}
+	return rawState, nil
+}
 
-		userObj.CustomSchemas = customSchemas
+func resourceUserCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if err := validateUserLanguages(diff); err != nil {
+		return err
 	}
 
-	user, err := usersService.Insert(&userObj).Do()
-	if err != nil {
-		return diag.FromErr(err)
-	}
+	client, _ := meta.(*apiClient)
 
-	d.SetId(user.Id)
+	var plannedUids *plannedPosixUids
+	enforceUniquePosixUids := false
+	if client != nil {
+		plannedUids = &client.plannedPosixUids
+		enforceUniquePosixUids = client.EnforceUniquePosixUids
+	}
 
-	// INSERT will respond with the User that will be created, however, it is eventually consistent
-	// After INSERT, the etag is updated along with the User (and any aliases),
-	// once we get a consistent etag, we can feel confident that our User is also consistent
-	cc := consistencyCheck{
-		resourceType: "user",
-		timeout:      d.Timeout(schema.TimeoutCreate),
+	if err := validateUserPosixUids(diff, plannedUids, enforceUniquePosixUids); err != nil {
+		return err
 	}
-	err = retryTimeDuration(ctx, d.Timeout(schema.TimeoutCreate), func() error {
-		var retryErr error
 
-		if cc.reachedConsistency(1) {
-			return nil
+	if client == nil || !client.SkipUserTypeValidation {
+		if err := validateUserTypes(diff); err != nil {
+			return err
 		}
+	}
 
-		newUser, retryErr := usersService.Get(d.Id()).IfNoneMatch(cc.lastEtag).Do()
-		if googleapi.IsNotModified(retryErr) {
-			cc.currConsistent += 1
-		} else if isNotFound(retryErr) {
-			// user was not found yet therefore setting currConsistent back to null value
-			cc.currConsistent = 0
-		} else if retryErr != nil {
-			return fmt.Errorf("unexpected error during retries of %s: %s", cc.resourceType, retryErr)
-		} else {
-			cc.handleNewEtag(newUser.Etag)
+	if client != nil {
+		if err := checkRecoveryEmailDomain(ctx, diff, client); err != nil {
+			return err
 		}
+	}
 
-		return fmt.Errorf("timed out while waiting for %s to be inserted", cc.resourceType)
-	})
-
-	if err != nil {
-		return diag.FromErr(err)
+	if err := customizeDiffSshPublicKeys(diff); err != nil {
+		return err
 	}
 
-	diags = resourceUserUpdate(ctx, d, meta)
-	if diags.HasError() {
-		return diags
+	if client != nil && len(client.UniqueExternalIdCustomTypes) > 0 {
+		return checkUniqueExternalIds(ctx, diff, client)
 	}
 
-	log.Printf("[DEBUG] Finished creating User %q: %#v", d.Id(), primaryEmail)
-	return resourceUserRead(ctx, d, meta)
+	return nil
 }
 
-func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+// validateRecoveryEmail checks the recovery email is a bare address, as the API rejects display names
+func validateRecoveryEmail(v interface{}, path cty.Path) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	// use the meta value to retrieve your client from the provider configure method
-	client := meta.(*apiClient)
+	email := v.(string)
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("%q is not a valid email address", email),
+			AttributePath: path,
+		})
+	}
 
-	primaryEmail := d.Get("primary_email").(string)
-	log.Printf("[DEBUG] Getting User %q: %#v", d.Id(), primaryEmail)
+	return diags
+}
+
+// checkRecoveryEmailDomain checks the recovery email isn't in one of the customer's domains, which the API
+// only rejects mid-apply
+func checkRecoveryEmailDomain(ctx context.Context, diff *schema.ResourceDiff, client *apiClient) error {
+	if !diff.HasChange("recovery_email") || !diff.NewValueKnown("recovery_email") {
+		return nil
+	}
+
+	recoveryEmail := diff.Get("recovery_email").(string)
+	at := strings.LastIndex(recoveryEmail, "@")
+	if at == -1 {
+		return nil
+	}
+	recoveryDomain := strings.ToLower(recoveryEmail[at+1:])
+
+	primaryEmail := diff.Get("primary_email").(string)
+	if strings.HasSuffix(strings.ToLower(primaryEmail), "@"+recoveryDomain) {
+		return fmt.Errorf("recovery_email %q can't be in the user's own domain", recoveryEmail)
+	}
 
+	// the customer's domains are only checked when they can be listed, the API rejects them when applying anyway
 	directoryService, diags := client.NewDirectoryService()
 	if diags.HasError() {
-		return diags
+		log.Printf("[WARN] unable to check the domain of recovery_email %q: %s", recoveryEmail, diags[0].Summary)
+		return nil
 	}
 
-	usersService, diags := GetUsersService(directoryService)
+	domainsService, diags := GetDomainsService(directoryService)
 	if diags.HasError() {
-		return diags
+		log.Printf("[WARN] unable to check the domain of recovery_email %q: %s", recoveryEmail, diags[0].Summary)
+		return nil
 	}
 
-	user, err := usersService.Get(d.Id()).Projection("full").Do()
+	domains, err := domainsService.List(client.Customer).Context(ctx).Do()
 	if err != nil {
-		return handleNotFoundError(err, d, primaryEmail)
+		log.Printf("[WARN] unable to check the domain of recovery_email %q: %s", recoveryEmail, err)
+		return nil
 	}
 
-	if user == nil {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  fmt.Sprintf("No user was returned for %s.", d.Get("primary_email").(string)),
-		})
+	for _, domain := range domains.Domains {
+		managed := []string{domain.DomainName}
+		for _, alias := range domain.DomainAliases {
+			managed = append(managed, alias.DomainAliasName)
+		}
 
-		return diags
+		for _, name := range managed {
+			if strings.ToLower(name) == recoveryDomain {
+				return fmt.Errorf("recovery_email %q can't be in %s, a domain of the customer", recoveryEmail, name)
+			}
+		}
 	}
 
-	customSchemas := []map[string]interface{}{}
-	if len(user.CustomSchemas) > 0 {
-		customSchemas, diags = flattenCustomSchemas(user.CustomSchemas, client)
-		if diags.HasError() {
-			return diags
+	return nil
+}
+
+// plannedExternalIds holds the unique external ids planned by the users of this plan, keyed by custom type
+// and value, so users claiming the same id within one plan are caught before either exists in the directory
+type plannedExternalIds struct {
+	mu sync.Mutex
+
+	// owners maps the external ids planned to the primary email, or ID, of the user planning them
+	owners map[string]string
+	// kept maps the ID of the existing users planned to the external ids they keep
+	kept map[string]map[string]bool
+}
+
+// claim records the external ids planned by a user, and returns the index of the first one already planned by
+// another user along with that user, or -1
+func (p *plannedExternalIds) claim(userId, owner string, keys []string) (int, string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if userId != "" {
+		if p.kept == nil {
+			p.kept = make(map[string]map[string]bool)
+		}
+
+		p.kept[userId] = make(map[string]bool)
+		for _, key := range keys {
+			p.kept[userId][key] = true
 		}
 	}
 
-	d.Set("primary_email", user.PrimaryEmail)
-	// password and hash_function are not returned in the response, so set them to what we defined in the config
-	d.Set("password", d.Get("password"))
-	d.Set("hash_function", d.Get("hash_function"))
-	d.Set("is_admin", user.IsAdmin)
-	d.Set("is_delegated_admin", user.IsDelegatedAdmin)
-	d.Set("agreed_to_terms", user.AgreedToTerms)
-	d.Set("suspended", user.Suspended)
-	d.Set("change_password_at_next_login", user.ChangePasswordAtNextLogin)
-	d.Set("ip_allowlist", user.IpWhitelisted)
-	d.Set("name", flattenName(user.Name))
-	d.Set("emails", flattenInterfaceObjects(user.Emails))
-	d.Set("external_ids", flattenInterfaceObjects(user.ExternalIds))
-	d.Set("relations", flattenInterfaceObjects(user.Relations))
-	d.Set("etag", user.Etag)
-	d.Set("aliases", user.Aliases)
-	d.Set("is_mailbox_setup", user.IsMailboxSetup)
-	d.Set("customer_id", user.CustomerId)
-	d.Set("addresses", flattenInterfaceObjects(user.Addresses))
-	d.Set("organizations", flattenInterfaceObjects(user.Organizations))
-	d.Set("last_login_time", user.LastLoginTime)
-	d.Set("phones", flattenInterfaceObjects(user.Phones))
-	d.Set("suspension_reason", user.SuspensionReason)
-	d.Set("thumbnail_photo_url", user.ThumbnailPhotoUrl)
-	d.Set("languages", flattenInterfaceObjects(user.Languages))
-	d.Set("posix_accounts", flattenInterfaceObjects(user.PosixAccounts))
-	d.Set("creation_time", user.CreationTime)
-	d.Set("non_editable_aliases", user.NonEditableAliases)
-	d.Set("ssh_public_keys", flattenInterfaceObjects(user.SshPublicKeys))
-	d.Set("websites", flattenInterfaceObjects(user.Websites))
-	d.Set("locations", flattenInterfaceObjects(user.Locations))
-	d.Set("include_in_global_address_list", user.IncludeInGlobalAddressList)
-	d.Set("keywords", flattenInterfaceObjects(user.Keywords))
+	if owner == "" {
+		return -1, ""
+	}
+
+	if p.owners == nil {
+		p.owners = make(map[string]string)
+	}
+
+	for i, key := range keys {
+		if planned, ok := p.owners[key]; ok && planned != owner {
+			return i, planned
+		}
+		p.owners[key] = owner
+	}
+
+	return -1, ""
+}
+
+// removes reports whether the existing user is planned without the external id
+func (p *plannedExternalIds) removes(userId, key string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	kept, ok := p.kept[userId]
+	return ok && !kept[key]
+}
+
+// checkUniqueExternalIds checks that the external ids of the provider's unique_external_id_custom_types
+// aren't used by another user of the plan or of the directory. Users of the directory planned without the
+// id are ignored, which can only be known once they have been planned, as Terraform plans users in no
+// particular order.
+func checkUniqueExternalIds(ctx context.Context, diff *schema.ResourceDiff, client *apiClient) error {
+	if !diff.NewValueKnown("external_ids") {
+		return nil
+	}
+
+	var keys, customTypes, values []string
+	for _, v := range diff.Get("external_ids").([]interface{}) {
+		externalId, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		customType, _ := externalId["custom_type"].(string)
+		value, _ := externalId["value"].(string)
+		if externalId["type"] != "custom" || value == "" || !stringInSlice(client.UniqueExternalIdCustomTypes, customType) {
+			continue
+		}
+
+		keys = append(keys, customType+"/"+value)
+		customTypes = append(customTypes, customType)
+		values = append(values, value)
+	}
+
+	// users whose primary_email isn't known yet are told apart by their ID, new ones are only checked in the directory
+	owner := diff.Id()
+	if diff.NewValueKnown("primary_email") {
+		owner = strings.ToLower(diff.Get("primary_email").(string))
+	}
+
+	if i, planned := client.plannedExternalIds.claim(diff.Id(), owner, keys); i != -1 {
+		return fmt.Errorf("external id %s %q is also planned for %s", customTypes[i], values[i], planned)
+	}
+
+	if diff.Id() != "" && !diff.HasChange("external_ids") {
+		return nil
+	}
+
+	var usersService *directory.UsersService
+	for i, key := range keys {
+		customType, value := customTypes[i], values[i]
+
+		if usersService == nil {
+			directoryService, diags := client.NewDirectoryService()
+			if diags.HasError() {
+				log.Printf("[WARN] unable to check external id %s %q in the directory: %s", customType, value, diags[0].Summary)
+				return nil
+			}
+
+			usersService, diags = GetUsersService(directoryService)
+			if diags.HasError() {
+				log.Printf("[WARN] unable to check external id %s %q in the directory: %s", customType, value, diags[0].Summary)
+				return nil
+			}
+		}
+
+		var conflict error
+		query := fmt.Sprintf("externalId=%s", celStringLiteral(value))
+		err := usersService.List().Customer(client.Customer).Query(query).MaxResults(usersMaxResults).
+			Fields("nextPageToken", "users(id,primaryEmail,externalIds)").Pages(ctx, func(resp *directory.Users) error {
+			for _, user := range resp.Users {
+				if user.Id == diff.Id() || !hasExternalId(user, customType, value) ||
+					client.plannedExternalIds.removes(user.Id, key) {
+					continue
+				}
+
+				conflict = fmt.Errorf("external id %s %q is already used by %s", customType, value, user.PrimaryEmail)
+				return conflict
+			}
+
+			return nil
+		})
+		if conflict != nil {
+			return conflict
+		}
+		if err != nil {
+			log.Printf("[WARN] unable to check external id %s %q in the directory: %s", customType, value, err)
+		}
+	}
+
+	return nil
+}
+
+// hasExternalId reports whether the user has the given custom external id, as the search matches ids of any type
+func hasExternalId(user *directory.User, customType, value string) bool {
+	externalIds, ok := flattenInterfaceObjects(user.ExternalIds).([]map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	for _, externalId := range externalIds {
+		if externalId["type"] == "custom" && externalId["custom_type"] == customType && externalId["value"] == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// The sub-block types are validated at plan time rather than by the schema, so the validation can be
+// skipped with the provider's skip_user_type_validation when the API supports values the provider doesn't know yet
+func validateUserTypes(diff *schema.ResourceDiff) error {
+	attributes := make([]string, 0, len(userTypeValues))
+	for attribute := range userTypeValues {
+		attributes = append(attributes, attribute)
+	}
+	sort.Strings(attributes)
+
+	for _, attribute := range attributes {
+		parts := strings.SplitN(attribute, ".", 2)
+		block, field := parts[0], parts[1]
+
+		for i, v := range diff.Get(block).([]interface{}) {
+			obj, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			// unknown values are validated once they're known
+			value, _ := obj[field].(string)
+			if value == "" || stringInSlice(userTypeValues[attribute], value) {
+				continue
+			}
+
+			return fmt.Errorf("expected %s.%d.%s to be one of %q, got %s", block, i, field, userTypeValues[attribute], value)
+		}
+	}
+
+	return nil
+}
+
+// A language is either a custom language, or a language code with a preference. language_code and
+// preference have defaults, so only the raw config tells whether they were set along with custom_language.
+func validateUserLanguages(diff *schema.ResourceDiff) error {
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+
+	languages := rawConfig.GetAttr("languages")
+	if languages.IsNull() || !languages.IsKnown() {
+		return nil
+	}
+
+	for i, language := range languages.AsValueSlice() {
+		if !language.IsKnown() || language.IsNull() || language.GetAttr("custom_language").IsNull() {
+			continue
+		}
+
+		if !language.GetAttr("language_code").IsNull() {
+			return fmt.Errorf("languages.%d: only one of `custom_language,language_code` can be specified", i)
+		}
+
+		if !language.GetAttr("preference").IsNull() {
+			return fmt.Errorf("languages.%d: `preference` can't be specified with `custom_language`", i)
+		}
+	}
+
+	return nil
+}
+
+// plannedPosixUids holds the uids planned by the users of this plan, keyed by system_id and uid
+type plannedPosixUids struct {
+	mu sync.Mutex
+
+	// owners maps the uids planned to the primary email, or ID, of the user planning them
+	owners map[string]string
+}
+
+// claim records the uids planned by a user, and returns the first one already planned by another user
+// along with that user, or an empty key
+func (p *plannedPosixUids) claim(owner string, keys []string) (string, string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.owners == nil {
+		p.owners = make(map[string]string)
+	}
+
+	for _, key := range keys {
+		if planned, ok := p.owners[key]; ok && planned != owner {
+			return key, planned
+		}
+		p.owners[key] = owner
+	}
+
+	return "", ""
+}
+
+// The API accepts the same uid for several accounts of a system, which breaks consumers such as LDAP or
+// OS Login unless it's on purpose, so uids are checked within the user and against the other users of the
+// plan. Duplicates are logged as warnings, CustomizeDiff can't return them, and fail the plan if enforce is set.
+func validateUserPosixUids(diff *schema.ResourceDiff, planned *plannedPosixUids, enforce bool) error {
+	if !diff.NewValueKnown("posix_accounts") {
+		return nil
+	}
+
+	uids := map[string]int{}
+	keys := []string{}
+	for i, v := range diff.Get("posix_accounts").([]interface{}) {
+		account, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		uid, _ := account["uid"].(int)
+		if uid == 0 {
+			continue
+		}
+
+		key := fmt.Sprintf("%s/%d", account["system_id"], uid)
+		if j, ok := uids[key]; ok {
+			err := fmt.Errorf("posix_accounts.%d and posix_accounts.%d have the same uid %d within system_id %q", j, i, uid, account["system_id"])
+			if enforce {
+				return err
+			}
+
+			log.Printf("[WARN] %s", err)
+			continue
+		}
+		uids[key] = i
+		keys = append(keys, key)
+	}
+
+	if planned == nil || len(keys) == 0 {
+		return nil
+	}
+
+	// users whose primary_email isn't known yet are told apart by their ID, new ones can't be checked
+	owner := diff.Id()
+	if diff.NewValueKnown("primary_email") {
+		owner = strings.ToLower(diff.Get("primary_email").(string))
+	}
+	if owner == "" {
+		return nil
+	}
+
+	if key, user := planned.claim(owner, keys); key != "" {
+		i := uids[key]
+		account := diff.Get(fmt.Sprintf("posix_accounts.%d", i)).(map[string]interface{})
+		err := fmt.Errorf("posix_accounts.%d: uid %d within system_id %q is also planned for %s", i, account["uid"], account["system_id"], user)
+		if enforce {
+			return err
+		}
+
+		log.Printf("[WARN] %s", err)
+	}
+
+	return nil
+}
+
+// language_code and preference are defaulted, but custom languages are sent and returned without them
+func diffSuppressCustomLanguage(k, old, new string, d *schema.ResourceData) bool {
+	prefix := k[:strings.LastIndex(k, ".")]
+	return d.Get(prefix+".custom_language").(string) != ""
+}
+
+func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// use the meta value to retrieve your client from the provider configure method
+	client := meta.(*apiClient)
+
+	if d.Get("password").(string) == "" {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Password is required when creating a new user"),
+		})
+
+		return diags
+	}
+
+	primaryEmail := d.Get("primary_email").(string)
+	log.Printf("[DEBUG] Creating User %q: %#v", d.Id(), primaryEmail)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	usersService, diags := GetUsersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	userObj := directory.User{
+		PrimaryEmail:               primaryEmail,
+		Password:                   d.Get("password").(string),
+		HashFunction:               d.Get("hash_function").(string),
+		Suspended:                  d.Get("suspended").(bool),
+		ChangePasswordAtNextLogin:  d.Get("change_password_at_next_login").(bool),
+		IpWhitelisted:              d.Get("ip_allowlist").(bool),
+		Name:                       expandName(d.Get("name")),
+		Emails:                     expandInterfaceObjects(d.Get("emails")),
+		ExternalIds:                expandInterfaceObjects(d.Get("external_ids")),
+		Relations:                  expandInterfaceObjects(d.Get("relations")),
+		Addresses:                  expandInterfaceObjects(d.Get("addresses")),
+		Organizations:              expandInterfaceObjects(d.Get("organizations")),
+		Phones:                     expandInterfaceObjects(d.Get("phones")),
+		Languages:                  expandUserLanguages(d.Get("languages")),
+		PosixAccounts:              expandUserPosixAccounts(d.Get("posix_accounts")),
+		SshPublicKeys:              expandUserSshPublicKeys(nil, d.Get("ssh_public_keys"), time.Now()),
+		Websites:                   expandInterfaceObjects(d.Get("websites")),
+		Locations:                  expandInterfaceObjects(d.Get("locations")),
+		IncludeInGlobalAddressList: d.Get("include_in_global_address_list").(bool),
+		Keywords:                   expandInterfaceObjects(d.Get("keywords")),
+		Ims:                        expandInterfaceObjects(d.Get("ims")),
+		Archived:                   d.Get("archived").(bool),
+		OrgUnitPath:                d.Get("org_unit_path").(string),
+		RecoveryEmail:              d.Get("recovery_email").(string),
+		RecoveryPhone:              d.Get("recovery_phone").(string),
+
+		ForceSendFields: []string{"IncludeInGlobalAddressList"},
+	}
+
+	if len(d.Get("custom_schemas").([]interface{})) > 0 {
+		diags = validateCustomSchemas(d, client)
+		if diags.HasError() {
+			return diags
+		}
+
+		customSchemas, diags := expandCustomSchemaValues(d.Get("custom_schemas").([]interface{}))
+		if diags.HasError() {
+			return diags
+		}
+
+		userObj.CustomSchemas = customSchemas
+	}
+
+	if customSchemaValues := d.Get("custom_schema_values").(map[string]interface{}); len(customSchemaValues) > 0 {
+		customSchemas, diags := expandCustomSchemaValuesMap(nil, customSchemaValues, client)
+		if diags.HasError() {
+			return diags
+		}
+
+		userObj.CustomSchemas = customSchemas
+	}
+
+	user, err := usersService.Insert(&userObj).Do()
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	d.SetId(user.Id)
+
+	// INSERT will respond with the User that will be created, however, it is eventually consistent
+	// After INSERT, the etag is updated along with the User (and any aliases),
+	// once we get a consistent etag, we can feel confident that our User is also consistent
+	cc := consistencyCheck{
+		resourceType: "user",
+		timeout:      d.Timeout(schema.TimeoutCreate),
+	}
+	err = retryConsistencyCheck(ctx, d.Timeout(schema.TimeoutCreate), func() error {
+		var retryErr error
+
+		if cc.reachedConsistency(1) {
+			return nil
+		}
+
+		newUser, retryErr := usersService.Get(d.Id()).Fields(consistencyCheckFields).IfNoneMatch(cc.lastEtag).Do()
+		if googleapi.IsNotModified(retryErr) {
+			cc.currConsistent += 1
+		} else if isNotFound(retryErr) {
+			// user was not found yet therefore setting currConsistent back to null value
+			cc.currConsistent = 0
+		} else if retryErr != nil {
+			return fmt.Errorf("unexpected error during retries of %s: %s", cc.resourceType, retryErr)
+		} else {
+			cc.handleNewEtag(newUser.Etag)
+		}
+
+		return fmt.Errorf("timed out while waiting for %s to be inserted", cc.resourceType)
+	})
+
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	diags = resourceUserUpdate(ctx, d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Finished creating User %q: %#v", d.Id(), primaryEmail)
+	return append(diags, resourceUserRead(ctx, d, meta)...)
+}
+
+func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// use the meta value to retrieve your client from the provider configure method
+	client := meta.(*apiClient)
+
+	primaryEmail := d.Get("primary_email").(string)
+	log.Printf("[DEBUG] Getting User %q: %#v", d.Id(), primaryEmail)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	usersService, diags := GetUsersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	user, err := usersService.Get(d.Id()).Projection("full").Do()
+	if err != nil {
+		return handleReadNotFoundError(err, d, primaryEmail)
+	}
+
+	if user == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("No user was returned for %s.", d.Get("primary_email").(string)),
+		})
+
+		return diags
+	}
+
+	// custom fields are read into the attribute they're managed with
+	customSchemas := []map[string]interface{}{}
+	customSchemaValues := map[string]interface{}{}
+	if _, ok := d.GetOk("custom_schema_values"); ok {
+		customSchemaValues = flattenCustomSchemaValuesMap(user.CustomSchemas)
+	} else if len(user.CustomSchemas) > 0 {
+		customSchemas, diags = flattenCustomSchemas(user.CustomSchemas, client)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	d.Set("primary_email", user.PrimaryEmail)
+	// password and hash_function are not returned in the response, so set them to what we defined in the config
+	d.Set("password", d.Get("password"))
+	d.Set("hash_function", d.Get("hash_function"))
+	d.Set("is_admin", user.IsAdmin)
+	d.Set("is_delegated_admin", user.IsDelegatedAdmin)
+	d.Set("agreed_to_terms", user.AgreedToTerms)
+	d.Set("suspended", user.Suspended)
+	d.Set("change_password_at_next_login", user.ChangePasswordAtNextLogin)
+	d.Set("ip_allowlist", user.IpWhitelisted)
+	d.Set("name", flattenName(user.Name))
+	d.Set("emails", flattenInterfaceObjects(user.Emails))
+	d.Set("external_ids", flattenInterfaceObjects(user.ExternalIds))
+	d.Set("relations", flattenInterfaceObjects(user.Relations))
+	d.Set("etag", user.Etag)
+	// skip_alias_management is not returned by the API, so set it to what we defined in the config
+	d.Set("skip_alias_management", d.Get("skip_alias_management"))
+	if !d.Get("skip_alias_management").(bool) {
+		d.Set("aliases", filterRetainedAliases(d, user.Aliases))
+	}
+
+	// retain_old_email_as_alias is not returned by the API, default it for imported users
+	if rawState := d.GetRawState(); !d.IsNewResource() && (rawState.IsNull() || rawState.GetAttr("retain_old_email_as_alias").IsNull()) {
+		d.Set("retain_old_email_as_alias", true)
+	}
+
+	d.Set("is_mailbox_setup", user.IsMailboxSetup)
+	d.Set("customer_id", user.CustomerId)
+	d.Set("addresses", flattenInterfaceObjects(user.Addresses))
+	d.Set("organizations", flattenInterfaceObjects(user.Organizations))
+	d.Set("last_login_time", user.LastLoginTime)
+	d.Set("phones", flattenInterfaceObjects(user.Phones))
+	d.Set("suspension_reason", user.SuspensionReason)
+	d.Set("thumbnail_photo_url", user.ThumbnailPhotoUrl)
+	d.Set("languages", flattenInterfaceObjects(user.Languages))
+	d.Set("posix_accounts", flattenUserPosixAccounts(user.PosixAccounts))
+	d.Set("creation_time", user.CreationTime)
+	d.Set("non_editable_aliases", user.NonEditableAliases)
+	d.Set("ssh_public_keys", flattenUserSshPublicKeys(user.SshPublicKeys, d.Get("ssh_public_keys")))
+	d.Set("websites", flattenInterfaceObjects(user.Websites))
+	d.Set("locations", flattenInterfaceObjects(user.Locations))
+	d.Set("include_in_global_address_list", user.IncludeInGlobalAddressList)
+	d.Set("keywords", flattenInterfaceObjects(user.Keywords))
 	d.Set("deletion_time", user.DeletionTime)
 	d.Set("thumbnail_photo_etag", user.ThumbnailPhotoEtag)
 	d.Set("ims", flattenInterfaceObjects(user.Ims))
 	d.Set("custom_schemas", customSchemas)
+	d.Set("custom_schema_values", customSchemaValues)
 	d.Set("is_enrolled_in_2_step_verification", user.IsEnrolledIn2Sv)
 	d.Set("is_enforced_in_2_step_verification", user.IsEnforcedIn2Sv)
 	d.Set("archived", user.Archived)
@@ -1346,17 +1891,18 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	if d.HasChange("languages") {
-		languages := expandInterfaceObjects(d.Get("languages"))
+		languages := expandUserLanguages(d.Get("languages"))
 		userObj.Languages = languages
 	}
 
 	if d.HasChange("posix_accounts") {
-		posixAccounts := expandInterfaceObjects(d.Get("posix_accounts"))
+		posixAccounts := expandUserPosixAccounts(d.Get("posix_accounts"))
 		userObj.PosixAccounts = posixAccounts
 	}
 
 	if d.HasChange("ssh_public_keys") {
-		sshPublicKeys := expandInterfaceObjects(d.Get("ssh_public_keys"))
+		o, n := d.GetChange("ssh_public_keys")
+		sshPublicKeys := expandUserSshPublicKeys(o, n, time.Now())
 		userObj.SshPublicKeys = sshPublicKeys
 	}
 
@@ -1396,8 +1942,19 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 		}
 	}
 
+	// custom_schemas takes over when switching from custom_schema_values
+	if d.HasChange("custom_schema_values") && userObj.CustomSchemas == nil {
+		old, new := d.GetChange("custom_schema_values")
+		customSchemas, diags := expandCustomSchemaValuesMap(old.(map[string]interface{}), new.(map[string]interface{}), client)
+		if diags.HasError() {
+			return diags
+		}
+
+		userObj.CustomSchemas = customSchemas
+	}
+
 	numInserts := 0
-	if d.HasChange("aliases") {
+	if d.HasChange("aliases") && !d.Get("skip_alias_management").(bool) {
 		old, new := d.GetChange("aliases")
 		oldAliases := listOfInterfacestoStrings(old.([]interface{}))
 		newAliases := listOfInterfacestoStrings(new.([]interface{}))
@@ -1415,7 +1972,7 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 
 			err := aliasesService.Delete(d.Id(), alias).Do()
 			if err != nil {
-				return diag.FromErr(err)
+				return apiErrorDiagnostics(err)
 			}
 			numInserts += 1
 		}
@@ -1432,7 +1989,7 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 
 			_, err := aliasesService.Insert(d.Id(), &aliasObj).Do()
 			if err != nil {
-				return diag.FromErr(err)
+				return apiErrorDiagnostics(err)
 			}
 			numInserts += 1
 		}
@@ -1446,7 +2003,7 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 
 		err := usersService.MakeAdmin(d.Id(), &makeAdminObj).Do()
 		if err != nil {
-			return diag.FromErr(err)
+			return apiErrorDiagnostics(err)
 		}
 		numInserts += 1
 	}
@@ -1454,11 +2011,62 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 	if &userObj != new(directory.User) {
 		_, err := usersService.Update(d.Id(), &userObj).Do()
 		if err != nil {
-			return diag.FromErr(err)
+			return apiErrorDiagnostics(err)
 		}
 		numInserts += 1
 	}
 
+	// Renaming a user adds its previous primary email as an alias
+	if o, n := d.GetChange("primary_email"); !d.IsNewResource() && o.(string) != "" && o.(string) != n.(string) {
+		oldEmail := o.(string)
+
+		if d.Get("retain_old_email_as_alias").(bool) {
+			retained := listOfInterfacestoStrings(d.Get("retained_aliases"))
+			if !stringInSlice(retained, oldEmail) {
+				d.Set("retained_aliases", append(retained, oldEmail))
+			}
+
+			// wait for the alias, so that the final read doesn't forget it
+			log.Printf("[DEBUG] Waiting for alias %q added by renaming User %q", oldEmail, d.Id())
+			err := retryTimeDuration(ctx, time.Minute, func() error {
+				user, retryErr := usersService.Get(d.Id()).Fields("aliases").Do()
+				if retryErr != nil {
+					return retryErr
+				}
+
+				if !stringInSlice(user.Aliases, oldEmail) {
+					return fmt.Errorf("timed out while waiting for alias %s to be added", oldEmail)
+				}
+
+				return nil
+			})
+			if err != nil {
+				return apiErrorDiagnostics(err)
+			}
+			numInserts += 1
+		} else {
+			aliasesService, diags := GetUserAliasService(usersService)
+			if diags.HasError() {
+				return diags
+			}
+
+			// the alias may not be added yet
+			log.Printf("[DEBUG] Removing alias %q added by renaming User %q", oldEmail, d.Id())
+			err := retryTimeDuration(ctx, time.Minute, func() error {
+				retryErr := aliasesService.Delete(d.Id(), oldEmail).Do()
+				if isNotFound(retryErr) {
+					return fmt.Errorf("timed out while waiting for alias %s to be added", oldEmail)
+				}
+
+				return retryErr
+			})
+			if err != nil {
+				return apiErrorDiagnostics(err)
+			}
+			numInserts += 1
+		}
+	}
+
 	// UPDATE will respond with the updated User, however, it is eventually consistent
 	// After UPDATE, the etag is updated along with the User (and any aliases),
 	// once we get a consistent etag, we can feel confident that our User is also consistent
@@ -1466,14 +2074,14 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 		resourceType: "user",
 		timeout:      d.Timeout(schema.TimeoutUpdate),
 	}
-	err := retryTimeDuration(ctx, d.Timeout(schema.TimeoutUpdate), func() error {
+	err := retryConsistencyCheck(ctx, d.Timeout(schema.TimeoutUpdate), func() error {
 		var retryErr error
 
 		if cc.reachedConsistency(numInserts) {
 			return nil
 		}
 
-		newUser, retryErr := usersService.Get(d.Id()).IfNoneMatch(cc.lastEtag).Do()
+		newUser, retryErr := usersService.Get(d.Id()).Fields(consistencyCheckFields).IfNoneMatch(cc.lastEtag).Do()
 		if googleapi.IsNotModified(retryErr) {
 			cc.currConsistent += 1
 		} else if retryErr != nil {
@@ -1486,7 +2094,7 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 	})
 
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	log.Printf("[DEBUG] Finished updating User %q: %#v", d.Id(), primaryEmail)
@@ -1494,6 +2102,35 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 	return resourceUserRead(ctx, d, meta)
 }
 
+// filterRetainedAliases leaves out the aliases kept from renames, unless they're also managed in aliases.
+// Retained aliases that were removed are forgotten.
+func filterRetainedAliases(d *schema.ResourceData, aliases []string) []string {
+	retained := listOfInterfacestoStrings(d.Get("retained_aliases"))
+	if len(retained) == 0 {
+		return aliases
+	}
+
+	managed := listOfInterfacestoStrings(d.Get("aliases"))
+
+	result := []string{}
+	stillRetained := []string{}
+	for _, alias := range aliases {
+		if stringInSlice(retained, alias) {
+			stillRetained = append(stillRetained, alias)
+
+			if !stringInSlice(managed, alias) {
+				continue
+			}
+		}
+
+		result = append(result, alias)
+	}
+
+	d.Set("retained_aliases", stillRetained)
+
+	return result
+}
+
 func resourceUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -1541,6 +2178,223 @@ func expandName(v interface{}) *directory.UserName {
 
 // Flatten functions
 
+// Custom languages don't have a language code nor a preference, so the defaults aren't sent with them
+func expandUserLanguages(v interface{}) []interface{} {
+	for _, l := range v.([]interface{}) {
+		language, ok := l.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if customLanguage, _ := language["custom_language"].(string); customLanguage == "" {
+			continue
+		}
+
+		language["language_code"] = ""
+		language["preference"] = ""
+	}
+
+	return expandInterfaceObjects(v)
+}
+
+// The API encodes uid and gid as strings
+func expandUserPosixAccounts(v interface{}) []interface{} {
+	for _, a := range v.([]interface{}) {
+		account, ok := a.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, k := range []string{"uid", "gid"} {
+			if id, _ := account[k].(int); id != 0 {
+				account[k] = strconv.Itoa(id)
+			} else {
+				account[k] = ""
+			}
+		}
+	}
+
+	return expandInterfaceObjects(v)
+}
+
+func flattenUserPosixAccounts(posixAccounts interface{}) interface{} {
+	result := flattenInterfaceObjects(posixAccounts)
+	if result == nil {
+		return nil
+	}
+
+	for _, account := range result.([]map[string]interface{}) {
+		for _, k := range []string{"uid", "gid"} {
+			switch id := account[k].(type) {
+			case string:
+				account[k], _ = strconv.Atoi(id)
+			case float64:
+				account[k] = int(id)
+			}
+		}
+	}
+
+	return result
+}
+
+func validateSshKeyExpires(v interface{}, k string) (warnings []string, errors []error) {
+	if _, err := sshKeyExpiration(v.(string), time.Now()); err != nil {
+		errors = append(errors, fmt.Errorf("expected %s to be an RFC3339 timestamp or a duration: %w", k, err))
+	}
+
+	return warnings, errors
+}
+
+// sshKeyExpiration converts an RFC3339 timestamp or a duration counted from now to microseconds since epoch
+func sshKeyExpiration(expires string, now time.Time) (int64, error) {
+	t, err := time.Parse(time.RFC3339, expires)
+	if err != nil {
+		duration, durationErr := time.ParseDuration(expires)
+		if durationErr != nil {
+			return 0, err
+		}
+
+		if duration <= 0 {
+			return 0, fmt.Errorf("duration %s must be positive", expires)
+		}
+
+		t = now.Add(duration)
+	}
+
+	return t.Unix()*int64(time.Second/time.Microsecond) + int64(t.Nanosecond())/int64(time.Microsecond), nil
+}
+
+// Google trims the keys it stores, so keys that only differ by whitespace are the same
+func normalizeSshPublicKey(key string) string {
+	return strings.Join(strings.Fields(key), " ")
+}
+
+func diffSuppressSshPublicKey(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeSshPublicKey(old) == normalizeSshPublicKey(new)
+}
+
+// customizeDiffSshPublicKeys marks the expiration of keys that are new, or whose expires changed, as
+// unknown, since it's computed at apply time. ssh_public_keys is computed for this, so removing every
+// key from the config has to be planned here as well.
+func customizeDiffSshPublicKeys(diff *schema.ResourceDiff) error {
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() || !rawConfig.GetAttr("ssh_public_keys").IsWhollyKnown() {
+		return nil
+	}
+
+	old, new := diff.GetChange("ssh_public_keys")
+	if rawConfig.GetAttr("ssh_public_keys").LengthInt() == 0 {
+		if len(old.([]interface{})) == 0 {
+			return nil
+		}
+
+		return diff.SetNew("ssh_public_keys", []interface{}{})
+	}
+
+	previous := map[string]string{}
+	for _, k := range old.([]interface{}) {
+		if key, ok := k.(map[string]interface{}); ok {
+			previous[normalizeSshPublicKey(key["key"].(string))], _ = key["expires"].(string)
+		}
+	}
+
+	keys := new.([]interface{})
+	changed := false
+	for _, k := range keys {
+		key, ok := k.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		expires, _ := key["expires"].(string)
+		if expires == "" {
+			continue
+		}
+
+		if prev, ok := previous[normalizeSshPublicKey(key["key"].(string))]; ok && prev == expires {
+			continue
+		}
+
+		// An empty value of a computed attribute is planned as unknown
+		key["expiration_time_usec"] = ""
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+
+	return diff.SetNew("ssh_public_keys", keys)
+}
+
+// The expiration of keys whose expires didn't change is kept, so durations aren't counted
+// again every time a key is added or removed
+func expandUserSshPublicKeys(old, new interface{}, now time.Time) []interface{} {
+	previous := map[string]map[string]interface{}{}
+	if old != nil {
+		for _, k := range old.([]interface{}) {
+			if key, ok := k.(map[string]interface{}); ok {
+				previous[normalizeSshPublicKey(key["key"].(string))] = key
+			}
+		}
+	}
+
+	for _, k := range new.([]interface{}) {
+		key, ok := k.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		expires, _ := key["expires"].(string)
+		delete(key, "expires")
+
+		if expires == "" {
+			continue
+		}
+
+		if prev, ok := previous[normalizeSshPublicKey(key["key"].(string))]; ok && prev["expires"] == expires {
+			key["expiration_time_usec"] = prev["expiration_time_usec"]
+			continue
+		}
+
+		// expires was validated at plan time
+		usec, _ := sshKeyExpiration(expires, now)
+		key["expiration_time_usec"] = strconv.FormatInt(usec, 10)
+	}
+
+	return expandInterfaceObjects(new)
+}
+
+// The API doesn't return expires, it's kept from the configured key
+func flattenUserSshPublicKeys(sshPublicKeys interface{}, configured interface{}) interface{} {
+	result := flattenInterfaceObjects(sshPublicKeys)
+	if result == nil {
+		return nil
+	}
+
+	expires := map[string]string{}
+	for _, k := range configured.([]interface{}) {
+		key, ok := k.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, _ := key["expires"].(string); v != "" {
+			expires[normalizeSshPublicKey(key["key"].(string))] = v
+		}
+	}
+
+	for _, key := range result.([]map[string]interface{}) {
+		if keyValue, ok := key["key"].(string); ok {
+			if v, ok := expires[normalizeSshPublicKey(keyValue)]; ok {
+				key["expires"] = v
+			}
+		}
+	}
+
+	return result
+}
+
 func flattenName(nameObj *directory.UserName) interface{} {
 	name := []map[string]interface{}{}
 
@@ -1580,7 +2434,7 @@ func validateCustomSchemas(d *schema.ResourceData, client *apiClient) diag.Diagn
 
 		schemaDef, err := schemaService.Get(client.Customer, schemaName).Do()
 		if err != nil {
-			return diag.FromErr(err)
+			return apiErrorDiagnostics(err)
 		}
 
 		if schemaDef == nil {
@@ -1751,6 +2605,138 @@ func expandCustomSchemaValues(customSchemas []interface{}) (map[string]googleapi
 	return result, diags
 }
 
+func validateCustomSchemaValuesKeys(v interface{}, k string) (warnings []string, errors []error) {
+	for key := range v.(map[string]interface{}) {
+		if parts := strings.Split(key, "."); len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			errors = append(errors, fmt.Errorf("expected the keys of %s to be <schema name>.<field name>, got %s", k, key))
+		}
+	}
+
+	return warnings, errors
+}
+
+// expandCustomSchemaValuesMap converts the custom_schema_values to the types of their schema definitions,
+// fields that were removed are cleared
+func expandCustomSchemaValuesMap(old, new map[string]interface{}, client *apiClient) (map[string]googleapi.RawMessage, diag.Diagnostics) {
+	customSchemaObjs := map[string]map[string]interface{}{}
+	for key := range old {
+		if _, ok := new[key]; !ok {
+			parts := strings.SplitN(key, ".", 2)
+			if customSchemaObjs[parts[0]] == nil {
+				customSchemaObjs[parts[0]] = map[string]interface{}{}
+			}
+			customSchemaObjs[parts[0]][parts[1]] = nil
+		}
+	}
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	schemaService, diags := GetSchemasService(directoryService)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	schemaFieldMaps := map[string]map[string]*directory.SchemaFieldSpec{}
+	for key, v := range new {
+		parts := strings.SplitN(key, ".", 2)
+		schemaName, fieldName := parts[0], parts[1]
+
+		schemaFieldMap, ok := schemaFieldMaps[schemaName]
+		if !ok {
+			schemaDef, err := schemaService.Get(client.Customer, schemaName).Do()
+			if err != nil {
+				return nil, apiErrorDiagnostics(err)
+			}
+
+			schemaFieldMap = map[string]*directory.SchemaFieldSpec{}
+			for _, schemaField := range schemaDef.Fields {
+				schemaFieldMap[schemaField.FieldName] = schemaField
+			}
+			schemaFieldMaps[schemaName] = schemaFieldMap
+		}
+
+		fieldSpec, ok := schemaFieldMap[fieldName]
+		if !ok {
+			return nil, append(diags, diag.Diagnostic{
+				Summary:  fmt.Sprintf("field name (%s) is not found in this schema definition (%s)", fieldName, schemaName),
+				Severity: diag.Error,
+			})
+		}
+
+		if fieldSpec.MultiValued {
+			return nil, append(diags, diag.Diagnostic{
+				Summary:  fmt.Sprintf("field %s is multi-valued and needs to be set with custom_schemas", key),
+				Severity: diag.Error,
+			})
+		}
+
+		value, err := convertFieldValueType(fieldSpec.FieldType, v)
+		if err != nil || !validateFieldValueType(fieldSpec.FieldType, normalizeCustomSchemaValue(value)) {
+			return nil, append(diags, diag.Diagnostic{
+				Summary:  fmt.Sprintf("value provided for %s is of incorrect type (expected type: %s)", key, fieldSpec.FieldType),
+				Severity: diag.Error,
+			})
+		}
+
+		if customSchemaObjs[schemaName] == nil {
+			customSchemaObjs[schemaName] = map[string]interface{}{}
+		}
+		customSchemaObjs[schemaName][fieldName] = value
+	}
+
+	result := map[string]googleapi.RawMessage{}
+	for schemaName, customSchemaObj := range customSchemaObjs {
+		schemaValuesJson, err := json.Marshal(customSchemaObj)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		result[schemaName] = schemaValuesJson
+	}
+
+	return result, diags
+}
+
+// validateFieldValueType expects numbers the way they're unmarshalled from JSON
+func normalizeCustomSchemaValue(value interface{}) interface{} {
+	if v, ok := value.(int64); ok {
+		return float64(v)
+	}
+
+	return value
+}
+
+// flattenCustomSchemaValuesMap returns the single-valued custom fields as plain strings
+func flattenCustomSchemaValuesMap(schemaAttrObj map[string]googleapi.RawMessage) map[string]interface{} {
+	result := map[string]interface{}{}
+
+	for schemaName, sv := range schemaAttrObj {
+		var schemaValuesObj map[string]interface{}
+		if err := json.Unmarshal(sv, &schemaValuesObj); err != nil {
+			log.Printf("[WARN] unable to read the values of custom schema %s: %s", schemaName, err)
+			continue
+		}
+
+		for k, v := range schemaValuesObj {
+			key := fmt.Sprintf("%s.%s", schemaName, k)
+
+			switch value := v.(type) {
+			case string:
+				result[key] = value
+			case bool:
+				result[key] = strconv.FormatBool(value)
+			case float64:
+				result[key] = strconv.FormatFloat(value, 'f', -1, 64)
+			}
+		}
+	}
+
+	return result
+}
+
 func flattenCustomSchemas(schemaAttrObj interface{}, client *apiClient) ([]map[string]interface{}, diag.Diagnostics) {
 	var customSchemas []map[string]interface{}
 
@@ -1767,7 +2753,7 @@ func flattenCustomSchemas(schemaAttrObj interface{}, client *apiClient) ([]map[s
 	for schemaName, sv := range schemaAttrObj.(map[string]googleapi.RawMessage) {
 		schemaDef, err := schemaService.Get(client.Customer, schemaName).Do()
 		if err != nil {
-			return nil, diag.FromErr(err)
+			return nil, apiErrorDiagnostics(err)
 		}
 
 		schemaFieldMap := map[string]*directory.SchemaFieldSpec{}
@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"google.golang.org/api/cloudidentity/v1"
+)
+
+func resourceUserInvitation() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "User Invitation resource invites an unmanaged user account, a consumer account using an email " +
+			"address of one of the organization's domains, to join the organization. The invitation is canceled " +
+			"when the resource is destroyed, unless it was already accepted or declined. User Invitation requires " +
+			"the `https://www.googleapis.com/auth/cloud-identity.userinvitations` client scope.",
+
+		CreateContext: resourceUserInvitationCreate,
+		ReadContext:   resourceUserInvitationRead,
+		DeleteContext: resourceUserInvitationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUserInvitationImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"email": {
+				Description: "The email address of the unmanaged user account to invite.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.EqualFold(old, new)
+				},
+			},
+			"state": {
+				Description: "The state of the invitation, one of `NOT_YET_SENT`, `INVITED`, `ACCEPTED` and `DECLINED`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"mails_sent_count": {
+				Description: "The number of invitation emails sent to the user.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"update_time": {
+				Description: "The time the invitation was last updated.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			// Adding a computed id simply to override the `optional` id that gets added in the SDK
+			// that will then display improperly in the docs
+			"id": {
+				Description: "The ID of this resource.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceUserInvitationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	cloudIdentityService, diags := client.NewCloudIdentityService()
+	if diags.HasError() {
+		return diags
+	}
+
+	userInvitationsService, diags := GetCloudIdentityUserInvitationsService(cloudIdentityService)
+	if diags.HasError() {
+		return diags
+	}
+
+	email := d.Get("email").(string)
+	name := userInvitationName(client.Customer, email)
+	log.Printf("[DEBUG] Creating User Invitation %q", name)
+
+	// managed users, and addresses without any account, can't be invited
+	invitable, err := userInvitationsService.IsInvitableUser(name).Do()
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	if !invitable.IsInvitableUser {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("%s can't be invited", email),
+				Detail: "Only unmanaged user accounts, consumer accounts using an email address of one of the " +
+					"organization's domains, can be invited to join the organization.",
+			},
+		}
+	}
+
+	_, err = userInvitationsService.Send(name, &cloudidentity.SendUserInvitationRequest{}).Do()
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	d.SetId(name)
+
+	log.Printf("[DEBUG] Finished creating User Invitation %q", d.Id())
+
+	return resourceUserInvitationRead(ctx, d, meta)
+}
+
+func resourceUserInvitationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	cloudIdentityService, diags := client.NewCloudIdentityService()
+	if diags.HasError() {
+		return diags
+	}
+
+	userInvitationsService, diags := GetCloudIdentityUserInvitationsService(cloudIdentityService)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Getting User Invitation %q", d.Id())
+
+	invitation, err := userInvitationsService.Get(d.Id()).Do()
+	if err != nil {
+		return handleReadNotFoundError(err, d, d.Id())
+	}
+
+	// the invitation's name ends with the invited email address
+	parts := strings.Split(invitation.Name, "/")
+	if d.Get("email").(string) == "" {
+		d.Set("email", parts[len(parts)-1])
+	}
+
+	d.Set("state", invitation.State)
+	d.Set("mails_sent_count", invitation.MailsSentCount)
+	d.Set("update_time", invitation.UpdateTime)
+
+	log.Printf("[DEBUG] Finished getting User Invitation %q", d.Id())
+
+	return diags
+}
+
+func resourceUserInvitationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := meta.(*apiClient)
+
+	cloudIdentityService, diags := client.NewCloudIdentityService()
+	if diags.HasError() {
+		return diags
+	}
+
+	userInvitationsService, diags := GetCloudIdentityUserInvitationsService(cloudIdentityService)
+	if diags.HasError() {
+		return diags
+	}
+
+	// accepted and declined invitations can't be canceled, they're only removed from state
+	if state := d.Get("state").(string); state == "ACCEPTED" || state == "DECLINED" {
+		log.Printf("[DEBUG] User Invitation %q is %s, removing from state", d.Id(), state)
+		return diags
+	}
+
+	log.Printf("[DEBUG] Canceling User Invitation %q", d.Id())
+
+	_, err := userInvitationsService.Cancel(d.Id(), &cloudidentity.CancelUserInvitationRequest{}).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, d.Id())
+	}
+
+	log.Printf("[DEBUG] Finished canceling User Invitation %q", d.Id())
+
+	return diags
+}
+
+// resourceUserInvitationImport accepts either the invited email address or the invitation's name
+func resourceUserInvitationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*apiClient)
+
+	if !strings.HasPrefix(d.Id(), "customers/") {
+		d.SetId(userInvitationName(client.Customer, d.Id()))
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func userInvitationName(customer, email string) string {
+	return fmt.Sprintf("customers/%s/userinvitations/%s", customer, email)
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceUserInvitation_basic(t *testing.T) {
+	t.Parallel()
+
+	email := os.Getenv("GOOGLEWORKSPACE_UNMANAGED_USER_EMAIL")
+
+	if email == "" {
+		t.Skip("GOOGLEWORKSPACE_UNMANAGED_USER_EMAIL needs to be set to run this test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUserInvitation(email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_user_invitation.invitation", "state", "INVITED"),
+				),
+			},
+			{
+				ResourceName:      "googleworkspace_user_invitation.invitation",
+				ImportState:       true,
+				ImportStateId:     email,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceUserInvitation_notInvitable(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceUserInvitation("tf-test-" + acctest.RandString(10) + "@" + domainName),
+				ExpectError: regexp.MustCompile("can't be invited"),
+			},
+		},
+	})
+}
+
+func testAccResourceUserInvitation(email string) string {
+	return Nprintf(`
+provider "googleworkspace" {
+  oauth_scopes = ["https://www.googleapis.com/auth/cloud-identity.userinvitations"]
+}
+
+resource "googleworkspace_user_invitation" "invitation" {
+  email = "%{email}"
+}
+`, map[string]interface{}{"email": email})
+}
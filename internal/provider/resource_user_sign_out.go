@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceUserSignOut() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "User Sign Out resource signs a user out of all web and device sessions and resets their " +
+			"sign-in cookies, e.g. as part of an incident response lockdown. The user is signed out when the " +
+			"resource is created, and again whenever `triggers` change. Destroying the resource doesn't do " +
+			"anything. User Sign Out requires the `https://www.googleapis.com/auth/admin.directory.user.security` " +
+			"client scope, which isn't one of the provider's default scopes and needs to be added to `oauth_scopes`.",
+
+		CreateContext: resourceUserSignOutCreate,
+		ReadContext:   resourceUserSignOutRead,
+		DeleteContext: resourceUserSignOutDelete,
+
+		Schema: map[string]*schema.Schema{
+			"user_key": {
+				Description: "The user's primary email address, alias email address, or unique user ID.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"triggers": {
+				Description: "Arbitrary map of values that, when changed, signs the user out again.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"signed_out_at": {
+				Description: "The time the user was signed out, in RFC 3339 format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			// Adding a computed id simply to override the `optional` id that gets added in the SDK
+			// that will then display improperly in the docs
+			"id": {
+				Description: "The unique ID of the user.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceUserSignOutCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	usersService, diags := GetUsersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	userKey := d.Get("user_key").(string)
+	log.Printf("[DEBUG] Signing out User %q", userKey)
+
+	user, err := usersService.Get(userKey).Fields("id").Do()
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	err = usersService.SignOut(user.Id).Do()
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	d.SetId(user.Id)
+	d.Set("signed_out_at", time.Now().UTC().Format(time.RFC3339))
+
+	log.Printf("[DEBUG] Finished signing out User %q", userKey)
+
+	return resourceUserSignOutRead(ctx, d, meta)
+}
+
+// resourceUserSignOutRead only checks the user still exists, as sign-outs can't be read back
+func resourceUserSignOutRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	usersService, diags := GetUsersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Getting User %q of User Sign Out", d.Id())
+
+	_, err := usersService.Get(d.Id()).Fields("id").Do()
+	if err != nil {
+		return handleReadNotFoundError(err, d, d.Id())
+	}
+
+	log.Printf("[DEBUG] Finished getting User %q of User Sign Out", d.Id())
+
+	return diags
+}
+
+func resourceUserSignOutDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// a sign-out can't be undone, so the resource is only removed from state
+	log.Printf("[DEBUG] Removing User Sign Out %q from state", d.Id())
+
+	return diags
+}
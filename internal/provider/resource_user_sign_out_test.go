@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceUserSignOut_basic(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testUserVals := map[string]interface{}{
+		"domainName": domainName,
+		"userEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":   acctest.RandString(10),
+		"incident":   "INC-1",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUserSignOut(testUserVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("googleworkspace_user_sign_out.lockdown", "id",
+						"googleworkspace_user.user", "id"),
+					resource.TestCheckResourceAttrSet("googleworkspace_user_sign_out.lockdown", "signed_out_at"),
+				),
+			},
+			{
+				// changing the triggers signs the user out again
+				Config: testAccResourceUserSignOut(map[string]interface{}{
+					"domainName": domainName,
+					"userEmail":  testUserVals["userEmail"],
+					"password":   testUserVals["password"],
+					"incident":   "INC-2",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_user_sign_out.lockdown", "triggers.incident", "INC-2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceUserSignOut(testUserVals map[string]interface{}) string {
+	return Nprintf(`
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/admin.directory.user",
+    "https://www.googleapis.com/auth/admin.directory.user.security",
+  ]
+}
+
+resource "googleworkspace_user" "user" {
+  primary_email = "%{userEmail}@%{domainName}"
+  password = "%{password}"
+
+  name {
+    family_name = "Scott"
+    given_name = "Michael"
+  }
+}
+
+resource "googleworkspace_user_sign_out" "lockdown" {
+  user_key = googleworkspace_user.user.primary_email
+
+  triggers = {
+    incident = "%{incident}"
+  }
+}
+`, testUserVals)
+}
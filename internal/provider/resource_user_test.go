@@ -4,13 +4,17 @@
 package googleworkspace
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"reflect"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -113,6 +117,364 @@ func TestAccResourceUser_noPassword(t *testing.T) {
 	})
 }
 
+func TestAccResourceUser_typeValidation(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testUserVals := map[string]interface{}{
+		"domainName": domainName,
+		"userEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":   acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceUser_relationType(testUserVals, false),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`expected relations.0.type to be one of`),
+			},
+			{
+				Config:             testAccResourceUser_relationType(testUserVals, true),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceUser_customLanguage(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testUserVals := map[string]interface{}{
+		"domainName": domainName,
+		"userEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":   acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceUser_customLanguage(testUserVals, `language_code = "en"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("only one of `custom_language,language_code` can be specified"),
+			},
+			{
+				Config:      testAccResourceUser_customLanguage(testUserVals, `preference = "not_preferred"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("`preference` can't be specified with `custom_language`"),
+			},
+			{
+				Config: testAccResourceUser_customLanguage(testUserVals, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "languages.#", "1"),
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "languages.0.custom_language", "Klingon"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceUser_posixAccounts(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testUserVals := map[string]interface{}{
+		"domainName": domainName,
+		"userEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":   acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "googleworkspace" {
+  enforce_unique_posix_uids = true
+}
+` + testAccResourceUser_posixAccounts(testUserVals, 2001),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("have the same uid 2001 within system_id"),
+			},
+			{
+				Config: testAccResourceUser_posixAccounts(testUserVals, 2002),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "posix_accounts.#", "2"),
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "posix_accounts.0.uid", "2001"),
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "posix_accounts.0.gid", "1000"),
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "posix_accounts.1.uid", "2002"),
+				),
+			},
+			{
+				ResourceName:            "googleworkspace_user.my-new-user",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func TestAccResourceUser_sshPublicKeys(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testUserVals := map[string]interface{}{
+		"domainName": domainName,
+		"userEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":   acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUser_sshPublicKeys(testUserVals, "2030-01-01T00:00:00Z"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "ssh_public_keys.0.expires", "2030-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "ssh_public_keys.0.expiration_time_usec", "1893456000000000"),
+					resource.TestCheckResourceAttrSet("googleworkspace_user.my-new-user", "ssh_public_keys.0.fingerprint"),
+				),
+			},
+			{
+				Config: testAccResourceUser_sshPublicKeys(testUserVals, "720h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "ssh_public_keys.0.expires", "720h"),
+					resource.TestCheckResourceAttrSet("googleworkspace_user.my-new-user", "ssh_public_keys.0.expiration_time_usec"),
+				),
+			},
+			{
+				Config: testAccResourceUser_noSshPublicKeys(testUserVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "ssh_public_keys.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceUser_rename(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testUserVals := map[string]interface{}{
+		"domainName": domainName,
+		"userEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":   acctest.RandString(10),
+	}
+
+	oldEmail := Nprintf("%{userEmail}@%{domainName}", testUserVals)
+	renamedEmail := Nprintf("%{userEmail}-renamed@%{domainName}", testUserVals)
+	renamedAgainEmail := Nprintf("%{userEmail}-renamed-again@%{domainName}", testUserVals)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUser_rename(testUserVals, oldEmail, true),
+			},
+			{
+				Config: testAccResourceUser_rename(testUserVals, renamedEmail, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "primary_email", renamedEmail),
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "aliases.#", "0"),
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "retained_aliases.#", "1"),
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "retained_aliases.0", oldEmail),
+				),
+			},
+			{
+				Config: testAccResourceUser_rename(testUserVals, renamedAgainEmail, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "primary_email", renamedAgainEmail),
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "aliases.#", "0"),
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "retained_aliases.#", "1"),
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "retained_aliases.0", oldEmail),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceUser_uniqueExternalIds(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testUserVals := map[string]interface{}{
+		"domainName":     domainName,
+		"userEmail":      fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":       acctest.RandString(10),
+		"employeeNumber": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceUser_uniqueExternalIds(testUserVals, "%{employeeNumber}"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("external id employee_number \"[^\"]+\" is also planned for"),
+			},
+			{
+				Config: testAccResourceUser_uniqueExternalIds(testUserVals, "%{employeeNumber}-2"),
+			},
+			{
+				Config: testAccResourceUser_uniqueExternalIds(testUserVals, "%{employeeNumber}-2") + Nprintf(`
+resource "googleworkspace_user" "duplicate" {
+  primary_email = "%{userEmail}-3@%{domainName}"
+  password = "%{password}"
+
+  name {
+    family_name = "Scott"
+    given_name = "Michael"
+  }
+
+  external_ids {
+    type = "custom"
+    custom_type = "employee_number"
+    value = "%{employeeNumber}"
+  }
+}
+`, testUserVals),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("external id employee_number \"[^\"]+\" is (already used by|also planned for)"),
+			},
+		},
+	})
+}
+
+func TestAccResourceUser_skipAliasManagement(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testUserVals := map[string]interface{}{
+		"domainName": domainName,
+		"userEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":   acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUser_skipAliasManagement(testUserVals, `aliases = ["%{userEmail}-alias@%{domainName}"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "aliases.#", "1"),
+				),
+			},
+			{
+				// the alias is left as is, and doesn't show as drift
+				Config: testAccResourceUser_skipAliasManagement(testUserVals, "skip_alias_management = true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "skip_alias_management", "true"),
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "aliases.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceUser_recoveryContacts(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testUserVals := map[string]interface{}{
+		"domainName": domainName,
+		"userEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":   acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceUser_recoveryContacts(testUserVals, `recovery_phone = "6506661212"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("must be in the E.164 format"),
+			},
+			{
+				Config:      testAccResourceUser_recoveryContacts(testUserVals, `recovery_email = "Dwight <dwightkschrute@example.com>"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("is not a valid email address"),
+			},
+			{
+				Config:      testAccResourceUser_recoveryContacts(testUserVals, `recovery_email = "dwight@%{domainName}"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("can't be in the user's own domain"),
+			},
+			{
+				Config: testAccResourceUser_recoveryContacts(testUserVals, `
+  recovery_email = "dwightkschrute@example.com"
+  recovery_phone = "+16506661212"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "recovery_email", "dwightkschrute@example.com"),
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "recovery_phone", "+16506661212"),
+				),
+			},
+			{
+				// removing the recovery contacts clears them
+				Config: testAccResourceUser_recoveryContacts(testUserVals, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "recovery_email", ""),
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "recovery_phone", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourceUser_full(t *testing.T) {
 	t.Parallel()
 
@@ -198,119 +560,386 @@ func TestAccResourceUser_isAdmin(t *testing.T) {
 func TestAccResourceUser_gone(t *testing.T) {
 	t.Parallel()
 
-	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testUserVals := map[string]interface{}{
+		"domainName": domainName,
+		"userEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":   acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUser_basic(testUserVals),
+			},
+			{
+				ResourceName:            "googleworkspace_user.my-new-user",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"etag", "password"},
+			},
+			{
+				Config: testAccResourceUser_suspended(testUserVals),
+			},
+			{
+				ResourceName:            "googleworkspace_user.my-new-user",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"etag", "password"},
+			},
+			// We need to buy Archived User Licenses in order to test this
+			//{
+			//	Config: testAccResourceUser_archived(testUserVals),
+			//},
+			//{
+			//	ResourceName:            "googleworkspace_user.my-new-user",
+			//	ImportState:             true,
+			//	ImportStateVerify:       true,
+			//	ImportStateVerifyIgnore: []string{"etag", "password"},
+			//},
+		},
+	})
+}
+
+func TestAccResourceUser_customSchemasAllTypes(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testUserVals := map[string]interface{}{
+		"domainName": domainName,
+		"userEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":   acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUser_customSchemaAllTypes(testUserVals),
+			},
+			{
+				ResourceName:            "googleworkspace_user.my-new-user",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"etag", "password"},
+			},
+		},
+	})
+}
+
+func TestAccResourceUser_customSchemaValues(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testUserVals := map[string]interface{}{
+		"domainName": domainName,
+		"userEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":   acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUser_customSchemaValues(testUserVals, `
+    "${googleworkspace_schema.my-schema.schema_name}.birthday"        = "1970-01-20"
+    "${googleworkspace_schema.my-schema.schema_name}.level"           = "3"
+    "${googleworkspace_schema.my-schema.schema_name}.lbs-of-beets"    = "1004.35"
+    "${googleworkspace_schema.my-schema.schema_name}.favorite-animal" = "bears"
+    "${googleworkspace_schema.my-schema.schema_name}.fire-certified"  = "true"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "custom_schema_values.%", "5"),
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user",
+						Nprintf("custom_schema_values.%{userEmail}-schema.level", testUserVals), "3"),
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user",
+						Nprintf("custom_schema_values.%{userEmail}-schema.fire-certified", testUserVals), "true"),
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "custom_schemas.#", "0"),
+				),
+			},
+			{
+				Config: testAccResourceUser_customSchemaValues(testUserVals, `
+    "${googleworkspace_schema.my-schema.schema_name}.level"           = "4"
+    "${googleworkspace_schema.my-schema.schema_name}.favorite-animal" = "bears"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "custom_schema_values.%", "2"),
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user",
+						Nprintf("custom_schema_values.%{userEmail}-schema.level", testUserVals), "4"),
+				),
+			},
+			{
+				Config: testAccResourceUser_customSchemaValues(testUserVals, `
+    "${googleworkspace_schema.my-schema.schema_name}.level" = "four"`),
+				ExpectError: regexp.MustCompile("is of incorrect type"),
+			},
+		},
+	})
+}
+
+func TestAccResourceUser_customSchemasMultiple(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testUserVals := map[string]interface{}{
+		"domainName": domainName,
+		"userEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":   acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUser_customSchemaMultiple(testUserVals),
+			},
+			{
+				ResourceName:            "googleworkspace_user.my-new-user",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"etag", "password"},
+			},
+		},
+	})
+}
+
+func testAccResourceUser_basic(testUserVals map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_user" "my-new-user" {
+  primary_email = "%{userEmail}@%{domainName}"
+  password = "%{password}"
+
+  name {
+    family_name = "Scott"
+    given_name = "Michael"
+  }
+
+  timeouts {
+    update = "15m"
+  }
+}
+`, testUserVals)
+}
+
+func testAccResourceUser_relationType(testUserVals map[string]interface{}, skipValidation bool) string {
+	testUserVals["skipValidation"] = skipValidation
+
+	return Nprintf(`
+provider "googleworkspace" {
+  skip_user_type_validation = %{skipValidation}
+}
+
+resource "googleworkspace_user" "my-new-user" {
+  primary_email = "%{userEmail}@%{domainName}"
+  password = "%{password}"
+
+  name {
+    family_name = "Scott"
+    given_name = "Michael"
+  }
+
+  relations {
+    type = "not_yet_known"
+    value = "Holly Flax"
+  }
+}
+`, testUserVals)
+}
+
+func testAccResourceUser_customLanguage(testUserVals map[string]interface{}, extraLanguageAttr string) string {
+	testUserVals["extraLanguageAttr"] = extraLanguageAttr
+
+	return Nprintf(`
+resource "googleworkspace_user" "my-new-user" {
+  primary_email = "%{userEmail}@%{domainName}"
+  password = "%{password}"
+
+  name {
+    family_name = "Scott"
+    given_name = "Michael"
+  }
+
+  languages {
+    custom_language = "Klingon"
+    %{extraLanguageAttr}
+  }
+}
+`, testUserVals)
+}
+
+func testAccResourceUser_posixAccounts(testUserVals map[string]interface{}, secondUid int) string {
+	testUserVals["secondUid"] = secondUid
+
+	return Nprintf(`
+resource "googleworkspace_user" "my-new-user" {
+  primary_email = "%{userEmail}@%{domainName}"
+  password = "%{password}"
+
+  name {
+    family_name = "Scott"
+    given_name = "Michael"
+  }
+
+  posix_accounts {
+    system_id = "tf-test"
+    username = "mscott"
+    uid = 2001
+    gid = 1000
+    primary = true
+  }
+
+  posix_accounts {
+    system_id = "tf-test"
+    username = "mscott-admin"
+    uid = %{secondUid}
+    gid = 1000
+  }
+}
+`, testUserVals)
+}
+
+func testAccResourceUser_sshPublicKeys(testUserVals map[string]interface{}, expires string) string {
+	testUserVals["expires"] = expires
+
+	return Nprintf(`
+resource "googleworkspace_user" "my-new-user" {
+  primary_email = "%{userEmail}@%{domainName}"
+  password = "%{password}"
+
+  name {
+    family_name = "Scott"
+    given_name = "Michael"
+  }
+
+  ssh_public_keys {
+    key = <<EOT
+ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIKQ8iZ0BniJ9ySz/ifid6RLnEDhtK11tPfwNu1aKNG6V
+EOT
+    expires = "%{expires}"
+  }
+}
+`, testUserVals)
+}
+
+func testAccResourceUser_noSshPublicKeys(testUserVals map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_user" "my-new-user" {
+  primary_email = "%{userEmail}@%{domainName}"
+  password = "%{password}"
+
+  name {
+    family_name = "Scott"
+    given_name = "Michael"
+  }
+}
+`, testUserVals)
+}
+
+func testAccResourceUser_rename(testUserVals map[string]interface{}, primaryEmail string, retainOldEmail bool) string {
+	testUserVals["primaryEmail"] = primaryEmail
+	testUserVals["retainOldEmail"] = retainOldEmail
 
-	if domainName == "" {
-		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
-	}
+	return Nprintf(`
+resource "googleworkspace_user" "my-new-user" {
+  primary_email = "%{primaryEmail}"
+  password = "%{password}"
 
-	testUserVals := map[string]interface{}{
-		"domainName": domainName,
-		"userEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
-		"password":   acctest.RandString(10),
-	}
+  retain_old_email_as_alias = %{retainOldEmail}
 
-	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccResourceUser_basic(testUserVals),
-			},
-			{
-				ResourceName:            "googleworkspace_user.my-new-user",
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"etag", "password"},
-			},
-			{
-				Config: testAccResourceUser_suspended(testUserVals),
-			},
-			{
-				ResourceName:            "googleworkspace_user.my-new-user",
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"etag", "password"},
-			},
-			// We need to buy Archived User Licenses in order to test this
-			//{
-			//	Config: testAccResourceUser_archived(testUserVals),
-			//},
-			//{
-			//	ResourceName:            "googleworkspace_user.my-new-user",
-			//	ImportState:             true,
-			//	ImportStateVerify:       true,
-			//	ImportStateVerifyIgnore: []string{"etag", "password"},
-			//},
-		},
-	})
+  name {
+    family_name = "Scott"
+    given_name = "Michael"
+  }
+}
+`, testUserVals)
 }
 
-func TestAccResourceUser_customSchemasAllTypes(t *testing.T) {
-	t.Parallel()
+func testAccResourceUser_uniqueExternalIds(testUserVals map[string]interface{}, secondEmployeeNumber string) string {
+	testUserVals["secondEmployeeNumber"] = Nprintf(secondEmployeeNumber, testUserVals)
 
-	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+	return Nprintf(`
+provider "googleworkspace" {
+  unique_external_id_custom_types = ["employee_number"]
+}
 
-	if domainName == "" {
-		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
-	}
+resource "googleworkspace_user" "first" {
+  primary_email = "%{userEmail}-1@%{domainName}"
+  password = "%{password}"
 
-	testUserVals := map[string]interface{}{
-		"domainName": domainName,
-		"userEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
-		"password":   acctest.RandString(10),
-	}
+  name {
+    family_name = "Scott"
+    given_name = "Michael"
+  }
 
-	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccResourceUser_customSchemaAllTypes(testUserVals),
-			},
-			{
-				ResourceName:            "googleworkspace_user.my-new-user",
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"etag", "password"},
-			},
-		},
-	})
+  external_ids {
+    type = "custom"
+    custom_type = "employee_number"
+    value = "%{employeeNumber}"
+  }
 }
 
-func TestAccResourceUser_customSchemasMultiple(t *testing.T) {
-	t.Parallel()
+resource "googleworkspace_user" "second" {
+  primary_email = "%{userEmail}-2@%{domainName}"
+  password = "%{password}"
 
-	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+  name {
+    family_name = "Schrute"
+    given_name = "Dwight"
+  }
 
-	if domainName == "" {
-		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
-	}
+  external_ids {
+    type = "custom"
+    custom_type = "employee_number"
+    value = "%{secondEmployeeNumber}"
+  }
+}
+`, testUserVals)
+}
 
-	testUserVals := map[string]interface{}{
-		"domainName": domainName,
-		"userEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
-		"password":   acctest.RandString(10),
-	}
+func testAccResourceUser_skipAliasManagement(testUserVals map[string]interface{}, aliasesAttr string) string {
+	testUserVals["aliasesAttr"] = Nprintf(aliasesAttr, testUserVals)
 
-	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccResourceUser_customSchemaMultiple(testUserVals),
-			},
-			{
-				ResourceName:            "googleworkspace_user.my-new-user",
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"etag", "password"},
-			},
-		},
-	})
+	return Nprintf(`
+resource "googleworkspace_user" "my-new-user" {
+  primary_email = "%{userEmail}@%{domainName}"
+  password = "%{password}"
+
+  name {
+    family_name = "Scott"
+    given_name = "Michael"
+  }
+
+  %{aliasesAttr}
+}
+`, testUserVals)
 }
 
-func testAccResourceUser_basic(testUserVals map[string]interface{}) string {
+func testAccResourceUser_recoveryContacts(testUserVals map[string]interface{}, recoveryAttrs string) string {
+	testUserVals["recoveryAttrs"] = Nprintf(recoveryAttrs, testUserVals)
+
 	return Nprintf(`
 resource "googleworkspace_user" "my-new-user" {
   primary_email = "%{userEmail}@%{domainName}"
@@ -321,9 +950,7 @@ resource "googleworkspace_user" "my-new-user" {
     given_name = "Michael"
   }
 
-  timeouts {
-    update = "15m"
-  }
+  %{recoveryAttrs}
 }
 `, testUserVals)
 }
@@ -761,6 +1388,54 @@ resource "googleworkspace_user" "my-new-user" {
 `, testUserVals)
 }
 
+func testAccResourceUser_customSchemaValues(testUserVals map[string]interface{}, customSchemaValues string) string {
+	testUserVals["customSchemaValues"] = customSchemaValues
+
+	return Nprintf(`
+resource "googleworkspace_schema" "my-schema" {
+  schema_name = "%{userEmail}-schema"
+
+  fields {
+    field_name = "birthday"
+    field_type = "DATE"
+  }
+
+  fields {
+    field_name = "level"
+    field_type = "INT64"
+  }
+
+  fields {
+    field_name = "lbs-of-beets"
+    field_type = "DOUBLE"
+  }
+
+  fields {
+    field_name = "favorite-animal"
+    field_type = "STRING"
+  }
+
+  fields {
+    field_name = "fire-certified"
+    field_type = "BOOL"
+  }
+}
+
+resource "googleworkspace_user" "my-new-user" {
+  primary_email = "%{userEmail}@%{domainName}"
+  password = "%{password}"
+
+  name {
+    family_name = "Scott"
+    given_name = "Michael"
+  }
+
+  custom_schema_values = {%{customSchemaValues}
+  }
+}
+`, testUserVals)
+}
+
 func testAccResourceUser_customSchemaMultiple(testUserVals map[string]interface{}) string {
 	return Nprintf(`
 resource "googleworkspace_schema" "bar-schema" {
@@ -808,3 +1483,172 @@ resource "googleworkspace_user" "my-new-user" {
 }
 `, testUserVals)
 }
+
+func TestSshKeyExpiration(t *testing.T) {
+	now := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		expires  string
+		expected int64
+		err      bool
+	}{
+		"timestamp": {
+			expires:  "2030-01-02T00:00:00Z",
+			expected: 1893542400000000,
+		},
+		"timestamp with offset": {
+			expires:  "2030-01-02T01:00:00+01:00",
+			expected: 1893542400000000,
+		},
+		"duration": {
+			expires:  "24h",
+			expected: 1893542400000000,
+		},
+		"negative duration": {
+			expires: "-24h",
+			err:     true,
+		},
+		"invalid": {
+			expires: "tomorrow",
+			err:     true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			actual, err := sshKeyExpiration(tc.expires, now)
+			if tc.err {
+				if err == nil {
+					t.Errorf("expected an error for %q", tc.expires)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if actual != tc.expected {
+				t.Errorf("expected %d, got %d", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestResourceUserStateUpgradeV0(t *testing.T) {
+	rawState := map[string]interface{}{
+		"primary_email": "user@example.com",
+		"posix_accounts": []interface{}{
+			map[string]interface{}{
+				"system_id": "",
+				"uid":       "2001",
+				"gid":       "",
+			},
+			map[string]interface{}{
+				"system_id": "ldap",
+				"uid":       "",
+				"gid":       "1000",
+			},
+		},
+	}
+
+	expected := map[string]interface{}{
+		"primary_email": "user@example.com",
+		"posix_accounts": []interface{}{
+			map[string]interface{}{
+				"system_id": "",
+				"uid":       2001,
+				"gid":       0,
+			},
+			map[string]interface{}{
+				"system_id": "ldap",
+				"uid":       0,
+				"gid":       1000,
+			},
+		},
+	}
+
+	actual, err := resourceUserStateUpgradeV0(context.Background(), rawState, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+
+	rawState = map[string]interface{}{
+		"posix_accounts": []interface{}{
+			map[string]interface{}{
+				"uid": "root",
+			},
+		},
+	}
+
+	if _, err := resourceUserStateUpgradeV0(context.Background(), rawState, nil); err == nil {
+		t.Error("expected an error for a uid that isn't a number")
+	}
+}
+
+func TestResourceUserSchemaV0(t *testing.T) {
+	r := resourceUser()
+
+	if err := resourceUserResourceV0(r).InternalValidate(nil, true); err != nil {
+		t.Fatalf("invalid v0 schema: %s", err)
+	}
+
+	if r.Schema["posix_accounts"].Elem.(*schema.Resource).Schema["uid"].Type != schema.TypeInt {
+		t.Error("building the v0 schema changed the current one")
+	}
+}
+
+func TestPlannedExternalIds(t *testing.T) {
+	p := plannedExternalIds{}
+
+	if i, _ := p.claim("", "a@example.com", []string{"employee_number/1"}); i != -1 {
+		t.Fatal("a first external id was reported as planned")
+	}
+
+	if i, _ := p.claim("", "a@example.com", []string{"employee_number/1"}); i != -1 {
+		t.Error("planning the same user again reported its own external id")
+	}
+
+	if i, planned := p.claim("", "b@example.com", []string{"employee_number/2", "employee_number/1"}); i != 1 || planned != "a@example.com" {
+		t.Errorf("expected employee_number/1 to be planned for a@example.com, got %d %q", i, planned)
+	}
+
+	if i, _ := p.claim("", "", []string{"employee_number/1"}); i != -1 {
+		t.Error("a user that can't be told apart was reported")
+	}
+
+	p.claim("01abc", "c@example.com", []string{"employee_number/3"})
+	if !p.removes("01abc", "employee_number/4") {
+		t.Error("an external id the user is planned without wasn't reported as removed")
+	}
+	if p.removes("01abc", "employee_number/3") {
+		t.Error("an external id the user keeps was reported as removed")
+	}
+	if p.removes("01def", "employee_number/4") {
+		t.Error("an external id of a user that isn't planned was reported as removed")
+	}
+}
+
+func TestPlannedPosixUids(t *testing.T) {
+	p := plannedPosixUids{}
+
+	if key, _ := p.claim("a@example.com", []string{"/2001"}); key != "" {
+		t.Fatal("a first uid was reported as planned")
+	}
+
+	if key, _ := p.claim("a@example.com", []string{"/2001"}); key != "" {
+		t.Error("planning the same user again reported its own uid")
+	}
+
+	if key, _ := p.claim("b@example.com", []string{"other/2001"}); key != "" {
+		t.Error("the same uid within another system_id was reported as planned")
+	}
+
+	if key, planned := p.claim("b@example.com", []string{"/2002", "/2001"}); key != "/2001" || planned != "a@example.com" {
+		t.Errorf("expected /2001 to be planned for a@example.com, got %q %q", key, planned)
+	}
+}
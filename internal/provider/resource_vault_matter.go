@@ -0,0 +1,310 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"google.golang.org/api/vault/v1"
+)
+
+func resourceVaultMatter() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Vault Matter resource manages Google Vault matters and their collaborators. Vault Matter resides " +
+			"under the `https://www.googleapis.com/auth/ediscovery` client scope, which isn't one of the provider's " +
+			"default scopes and needs to be added to `oauth_scopes`. The impersonated user needs the Vault " +
+			"privileges to manage matters.",
+
+		CreateContext: resourceVaultMatterCreate,
+		ReadContext:   resourceVaultMatterRead,
+		UpdateContext: resourceVaultMatterUpdate,
+		DeleteContext: resourceVaultMatterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"matter_id": {
+				Description: "The matter ID which is generated by the server.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description: "The name of the matter.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "An optional description for the matter.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"state": {
+				Description: "The state of the matter. Acceptable values are `OPEN` and `CLOSED`. " +
+					"Holds can't be created in closed matters, open matters are closed before they are deleted.",
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "OPEN",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+					"OPEN",
+					"CLOSED",
+				}, false)),
+			},
+			"collaborators": {
+				Description: "Accounts that can access the matter. The account creating the matter is added as its owner " +
+					"by Vault, it's only managed by the provider if it's listed here. If unset, the existing collaborators are left as is.",
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Description: "The account ID, as provided by the Admin SDK.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"role": {
+							Description: "The user's role for the matter. Acceptable values are `COLLABORATOR` and `OWNER`.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "COLLABORATOR",
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+								"COLLABORATOR",
+								"OWNER",
+							}, false)),
+						},
+					},
+				},
+			},
+			// Adding a computed id simply to override the `optional` id that gets added in the SDK
+			// that will then display improperly in the docs
+			"id": {
+				Description: "The ID of this resource.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceVaultMatterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	vaultService, diags := client.NewVaultService()
+	if diags.HasError() {
+		return diags
+	}
+
+	mattersService, diags := GetVaultMattersService(vaultService)
+	if diags.HasError() {
+		return diags
+	}
+
+	name := d.Get("name").(string)
+	log.Printf("[DEBUG] Creating Vault Matter %q", name)
+
+	matter, err := mattersService.Create(&vault.Matter{
+		Name:        name,
+		Description: d.Get("description").(string),
+	}).Do()
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	d.SetId(matter.MatterId)
+
+	for _, collaborator := range d.Get("collaborators").(*schema.Set).List() {
+		if err := addVaultMatterPermission(mattersService, d.Id(), collaborator.(map[string]interface{})); err != nil {
+			return apiErrorDiagnostics(err)
+		}
+	}
+
+	if d.Get("state").(string) == "CLOSED" {
+		_, err := mattersService.Close(d.Id(), &vault.CloseMatterRequest{}).Do()
+		if err != nil {
+			return apiErrorDiagnostics(err)
+		}
+	}
+
+	log.Printf("[DEBUG] Finished creating Vault Matter %q: %#v", d.Id(), name)
+
+	return resourceVaultMatterRead(ctx, d, meta)
+}
+
+func resourceVaultMatterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	vaultService, diags := client.NewVaultService()
+	if diags.HasError() {
+		return diags
+	}
+
+	mattersService, diags := GetVaultMattersService(vaultService)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Getting Vault Matter %q", d.Id())
+
+	matter, err := mattersService.Get(d.Id()).View("FULL").Do()
+	if err != nil {
+		return handleReadNotFoundError(err, d, d.Id())
+	}
+
+	// deleted matters are kept for a while before being purged
+	if matter.State == "DELETED" {
+		log.Printf("[WARN] Vault Matter %q was deleted, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	d.Set("matter_id", matter.MatterId)
+	d.Set("name", matter.Name)
+	d.Set("description", matter.Description)
+	d.Set("state", matter.State)
+
+	// only the configured collaborators are tracked, unless the matter is being imported,
+	// so that the owner added by Vault on creation doesn't cause a diff
+	configured := map[string]bool{}
+	for _, collaborator := range d.Get("collaborators").(*schema.Set).List() {
+		configured[collaborator.(map[string]interface{})["account_id"].(string)] = true
+	}
+
+	var collaborators []map[string]interface{}
+	for _, permission := range matter.MatterPermissions {
+		if len(configured) > 0 && !configured[permission.AccountId] {
+			continue
+		}
+
+		collaborators = append(collaborators, map[string]interface{}{
+			"account_id": permission.AccountId,
+			"role":       permission.Role,
+		})
+	}
+	d.Set("collaborators", collaborators)
+
+	log.Printf("[DEBUG] Finished getting Vault Matter %q: %#v", d.Id(), matter.Name)
+
+	return diags
+}
+
+func resourceVaultMatterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	vaultService, diags := client.NewVaultService()
+	if diags.HasError() {
+		return diags
+	}
+
+	mattersService, diags := GetVaultMattersService(vaultService)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Updating Vault Matter %q", d.Id())
+
+	// closed matters can't be modified, so they're reopened first and closed last
+	if d.HasChange("state") && d.Get("state").(string) == "OPEN" {
+		_, err := mattersService.Reopen(d.Id(), &vault.ReopenMatterRequest{}).Do()
+		if err != nil {
+			return apiErrorDiagnostics(err)
+		}
+	}
+
+	if d.HasChanges("name", "description") {
+		_, err := mattersService.Update(d.Id(), &vault.Matter{
+			MatterId:    d.Id(),
+			Name:        d.Get("name").(string),
+			Description: d.Get("description").(string),
+		}).Do()
+		if err != nil {
+			return apiErrorDiagnostics(err)
+		}
+	}
+
+	if d.HasChange("collaborators") {
+		old, new := d.GetChange("collaborators")
+
+		// a changed role is applied by removing and re-adding the account
+		for _, collaborator := range old.(*schema.Set).Difference(new.(*schema.Set)).List() {
+			accountId := collaborator.(map[string]interface{})["account_id"].(string)
+			_, err := mattersService.RemovePermissions(d.Id(), &vault.RemoveMatterPermissionsRequest{
+				AccountId: accountId,
+			}).Do()
+			if err != nil && !isApiErrorWithCode(err, 404) {
+				return apiErrorDiagnostics(err)
+			}
+		}
+
+		for _, collaborator := range new.(*schema.Set).Difference(old.(*schema.Set)).List() {
+			if err := addVaultMatterPermission(mattersService, d.Id(), collaborator.(map[string]interface{})); err != nil {
+				return apiErrorDiagnostics(err)
+			}
+		}
+	}
+
+	if d.HasChange("state") && d.Get("state").(string) == "CLOSED" {
+		_, err := mattersService.Close(d.Id(), &vault.CloseMatterRequest{}).Do()
+		if err != nil {
+			return apiErrorDiagnostics(err)
+		}
+	}
+
+	log.Printf("[DEBUG] Finished updating Vault Matter %q", d.Id())
+
+	return resourceVaultMatterRead(ctx, d, meta)
+}
+
+func resourceVaultMatterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := meta.(*apiClient)
+
+	vaultService, diags := client.NewVaultService()
+	if diags.HasError() {
+		return diags
+	}
+
+	mattersService, diags := GetVaultMattersService(vaultService)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Deleting Vault Matter %q", d.Id())
+
+	// only closed matters can be deleted
+	if d.Get("state").(string) == "OPEN" {
+		_, err := mattersService.Close(d.Id(), &vault.CloseMatterRequest{}).Do()
+		if err != nil {
+			return handleNotFoundError(err, d, d.Id())
+		}
+	}
+
+	_, err := mattersService.Delete(d.Id()).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, d.Id())
+	}
+
+	log.Printf("[DEBUG] Finished deleting Vault Matter %q", d.Id())
+
+	return diags
+}
+
+func addVaultMatterPermission(mattersService *vault.MattersService, matterId string, collaborator map[string]interface{}) error {
+	_, err := mattersService.AddPermissions(matterId, &vault.AddMatterPermissionsRequest{
+		MatterPermission: &vault.MatterPermission{
+			AccountId: collaborator["account_id"].(string),
+			Role:      collaborator["role"].(string),
+		},
+	}).Do()
+
+	return err
+}
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceVaultMatter_basic(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testMatterVals := map[string]interface{}{
+		"name": fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceVaultMatter_basic(testMatterVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_vault_matter.matter", "state", "OPEN"),
+				),
+			},
+			{
+				ResourceName:      "googleworkspace_vault_matter.matter",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// all of the matter's permissions are imported, including its creator's
+				ImportStateVerifyIgnore: []string{"collaborators"},
+			},
+		},
+	})
+}
+
+func TestAccResourceVaultMatter_full(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testMatterVals := map[string]interface{}{
+		"domainName": domainName,
+		"name":       fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"userEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":   acctest.RandString(10),
+		"state":      "OPEN",
+		"role":       "COLLABORATOR",
+	}
+
+	testMatterValsUpdate := map[string]interface{}{}
+	for k, v := range testMatterVals {
+		testMatterValsUpdate[k] = v
+	}
+	testMatterValsUpdate["state"] = "CLOSED"
+	testMatterValsUpdate["role"] = "OWNER"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceVaultMatter_full(testMatterVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_vault_matter.matter", "collaborators.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("googleworkspace_vault_matter.matter", "collaborators.*", map[string]string{
+						"role": "COLLABORATOR",
+					}),
+				),
+			},
+			{
+				Config: testAccResourceVaultMatter_full(testMatterValsUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_vault_matter.matter", "state", "CLOSED"),
+					resource.TestCheckTypeSetElemNestedAttrs("googleworkspace_vault_matter.matter", "collaborators.*", map[string]string{
+						"role": "OWNER",
+					}),
+				),
+			},
+			{
+				Config: testAccResourceVaultMatter_full(testMatterVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_vault_matter.matter", "state", "OPEN"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceVaultMatter_basic(testMatterVals map[string]interface{}) string {
+	return Nprintf(`
+provider "googleworkspace" {
+  oauth_scopes = ["https://www.googleapis.com/auth/ediscovery"]
+}
+
+resource "googleworkspace_vault_matter" "matter" {
+  name        = "%{name}"
+  description = "created by terraform"
+}
+`, testMatterVals)
+}
+
+func testAccResourceVaultMatter_full(testMatterVals map[string]interface{}) string {
+	return Nprintf(`
+provider "googleworkspace" {
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/admin.directory.user",
+    "https://www.googleapis.com/auth/ediscovery",
+  ]
+}
+
+resource "googleworkspace_user" "collaborator" {
+  primary_email = "%{userEmail}@%{domainName}"
+  password      = "%{password}"
+
+  name {
+    family_name = "Scott"
+    given_name  = "Michael"
+  }
+}
+
+resource "googleworkspace_vault_matter" "matter" {
+  name  = "%{name}"
+  state = "%{state}"
+
+  collaborators {
+    account_id = googleworkspace_user.collaborator.id
+    role       = "%{role}"
+  }
+}
+`, testMatterVals)
+}
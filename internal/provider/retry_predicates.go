@@ -96,6 +96,14 @@ func isCommonRetryableErrorCode(err error) (bool, string) {
 	return false, ""
 }
 
+// Reasons the APIs return with 403 responses when a quota or rate limit is hit, the more
+// specific reasons come first as they contain the more generic ones
+var rateLimitExceededReasons = []string{
+	"userRateLimitExceeded",
+	"rateLimitExceeded",
+	"quotaExceeded",
+}
+
 func isRateLimitExceeded(err error) (bool, string) {
 	gerr, ok := err.(*googleapi.Error)
 	if !ok {
@@ -107,14 +115,38 @@ func isRateLimitExceeded(err error) (bool, string) {
 		return true, fmt.Sprintf("Retryable error code %d", gerr.Code)
 	}
 
-	if gerr.Code == 403 && strings.Contains(gerr.Error(), "quotaExceeded") {
-		log.Printf("[DEBUG] Dismissed an error as retryable based on error code: %s", err)
-		return true, fmt.Sprintf("Retryable error code %d", gerr.Code)
+	if gerr.Code == 403 {
+		if reason := rateLimitExceededReason(gerr); reason != "" {
+			log.Printf("[DEBUG] Dismissed an error as retryable based on error reason: %s", err)
+			return true, fmt.Sprintf("Retryable error code %d with reason %s", gerr.Code, reason)
+		}
 	}
 
 	return false, ""
 }
 
+// rateLimitExceededReason returns the rate limit reason of the error, or an empty string
+// if the error wasn't caused by a rate limit.
+func rateLimitExceededReason(gerr *googleapi.Error) string {
+	for _, item := range gerr.Errors {
+		for _, reason := range rateLimitExceededReasons {
+			if item.Reason == reason {
+				return reason
+			}
+		}
+	}
+
+	// the retry transport checks a dump of the response, in which case the
+	// reasons aren't parsed and are only part of the body
+	for _, reason := range rateLimitExceededReasons {
+		if strings.Contains(gerr.Error(), reason) {
+			return reason
+		}
+	}
+
+	return ""
+}
+
 // IsNotFound reports whether err is the result of the
 // server replying with http.StatusNotFound.
 // Such error values are sometimes returned by "Do" methods
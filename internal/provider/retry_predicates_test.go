@@ -5,6 +5,7 @@ package googleworkspace
 
 import (
 	"strconv"
+	"strings"
 	"testing"
 
 	"google.golang.org/api/googleapi"
@@ -60,6 +61,35 @@ func TestIsOperationReadQuotaError_rateLimitExceeded(t *testing.T) {
 	}
 }
 
+func TestIsOperationReadQuotaError_userRateLimitExceeded(t *testing.T) {
+	err := googleapi.Error{
+		Code: 403,
+		Errors: []googleapi.ErrorItem{
+			{Reason: "userRateLimitExceeded", Message: "User Rate Limit Exceeded"},
+		},
+	}
+	isRetryable, reason := isRateLimitExceeded(&err)
+	if !isRetryable {
+		t.Errorf("Error not detected as retryable")
+	}
+	if !strings.Contains(reason, "userRateLimitExceeded") {
+		t.Errorf("Expected reason to mention userRateLimitExceeded, got %q", reason)
+	}
+}
+
+func TestIsOperationReadQuotaError_forbidden(t *testing.T) {
+	err := googleapi.Error{
+		Code: 403,
+		Errors: []googleapi.ErrorItem{
+			{Reason: "forbidden", Message: "Not Authorized to access this resource/api"},
+		},
+	}
+	isRetryable, _ := isRateLimitExceeded(&err)
+	if isRetryable {
+		t.Errorf("Error incorrectly detected as retryable")
+	}
+}
+
 func TestGoogle404Error(t *testing.T) {
 	gerr := googleapi.Error{
 		Code:    404,
@@ -14,11 +14,17 @@ import (
 	"log"
 	"net/http"
 	"net/http/httputil"
+	"strconv"
+	"strings"
 	"time"
 )
 
 const defaultRetryTransportTimeoutSec = 90
 
+// Rate limits are enforced over windows of several seconds, so retrying them
+// sooner than this only uses up more of the quota
+const rateLimitMinBackoff = time.Second * 2
+
 type retryTransport struct {
 	retryPredicates []RetryErrorPredicateFunc
 	internal        http.RoundTripper
@@ -90,22 +96,39 @@ Retry:
 			break Retry
 		}
 
-		log.Printf("[DEBUG] Retry Transport: Waiting %s before trying request again", backoff)
+		wait := backoff
+		if retryAfter, ok := retryAfterDuration(resp, time.Now()); ok {
+			log.Printf("[DEBUG] Retry Transport: Server asked to retry after %s", retryAfter)
+			if retryAfter > wait {
+				wait = retryAfter
+			}
+		} else if rateLimited, _ := isRateLimitExceeded(retryErr.Err); rateLimited && wait < rateLimitMinBackoff {
+			wait = rateLimitMinBackoff
+		}
+
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+			log.Printf("[DEBUG] Retry Transport: Stopping retries, waiting %s would exceed the context deadline", wait)
+			break Retry
+		}
+
+		log.Printf("[DEBUG] Retry Transport: Waiting %s before trying request again", wait)
 		select {
 		case <-ctx.Done():
 			log.Printf("[DEBUG] Retry Transport: Stopping retries, context done: %v", ctx.Err())
 			break Retry
-		case <-time.After(backoff):
-			log.Printf("[DEBUG] Retry Transport: Finished waiting %s before next retry", backoff)
+		case <-time.After(wait):
+			log.Printf("[DEBUG] Retry Transport: Finished waiting %s before next retry", wait)
 
 			// Fibonnaci backoff - 0.5, 1, 1.5, 2.5, 4, 6.5, 10.5, ...
+			// the server's wait only applies to this retry, so it doesn't grow the sequence
 			lastBackoff := backoff
-			backoff = backoff + nextBackoff
+			backoff += nextBackoff
 			nextBackoff = lastBackoff
 			continue
 		}
 	}
 	log.Printf("[DEBUG] Retry Transport: Returning after %d attempts", attempts)
+	recordRetryCount(req.Context(), attempts)
 	return resp, respErr
 }
 
@@ -143,6 +166,35 @@ func (t *retryTransport) checkForRetryableError(resp *http.Response, respErr err
 	return resource.NonRetryableError(errToCheck)
 }
 
+// retryAfterDuration parses the Retry-After header of the response, which is either
+// a number of seconds or an HTTP date.
+func retryAfterDuration(resp *http.Response, now time.Time) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+
+	retryAfter := strings.TrimSpace(resp.Header.Get("Retry-After"))
+	if retryAfter == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(retryAfter); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(retryAfter); err == nil {
+		if date.Before(now) {
+			return 0, true
+		}
+		return date.Sub(now), true
+	}
+
+	return 0, false
+}
+
 // copyHttpRequest provides an copy of the given HTTP request for one RoundTrip.
 // If the request has a non-empty body (io.ReadCloser), the body is deep copied
 // so it can be consumed.
@@ -171,6 +171,133 @@ func TestRetryTransport_DoesNotRetryEmptyGetBody(t *testing.T) {
 	testRetryTransport_checkFailedWhileRetrying(t, resp, err)
 }
 
+// Check that the Retry-After header of rate limited requests is honored
+func TestRetryTransport_RetryAfter(t *testing.T) {
+	var firstReqTime time.Time
+	var attempts int
+
+	ts, client := setUpRetryTransportServerClient(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			if attempts == 1 {
+				firstReqTime = time.Now()
+				w.Header().Set("Retry-After", "1")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+
+			if time.Since(firstReqTime) < time.Second {
+				t.Errorf("request was retried after %s, expected to wait at least 1s", time.Since(firstReqTime))
+			}
+			w.WriteHeader(testRetryTransportCodeSuccess)
+		}))
+	defer ts.Close()
+
+	ctx, cc := context.WithTimeout(context.Background(), time.Second*3)
+	defer cc()
+	req, err := http.NewRequestWithContext(ctx, "GET", ts.URL, nil)
+	if err != nil {
+		t.Fatalf("unable to construct err: %v", err)
+	}
+
+	resp, err := client.Do(req)
+	testRetryTransport_checkSuccess(t, resp, err)
+}
+
+// Check that the wait asked by the server doesn't grow the backoff of later retries
+func TestRetryTransport_RetryAfterDoesNotGrowBackoff(t *testing.T) {
+	var secondReqTime time.Time
+	var attempts int
+
+	ts, client := setUpRetryTransportServerClient(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			switch attempts {
+			case 1:
+				w.Header().Set("Retry-After", "1")
+				w.WriteHeader(http.StatusTooManyRequests)
+			case 2:
+				secondReqTime = time.Now()
+				w.WriteHeader(testRetryTransportCodeRetry)
+			default:
+				// the second backoff is 1s, seeding it with the server's wait makes it 1.5s
+				if time.Since(secondReqTime) > 1400*time.Millisecond {
+					t.Errorf("request was retried after %s, expected the backoff to ignore Retry-After", time.Since(secondReqTime))
+				}
+				w.WriteHeader(testRetryTransportCodeSuccess)
+			}
+		}))
+	defer ts.Close()
+
+	ctx, cc := context.WithTimeout(context.Background(), time.Second*5)
+	defer cc()
+	req, err := http.NewRequestWithContext(ctx, "GET", ts.URL, nil)
+	if err != nil {
+		t.Fatalf("unable to construct err: %v", err)
+	}
+
+	resp, err := client.Do(req)
+	testRetryTransport_checkSuccess(t, resp, err)
+}
+
+// Check that the request isn't retried if Retry-After is past the context deadline
+func TestRetryTransport_RetryAfterExceedsDeadline(t *testing.T) {
+	ts, client := setUpRetryTransportServerClient(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Retry-After", "10")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+	defer ts.Close()
+
+	ctx, cc := context.WithTimeout(context.Background(), time.Second*2)
+	defer cc()
+	req, err := http.NewRequestWithContext(ctx, "GET", ts.URL, nil)
+	if err != nil {
+		t.Fatalf("unable to construct err: %v", err)
+	}
+
+	start := time.Now()
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("expected response error, got actual error for doing request: %v", err)
+	}
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("expected status code %d, got %d", http.StatusTooManyRequests, resp.StatusCode)
+	}
+	if time.Since(start) > time.Second {
+		t.Errorf("expected to stop retrying immediately, took %s", time.Since(start))
+	}
+}
+
+func TestRetryAfterDuration(t *testing.T) {
+	now := time.Date(2022, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		header   string
+		expected time.Duration
+		ok       bool
+	}{
+		"seconds":     {header: "30", expected: 30 * time.Second, ok: true},
+		"date":        {header: now.Add(time.Minute).Format(http.TimeFormat), expected: time.Minute, ok: true},
+		"past date":   {header: now.Add(-time.Minute).Format(http.TimeFormat), expected: 0, ok: true},
+		"unset":       {header: "", ok: false},
+		"negative":    {header: "-1", ok: false},
+		"unparseable": {header: "soon", ok: false},
+	}
+
+	for tn, tc := range cases {
+		resp := &http.Response{Header: http.Header{}}
+		if tc.header != "" {
+			resp.Header.Set("Retry-After", tc.header)
+		}
+
+		actual, ok := retryAfterDuration(resp, now)
+		if ok != tc.ok || actual != tc.expected {
+			t.Errorf("%s: expected (%s, %t), got (%s, %t)", tn, tc.expected, tc.ok, actual, ok)
+		}
+	}
+}
+
 // handlers
 func testRetryTransportHandler_noRetries(t *testing.T, code int) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -8,12 +8,121 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
+	datatransfer "google.golang.org/api/admin/datatransfer/v1"
 	directory "google.golang.org/api/admin/directory/v1"
+	alertcenter "google.golang.org/api/alertcenter/v1beta1"
+	"google.golang.org/api/calendar/v3"
 	"google.golang.org/api/chromepolicy/v1"
+	"google.golang.org/api/cloudidentity/v1"
+	"google.golang.org/api/drive/v3"
 	"google.golang.org/api/gmail/v1"
 	"google.golang.org/api/groupssettings/v1"
+	"google.golang.org/api/iam/v1"
+	"google.golang.org/api/licensing/v1"
+	"google.golang.org/api/reseller/v1"
+	"google.golang.org/api/siteverification/v1"
+	"google.golang.org/api/vault/v1"
 )
 
+func GetAlertFeedbackService(alertsService *alertcenter.AlertsService) (*alertcenter.AlertsFeedbackService, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	log.Printf("[INFO] Instantiating Alert Center Alert Feedback service")
+	feedbackService := alertsService.Feedback
+	if feedbackService == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Alert Center Alert Feedback Service could not be created.",
+		})
+
+		return nil, diags
+	}
+
+	return feedbackService, diags
+}
+
+func GetAlertsService(alertCenterService *alertcenter.Service) (*alertcenter.AlertsService, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	log.Printf("[INFO] Instantiating Alert Center Alerts service")
+	alertsService := alertCenterService.Alerts
+	if alertsService == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Alert Center Alerts Service could not be created.",
+		})
+
+		return nil, diags
+	}
+
+	return alertsService, diags
+}
+
+func GetBuildingsService(directoryService *directory.Service) (*directory.ResourcesBuildingsService, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	log.Printf("[INFO] Instantiating Google Admin Buildings service")
+	if directoryService.Resources == nil || directoryService.Resources.Buildings == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Buildings Service could not be created.",
+		})
+
+		return nil, diags
+	}
+
+	return directoryService.Resources.Buildings, diags
+}
+
+func GetCalendarAclService(calendarService *calendar.Service) (*calendar.AclService, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	log.Printf("[INFO] Instantiating Calendar Acl service")
+	aclService := calendarService.Acl
+	if aclService == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Calendar Acl Service could not be created.",
+		})
+
+		return nil, diags
+	}
+
+	return aclService, diags
+}
+
+func GetCalendarFeaturesService(directoryService *directory.Service) (*directory.ResourcesFeaturesService, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	log.Printf("[INFO] Instantiating Google Admin Calendar Features service")
+	if directoryService.Resources == nil || directoryService.Resources.Features == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Calendar Features Service could not be created.",
+		})
+
+		return nil, diags
+	}
+
+	return directoryService.Resources.Features, diags
+}
+
+func GetCalendarResourcesService(directoryService *directory.Service) (*directory.ResourcesCalendarsService, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	log.Printf("[INFO] Instantiating Google Admin Calendar Resources service")
+	if directoryService.Resources == nil || directoryService.Resources.Calendars == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Calendar Resources Service could not be created.",
+		})
+
+		return nil, diags
+	}
+
+	return directoryService.Resources.Calendars, diags
+}
+
 func GetChromePoliciesService(chromePolicyService *chromepolicy.Service) (*chromepolicy.CustomersPoliciesService, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -48,6 +157,176 @@ func GetChromePolicySchemasService(chromePolicyService *chromepolicy.Service) (*
 	return customersService.PolicySchemas, diags
 }
 
+func GetChromePrintServersService(directoryService *directory.Service) (*directory.CustomersChromePrintServersService, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	log.Printf("[INFO] Instantiating Chrome Print Servers service")
+	customersService := directoryService.Customers
+	if customersService == nil || customersService.Chrome == nil || customersService.Chrome.PrintServers == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Chrome Print Servers Service could not be created.",
+		})
+
+		return nil, diags
+	}
+
+	return customersService.Chrome.PrintServers, diags
+}
+
+func GetChromeosDevicesService(directoryService *directory.Service) (*directory.ChromeosdevicesService, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	log.Printf("[INFO] Instantiating Google Admin Chrome OS Devices service")
+	chromeosDevicesService := directoryService.Chromeosdevices
+	if chromeosDevicesService == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Chrome OS Devices Service could not be created.",
+		})
+
+		return nil, diags
+	}
+
+	return chromeosDevicesService, diags
+}
+
+func GetCloudIdentityDeviceUsersService(devicesService *cloudidentity.DevicesService) (*cloudidentity.DevicesDeviceUsersService, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	log.Printf("[INFO] Instantiating Cloud Identity Device Users service")
+	deviceUsersService := devicesService.DeviceUsers
+	if deviceUsersService == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cloud Identity Device Users Service could not be created.",
+		})
+
+		return nil, diags
+	}
+
+	return deviceUsersService, diags
+}
+
+func GetCloudIdentityDevicesService(cloudIdentityService *cloudidentity.Service) (*cloudidentity.DevicesService, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	log.Printf("[INFO] Instantiating Cloud Identity Devices service")
+	devicesService := cloudIdentityService.Devices
+	if devicesService == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cloud Identity Devices Service could not be created.",
+		})
+
+		return nil, diags
+	}
+
+	return devicesService, diags
+}
+
+func GetCloudIdentityGroupsService(cloudIdentityService *cloudidentity.Service) (*cloudidentity.GroupsService, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	log.Printf("[INFO] Instantiating Cloud Identity Groups service")
+	groupsService := cloudIdentityService.Groups
+	if groupsService == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cloud Identity Groups Service could not be created.",
+		})
+
+		return nil, diags
+	}
+
+	return groupsService, diags
+}
+
+func GetCloudIdentityMembershipsService(groupsService *cloudidentity.GroupsService) (*cloudidentity.GroupsMembershipsService, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	log.Printf("[INFO] Instantiating Cloud Identity Group Memberships service")
+	membershipsService := groupsService.Memberships
+	if membershipsService == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cloud Identity Group Memberships Service could not be created.",
+		})
+
+		return nil, diags
+	}
+
+	return membershipsService, diags
+}
+
+func GetCloudIdentityUserInvitationsService(cloudIdentityService *cloudidentity.Service) (*cloudidentity.CustomersUserinvitationsService, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	log.Printf("[INFO] Instantiating Cloud Identity User Invitations service")
+	customersService := cloudIdentityService.Customers
+	if customersService == nil || customersService.Userinvitations == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cloud Identity User Invitations Service could not be created.",
+		})
+
+		return nil, diags
+	}
+
+	return customersService.Userinvitations, diags
+}
+
+func GetCustomersService(directoryService *directory.Service) (*directory.CustomersService, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	log.Printf("[INFO] Instantiating Customers service")
+	customersService := directoryService.Customers
+	if customersService == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Customers Service could not be created.",
+		})
+
+		return nil, diags
+	}
+
+	return customersService, diags
+}
+
+func GetDataTransferApplicationsService(dataTransferService *datatransfer.Service) (*datatransfer.ApplicationsService, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	log.Printf("[INFO] Instantiating Data Transfer Applications service")
+	applicationsService := dataTransferService.Applications
+	if applicationsService == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Data Transfer Applications Service could not be created.",
+		})
+
+		return nil, diags
+	}
+
+	return applicationsService, diags
+}
+
+func GetDataTransferTransfersService(dataTransferService *datatransfer.Service) (*datatransfer.TransfersService, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	log.Printf("[INFO] Instantiating Data Transfer Transfers service")
+	transfersService := dataTransferService.Transfers
+	if transfersService == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Data Transfer Transfers Service could not be created.",
+		})
+
+		return nil, diags
+	}
+
+	return transfersService, diags
+}
+
 func GetDomainAliasesService(directoryService *directory.Service) (*directory.DomainAliasesService, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -82,6 +361,40 @@ func GetDomainsService(directoryService *directory.Service) (*directory.DomainsS
 	return domainsService, diags
 }
 
+func GetDrivePermissionsService(driveService *drive.Service) (*drive.PermissionsService, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	log.Printf("[INFO] Instantiating Drive Permissions service")
+	permissionsService := driveService.Permissions
+	if permissionsService == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Drive Permissions Service could not be created.",
+		})
+
+		return nil, diags
+	}
+
+	return permissionsService, diags
+}
+
+func GetGmailUsersService(gmailService *gmail.Service) (*gmail.UsersService, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	log.Printf("[INFO] Instantiating Google Admin Gmail Users service")
+	usersService := gmailService.Users
+	if usersService == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Gmail Users Service could not be created.",
+		})
+
+		return nil, diags
+	}
+
+	return usersService, diags
+}
+
 func GetGroupsService(directoryService *directory.Service) (*directory.GroupsService, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -150,6 +463,39 @@ func GetGroupAliasService(groupsService *directory.GroupsService) (*directory.Gr
 	return aliasesService, diags
 }
 
+func GetIamServiceAccountsService(iamService *iam.Service) (*iam.ProjectsServiceAccountsService, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	log.Printf("[INFO] Instantiating IAM Service Accounts service")
+	if iamService.Projects == nil || iamService.Projects.ServiceAccounts == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "IAM Service Accounts Service could not be created.",
+		})
+
+		return nil, diags
+	}
+
+	return iamService.Projects.ServiceAccounts, diags
+}
+
+func GetLicenseAssignmentsService(licensingService *licensing.Service) (*licensing.LicenseAssignmentsService, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	log.Printf("[INFO] Instantiating License Assignments service")
+	licenseAssignmentsService := licensingService.LicenseAssignments
+	if licenseAssignmentsService == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "License Assignments Service could not be created.",
+		})
+
+		return nil, diags
+	}
+
+	return licenseAssignmentsService, diags
+}
+
 func GetMembersService(directoryService *directory.Service) (*directory.MembersService, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -201,6 +547,23 @@ func GetPrivilegesService(directoryService *directory.Service) (*directory.Privi
 	return privilegesService, diags
 }
 
+func GetResellerSubscriptionsService(resellerService *reseller.Service) (*reseller.SubscriptionsService, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	log.Printf("[INFO] Instantiating Reseller Subscriptions service")
+	subscriptionsService := resellerService.Subscriptions
+	if subscriptionsService == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Reseller Subscriptions Service could not be created.",
+		})
+
+		return nil, diags
+	}
+
+	return subscriptionsService, diags
+}
+
 func GetRoleAssignmentsService(directoryService *directory.Service) (*directory.RoleAssignmentsService, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -252,6 +615,23 @@ func GetSchemasService(directoryService *directory.Service) (*directory.SchemasS
 	return schemasService, diags
 }
 
+func GetSiteVerificationWebResourceService(siteVerificationService *siteverification.Service) (*siteverification.WebResourceService, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	log.Printf("[INFO] Instantiating Site Verification Web Resource service")
+	webResourceService := siteVerificationService.WebResource
+	if webResourceService == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Site Verification Web Resource Service could not be created.",
+		})
+
+		return nil, diags
+	}
+
+	return webResourceService, diags
+}
+
 func GetUsersService(directoryService *directory.Service) (*directory.UsersService, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -285,3 +665,20 @@ func GetUserAliasService(usersService *directory.UsersService) (*directory.Users
 
 	return aliasesService, diags
 }
+
+func GetVaultMattersService(vaultService *vault.Service) (*vault.MattersService, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	log.Printf("[INFO] Instantiating Vault Matters service")
+	mattersService := vaultService.Matters
+	if mattersService == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Vault Matters Service could not be created.",
+		})
+
+		return nil, diags
+	}
+
+	return mattersService, diags
+}
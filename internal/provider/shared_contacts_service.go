@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"google.golang.org/api/googleapi"
+)
+
+const (
+	sharedContactsBasePath = "https://www.google.com/m8/feeds/"
+
+	atomNamespace    = "http://www.w3.org/2005/Atom"
+	gdataNamespace   = "http://schemas.google.com/g/2005"
+	gdataRelPrefix   = gdataNamespace + "#"
+	gdataContactKind = "http://schemas.google.com/contact/2008#contact"
+)
+
+// sharedContactsService is a client of the Domain Shared Contacts API, a GData API exchanging
+// Atom entries that has no client in google.golang.org/api,
+// see https://developers.google.com/admin-sdk/domain-shared-contacts
+type sharedContactsService struct {
+	client   *http.Client
+	BasePath string
+}
+
+// sharedContact is the Atom entry of a domain shared contact
+type sharedContact struct {
+	XMLName       xml.Name             `xml:"http://www.w3.org/2005/Atom entry"`
+	Etag          string               `xml:"http://schemas.google.com/g/2005 etag,attr,omitempty"`
+	Id            string               `xml:"http://www.w3.org/2005/Atom id,omitempty"`
+	Category      *atomCategory        `xml:"http://www.w3.org/2005/Atom category"`
+	Name          *gdataName           `xml:"http://schemas.google.com/g/2005 name,omitempty"`
+	Content       string               `xml:"http://www.w3.org/2005/Atom content,omitempty"`
+	Emails        []gdataEmail         `xml:"http://schemas.google.com/g/2005 email"`
+	PhoneNumbers  []gdataPhoneNumber   `xml:"http://schemas.google.com/g/2005 phoneNumber"`
+	Organizations []gdataOrganization  `xml:"http://schemas.google.com/g/2005 organization"`
+	Addresses     []gdataPostalAddress `xml:"http://schemas.google.com/g/2005 structuredPostalAddress"`
+}
+
+type atomCategory struct {
+	Scheme string `xml:"scheme,attr"`
+	Term   string `xml:"term,attr"`
+}
+
+type gdataName struct {
+	GivenName  string `xml:"http://schemas.google.com/g/2005 givenName,omitempty"`
+	FamilyName string `xml:"http://schemas.google.com/g/2005 familyName,omitempty"`
+	FullName   string `xml:"http://schemas.google.com/g/2005 fullName,omitempty"`
+}
+
+type gdataEmail struct {
+	Address     string `xml:"address,attr"`
+	Rel         string `xml:"rel,attr,omitempty"`
+	DisplayName string `xml:"displayName,attr,omitempty"`
+	Primary     bool   `xml:"primary,attr,omitempty"`
+}
+
+type gdataPhoneNumber struct {
+	Number  string `xml:",chardata"`
+	Rel     string `xml:"rel,attr,omitempty"`
+	Primary bool   `xml:"primary,attr,omitempty"`
+}
+
+type gdataOrganization struct {
+	Rel     string `xml:"rel,attr,omitempty"`
+	Primary bool   `xml:"primary,attr,omitempty"`
+	Name    string `xml:"http://schemas.google.com/g/2005 orgName,omitempty"`
+	Title   string `xml:"http://schemas.google.com/g/2005 orgTitle,omitempty"`
+}
+
+type gdataPostalAddress struct {
+	Rel              string `xml:"rel,attr,omitempty"`
+	Primary          bool   `xml:"primary,attr,omitempty"`
+	FormattedAddress string `xml:"http://schemas.google.com/g/2005 formattedAddress,omitempty"`
+}
+
+// contactId returns the ID of the contact, the last segment of the entry's id URL
+func (c *sharedContact) contactId() string {
+	parts := strings.Split(c.Id, "/")
+	return parts[len(parts)-1]
+}
+
+func (s *sharedContactsService) Insert(ctx context.Context, domain string, contact *sharedContact) (*sharedContact, error) {
+	contact.Category = &atomCategory{Scheme: gdataRelPrefix + "kind", Term: gdataContactKind}
+
+	return s.do(ctx, "POST", fmt.Sprintf("contacts/%s/full", domain), contact)
+}
+
+func (s *sharedContactsService) Get(ctx context.Context, domain, contactId string) (*sharedContact, error) {
+	return s.do(ctx, "GET", fmt.Sprintf("contacts/%s/full/%s", domain, contactId), nil)
+}
+
+// Update replaces the contact, regardless of its etag
+func (s *sharedContactsService) Update(ctx context.Context, domain, contactId string, contact *sharedContact) (*sharedContact, error) {
+	contact.Category = &atomCategory{Scheme: gdataRelPrefix + "kind", Term: gdataContactKind}
+
+	return s.do(ctx, "PUT", fmt.Sprintf("contacts/%s/full/%s", domain, contactId), contact)
+}
+
+func (s *sharedContactsService) Delete(ctx context.Context, domain, contactId string) error {
+	_, err := s.do(ctx, "DELETE", fmt.Sprintf("contacts/%s/full/%s", domain, contactId), nil)
+	return err
+}
+
+func (s *sharedContactsService) do(ctx context.Context, method, path string, contact *sharedContact) (*sharedContact, error) {
+	var reqBody bytes.Buffer
+	if contact != nil {
+		reqBody.WriteString(xml.Header)
+		if err := xml.NewEncoder(&reqBody).Encode(contact); err != nil {
+			return nil, err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(s.BasePath, "/")+"/"+path, &reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("GData-Version", "3.0")
+	if contact != nil {
+		req.Header.Set("Content-Type", "application/atom+xml")
+	}
+	if method == "PUT" || method == "DELETE" {
+		req.Header.Set("If-Match", "*")
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer googleapi.CloseBody(resp)
+
+	if err := googleapi.CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	if method == "DELETE" {
+		return nil, nil
+	}
+
+	var result sharedContact
+	if err := xml.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestSharedContactsService_Insert(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/contacts/example.com/full" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if r.Header.Get("GData-Version") != "3.0" {
+			t.Errorf("expected the GData version to be set")
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		for _, expected := range []string{
+			`term="http://schemas.google.com/contact/2008#contact"`,
+			`<givenName xmlns="http://schemas.google.com/g/2005">Elizabeth</givenName>`,
+			`address="liz@example.org"`,
+			`primary="true"`,
+		} {
+			if !strings.Contains(string(body), expected) {
+				t.Errorf("expected the request body to contain %q, got:\n%s", expected, body)
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/atom+xml")
+		fmt.Fprint(w, `<?xml version='1.0' encoding='UTF-8'?>
+<entry xmlns='http://www.w3.org/2005/Atom' xmlns:gd='http://schemas.google.com/g/2005' gd:etag='"Qn04eTVSLyp7I2A9XRNRGEwNRwQ."'>
+  <id>http://www.google.com/m8/feeds/contacts/example.com/base/8411573</id>
+  <gd:name><gd:givenName>Elizabeth</gd:givenName><gd:familyName>Bennet</gd:familyName><gd:fullName>Elizabeth Bennet</gd:fullName></gd:name>
+  <gd:email rel='http://schemas.google.com/g/2005#work' primary='true' address='liz@example.org'/>
+  <gd:phoneNumber rel='http://schemas.google.com/g/2005#mobile'>(206)555-1212</gd:phoneNumber>
+  <gd:organization rel='http://schemas.google.com/g/2005#work'><gd:orgName>Longbourn</gd:orgName></gd:organization>
+</entry>`)
+	}))
+	defer ts.Close()
+
+	service := &sharedContactsService{client: ts.Client(), BasePath: ts.URL + "/"}
+
+	contact, err := service.Insert(context.Background(), "example.com", &sharedContact{
+		Name:   &gdataName{GivenName: "Elizabeth", FamilyName: "Bennet"},
+		Emails: []gdataEmail{{Address: "liz@example.org", Rel: gdataRelPrefix + "work", Primary: true}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if contact.contactId() != "8411573" {
+		t.Errorf("unexpected contact id %q", contact.contactId())
+	}
+	if contact.Etag != `"Qn04eTVSLyp7I2A9XRNRGEwNRwQ."` {
+		t.Errorf("unexpected etag %q", contact.Etag)
+	}
+	if contact.Name.FullName != "Elizabeth Bennet" {
+		t.Errorf("unexpected name %#v", contact.Name)
+	}
+	if len(contact.PhoneNumbers) != 1 || contact.PhoneNumbers[0].Number != "(206)555-1212" || contact.PhoneNumbers[0].Rel != gdataRelPrefix+"mobile" {
+		t.Errorf("unexpected phone numbers %#v", contact.PhoneNumbers)
+	}
+	if len(contact.Organizations) != 1 || contact.Organizations[0].Name != "Longbourn" {
+		t.Errorf("unexpected organizations %#v", contact.Organizations)
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/hashicorp/terraform-provider-googleworkspace"
+
+// Span attribute keys specific to the provider
+const (
+	tracingAttrResourceType = attribute.Key("googleworkspace.resource_type")
+	tracingAttrOperation    = attribute.Key("googleworkspace.operation")
+	tracingAttrRetryCount   = attribute.Key("googleworkspace.retry_count")
+)
+
+// SetupTracing configures OpenTelemetry tracing of the provider's API calls if an OTLP endpoint
+// is set with the OTEL_EXPORTER_OTLP_ENDPOINT or OTEL_EXPORTER_OTLP_TRACES_ENDPOINT environment
+// variables, the exporter is configured with the standard OTEL_* environment variables.
+// The returned func flushes the remaining spans and should be called before the provider exits.
+func SetupTracing(ctx context.Context, version string) (func(context.Context) error, error) {
+	noop := func(context.Context) error { return nil }
+
+	if os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") == "" && os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") == "" {
+		return noop, nil
+	}
+
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return noop, fmt.Errorf("unable to create the OTLP trace exporter: %w", err)
+	}
+
+	// attributes from OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES take precedence
+	res, err := resource.New(ctx,
+		resource.WithAttributes(
+			semconv.ServiceNameKey.String("terraform-provider-googleworkspace"),
+			semconv.ServiceVersionKey.String(version),
+		),
+		resource.WithFromEnv(),
+	)
+	if err != nil {
+		return noop, fmt.Errorf("unable to create the OpenTelemetry resource: %w", err)
+	}
+
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(tracerProvider)
+
+	return tracerProvider.Shutdown, nil
+}
+
+// tracingTransport records a span for each API request, including the retries made by the
+// wrapped retry transport
+type tracingTransport struct {
+	transport http.RoundTripper
+}
+
+func NewTransportWithTracing(t http.RoundTripper) *tracingTransport {
+	return &tracingTransport{transport: t}
+}
+
+func (t *tracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, span := otel.Tracer(tracerName).Start(req.Context(), fmt.Sprintf("%s %s", req.Method, req.URL.Host),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.HTTPMethodKey.String(req.Method),
+			semconv.HTTPHostKey.String(req.URL.Host),
+			semconv.HTTPTargetKey.String(req.URL.Path),
+		),
+	)
+	defer span.End()
+
+	resp, err := t.transport.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return resp, err
+	}
+
+	span.SetAttributes(semconv.HTTPStatusCodeKey.Int(resp.StatusCode))
+	if resp.StatusCode >= 400 {
+		span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+	}
+
+	return resp, nil
+}
+
+// recordRetryCount adds the number of retries of a request to its span, if it's traced
+func recordRetryCount(ctx context.Context, attempts int) {
+	if attempts > 0 {
+		trace.SpanFromContext(ctx).SetAttributes(tracingAttrRetryCount.Int(attempts - 1))
+	}
+}
+
+// traceResource wraps the CRUD functions of the resource or data source in spans, recording
+// the resource type and operation next to the spans of the API requests
+func traceResource(resourceType string, r *schema.Resource) *schema.Resource {
+	// the CRUD func types share their signature, so they can be wrapped by the same func
+	r.CreateContext = traceCRUDFunc(resourceType, "create", r.CreateContext)
+	r.ReadContext = traceCRUDFunc(resourceType, "read", r.ReadContext)
+	r.UpdateContext = traceCRUDFunc(resourceType, "update", r.UpdateContext)
+	r.DeleteContext = traceCRUDFunc(resourceType, "delete", r.DeleteContext)
+
+	return r
+}
+
+func traceCRUDFunc(resourceType, operation string, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		ctx, span := otel.Tracer(tracerName).Start(ctx, fmt.Sprintf("%s.%s", resourceType, operation),
+			trace.WithAttributes(
+				tracingAttrResourceType.String(resourceType),
+				tracingAttrOperation.String(operation),
+			),
+		)
+		defer span.End()
+
+		diags := f(ctx, d, meta)
+		if diags.HasError() {
+			for _, d := range diags {
+				if d.Severity == diag.Error {
+					span.SetStatus(codes.Error, d.Summary)
+					break
+				}
+			}
+		}
+
+		return diags
+	}
+}
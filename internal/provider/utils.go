@@ -61,6 +61,8 @@ func isApiErrorWithCode(err error, errCode int) bool {
 	return ok && gerr != nil && gerr.Code == errCode
 }
 
+// handleNotFoundError removes the resource from state if err is a 404, e.g. as it was deleted outside of
+// Terraform, or as it is already gone when deleting it. Any other error is returned as is.
 func handleNotFoundError(err error, d *schema.ResourceData, resource string) diag.Diagnostics {
 	if isApiErrorWithCode(err, 404) {
 		log.Printf("[WARN] Removing %s because it's gone", resource)
@@ -70,7 +72,35 @@ func handleNotFoundError(err error, d *schema.ResourceData, resource string) dia
 		return nil
 	}
 
-	return diag.Errorf("Error when reading or editing %s: %s", resource, err.Error())
+	diags := apiErrorDiagnostics(err)
+	for i := range diags {
+		diags[i].Summary = fmt.Sprintf("Error when reading or editing %s: %s", resource, diags[i].Summary)
+	}
+
+	return diags
+}
+
+// handleReadNotFoundError is handleNotFoundError for the Read of resources, which warns that a resource
+// deleted outside of Terraform has been removed from state, so that refreshing doesn't fail and it's
+// created again if still configured.
+func handleReadNotFoundError(err error, d *schema.ResourceData, resource string) diag.Diagnostics {
+	if isApiErrorWithCode(err, 404) {
+		handleNotFoundError(err, d, resource)
+
+		return diag.Diagnostics{removedFromStateWarning(resource)}
+	}
+
+	return handleNotFoundError(err, d, resource)
+}
+
+const removedFromStateDetail = "It was deleted outside of Terraform and has been removed from the state."
+
+func removedFromStateWarning(resource string) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("%s no longer exists", resource),
+		Detail:   removedFromStateDetail,
+	}
 }
 
 // This is a Printf sibling (Nprintf; Named Printf), which handles strings like
@@ -171,6 +201,10 @@ func listOfInterfacestoStrings(v interface{}) []string {
 		return result
 	}
 
+	if set, ok := v.(*schema.Set); ok {
+		v = set.List()
+	}
+
 	for _, s := range v.([]interface{}) {
 		result = append(result, s.(string))
 	}
@@ -210,3 +244,69 @@ func isEmail(input string) bool {
 	}
 	return true
 }
+
+// celStringLiteral quotes the input as a string literal of a CEL query, or a value of a Directory API search
+// query which is escaped the same way, escaping the backslashes and quotes it contains so that it can't alter
+// the query
+func celStringLiteral(input string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(input) + "'"
+}
+
+// closestStrings returns the candidates within maxDistance edits of the input, closest first,
+// e.g. to suggest a fix for a misspelled name
+func closestStrings(input string, candidates []string, maxDistance int) []string {
+	distances := map[string]int{}
+	var result []string
+	for _, c := range candidates {
+		if _, ok := distances[c]; ok {
+			continue
+		}
+
+		distance := levenshteinDistance(strings.ToUpper(input), strings.ToUpper(c))
+		distances[c] = distance
+		if distance <= maxDistance {
+			result = append(result, c)
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if distances[result[i]] != distances[result[j]] {
+			return distances[result[i]] < distances[result[j]]
+		}
+		return result[i] < result[j]
+	})
+
+	return result
+}
+
+// levenshteinDistance returns the number of single character edits needed to turn a into b
+func levenshteinDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	prev := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr := make([]int, len(rb)+1)
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			curr[j] = prev[j] + 1
+			if curr[j-1]+1 < curr[j] {
+				curr[j] = curr[j-1] + 1
+			}
+			if prev[j-1]+cost < curr[j] {
+				curr[j] = prev[j-1] + cost
+			}
+		}
+		prev = curr
+	}
+
+	return prev[len(rb)]
+}
@@ -5,6 +5,10 @@ package googleworkspace
 
 import (
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/api/googleapi"
 )
 
 func TestSnakeToCamel(t *testing.T) {
@@ -73,3 +77,119 @@ func TestIsEmail(t *testing.T) {
 		}
 	}
 }
+
+func TestLevenshteinDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"", "ABC", 3},
+		{"USERS_RETRIEVE", "USERS_RETRIEVE", 0},
+		{"USERS_RETREIVE", "USERS_RETRIEVE", 2},
+		{"GROUPS_ALL", "GROUPS_ALL_", 1},
+		{"kitten", "sitting", 3},
+	}
+
+	for _, tc := range tests {
+		if got := levenshteinDistance(tc.a, tc.b); got != tc.want {
+			t.Errorf("levenshteinDistance(%q, %q) = %d, want %d", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
+
+func TestClosestStrings(t *testing.T) {
+	candidates := []string{"USERS_RETRIEVE", "USERS_UPDATE", "GROUPS_RETRIEVE", "USERS_RETRIEVE"}
+
+	got := closestStrings("users_retreive", candidates, 3)
+	want := []string{"USERS_RETRIEVE"}
+	if len(got) != len(want) || got[0] != want[0] {
+		t.Errorf("closestStrings returned %v, want %v", got, want)
+	}
+
+	if got := closestStrings("ORG_UNITS_ALL", candidates, 3); len(got) != 0 {
+		t.Errorf("closestStrings returned %v, want none", got)
+	}
+}
+
+func TestCelStringLiteral(t *testing.T) {
+	cases := map[string]string{
+		"user@example.com":          `'user@example.com'`,
+		"o'brien@example.com":       `'o\'brien@example.com'`,
+		"x' || member_key_id != 'y": `'x\' || member_key_id != \'y'`,
+		`back\slash@example.com`:    `'back\\slash@example.com'`,
+	}
+
+	for input, want := range cases {
+		if got := celStringLiteral(input); got != want {
+			t.Errorf("celStringLiteral(%q) = %s, want %s", input, got, want)
+		}
+	}
+}
+
+func TestHandleNotFoundError(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})
+	d.SetId("my-id")
+
+	diags := handleNotFoundError(&googleapi.Error{Code: 404}, d, "my-resource")
+	if len(diags) != 0 {
+		t.Errorf("a 404 returned diagnostics: %v", diags)
+	}
+	if d.Id() != "" {
+		t.Errorf("a 404 didn't remove the resource from state, id is %q", d.Id())
+	}
+
+	d.SetId("my-id")
+
+	diags = handleNotFoundError(&googleapi.Error{Code: 500}, d, "my-resource")
+	if !diags.HasError() {
+		t.Errorf("a 500 didn't return an error: %v", diags)
+	}
+	if d.Id() != "my-id" {
+		t.Errorf("a 500 removed the resource from state")
+	}
+}
+
+func TestHandleReadNotFoundError(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})
+	d.SetId("my-id")
+
+	diags := handleReadNotFoundError(&googleapi.Error{Code: 404}, d, "my-resource")
+	if diags.HasError() {
+		t.Errorf("a 404 returned an error: %v", diags)
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Errorf("a 404 didn't return a single warning: %v", diags)
+	}
+	if d.Id() != "" {
+		t.Errorf("a 404 didn't remove the resource from state, id is %q", d.Id())
+	}
+
+	if got := withoutRemovedFromStateWarning(diags); len(got) != 0 {
+		t.Errorf("the warning wasn't dropped for datasources: %v", got)
+	}
+
+	d.SetId("my-id")
+
+	diags = handleReadNotFoundError(&googleapi.Error{Code: 500}, d, "my-resource")
+	if !diags.HasError() {
+		t.Errorf("a 500 didn't return an error: %v", diags)
+	}
+	if d.Id() != "my-id" {
+		t.Errorf("a 500 removed the resource from state")
+	}
+}
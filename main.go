@@ -37,10 +37,20 @@ func main() {
 	flag.BoolVar(&debugMode, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
+	shutdownTracing, err := googleworkspace.SetupTracing(context.Background(), version)
+	if err != nil {
+		log.Printf("[WARN] Unable to set up tracing: %s", err)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			log.Printf("[WARN] Unable to flush traces: %s", err)
+		}
+	}()
+
 	opts := &plugin.ServeOpts{ProviderFunc: googleworkspace.New(version)}
 
 	if debugMode {
-		err := plugin.Debug(context.Background(), "registry.terraform.io/hashicorp/googleworkspace", opts)
+		err = plugin.Debug(context.Background(), "registry.terraform.io/hashicorp/googleworkspace", opts)
 		if err != nil {
 			log.Fatal(err.Error())
 		}
@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// chromepolicyschemas replaces the Chrome policy schemas bundled with the provider, which are used to
+// validate googleworkspace_chrome_policy at plan time, with every chrome.* schema of a customer. The
+// bundled file otherwise only holds a handful of common schemas maintained by hand.
+//
+// It authenticates the same way the provider does, using the GOOGLEWORKSPACE_CREDENTIALS,
+// GOOGLEWORKSPACE_CUSTOMER_ID and GOOGLEWORKSPACE_IMPERSONATED_USER_EMAIL environment variables:
+//
+//	go run ./scripts/chromepolicyschemas
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/chromepolicy/v1"
+	"google.golang.org/api/option"
+)
+
+func main() {
+	var out, prefix string
+
+	flag.StringVar(&out, "out", "internal/provider/chrome_policy_schemas.json", "file to write the snapshot to")
+	flag.StringVar(&prefix, "prefix", "chrome.", "only include schemas whose name starts with this prefix")
+	flag.Parse()
+
+	ctx := context.Background()
+
+	customer := os.Getenv("GOOGLEWORKSPACE_CUSTOMER_ID")
+	if customer == "" {
+		log.Fatal("GOOGLEWORKSPACE_CUSTOMER_ID must be set")
+	}
+
+	credParams := google.CredentialsParams{
+		Scopes:  []string{chromepolicy.ChromeManagementPolicyReadonlyScope},
+		Subject: os.Getenv("GOOGLEWORKSPACE_IMPERSONATED_USER_EMAIL"),
+	}
+
+	var creds *google.Credentials
+	var err error
+	if contents := os.Getenv("GOOGLEWORKSPACE_CREDENTIALS"); contents != "" {
+		if _, statErr := os.Stat(contents); statErr == nil {
+			b, readErr := os.ReadFile(contents)
+			if readErr != nil {
+				log.Fatal(readErr)
+			}
+			contents = string(b)
+		}
+		creds, err = google.CredentialsFromJSONWithParams(ctx, []byte(contents), credParams)
+	} else {
+		creds, err = google.FindDefaultCredentialsWithParams(ctx, credParams)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	chromePolicyService, err := chromepolicy.NewService(ctx, option.WithCredentials(creds))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	snapshot := chromepolicy.GoogleChromePolicyV1ListPolicySchemasResponse{}
+	err = chromePolicyService.Customers.PolicySchemas.List(fmt.Sprintf("customers/%s", customer)).Pages(ctx, func(resp *chromepolicy.GoogleChromePolicyV1ListPolicySchemasResponse) error {
+		for _, schemaDef := range resp.PolicySchemas {
+			if !strings.HasPrefix(schemaDef.SchemaName, prefix) {
+				continue
+			}
+
+			// only keep what's needed for validation, and don't leak the customer id
+			snapshot.PolicySchemas = append(snapshot.PolicySchemas, &chromepolicy.GoogleChromePolicyV1PolicySchema{
+				Name:              fmt.Sprintf("customers/my_customer/policySchemas/%s", schemaDef.SchemaName),
+				SchemaName:        schemaDef.SchemaName,
+				PolicyDescription: schemaDef.PolicyDescription,
+				Definition:        schemaDef.Definition,
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	sort.Slice(snapshot.PolicySchemas, func(i, j int) bool {
+		return snapshot.PolicySchemas[i].SchemaName < snapshot.PolicySchemas[j].SchemaName
+	})
+
+	b, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(out, append(b, '\n'), 0644); err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("wrote %d policy schemas to %s", len(snapshot.PolicySchemas), out)
+}
@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// fakeworkspace serves an in-memory fake of the Google Workspace APIs, so configurations using
+// the provider's user and group resources can be tested without a Workspace tenant:
+//
+//	go run ./scripts/fakeworkspace -addr localhost:8080
+//	GOOGLEWORKSPACE_CUSTOM_ENDPOINT=http://localhost:8080 GOOGLEWORKSPACE_CUSTOMER_ID=my_customer terraform apply
+//
+// All state is lost when the server is stopped.
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-provider-googleworkspace/internal/fakeworkspace"
+)
+
+func main() {
+	var addr string
+
+	flag.StringVar(&addr, "addr", "localhost:8080", "address to listen on")
+	flag.Parse()
+
+	log.Printf("serving the fake Google Workspace APIs on http://%s", addr)
+	log.Fatal(http.ListenAndServe(addr, fakeworkspace.NewServer()))
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// usertypes regenerates the acceptable values of the googleworkspace_user sub-block types, which
+// are used to validate them at plan time. The values are read from the field descriptions of the
+// Admin SDK Directory API discovery document, which doesn't declare them as enums:
+//
+//	go run ./scripts/usertypes
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"net/http"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const discoveryURL = "https://admin.googleapis.com/$discovery/rest?version=directory_v1"
+
+// userTypeFields maps the attributes of the user resource to the discovery schema and property
+// they're sent as
+var userTypeFields = map[string][2]string{
+	"addresses.type":     {"UserAddress", "type"},
+	"emails.type":        {"UserEmail", "type"},
+	"external_ids.type":  {"UserExternalId", "type"},
+	"ims.protocol":       {"UserIm", "protocol"},
+	"ims.type":           {"UserIm", "type"},
+	"keywords.type":      {"UserKeyword", "type"},
+	"locations.type":     {"UserLocation", "type"},
+	"organizations.type": {"UserOrganization", "type"},
+	"phones.type":        {"UserPhone", "type"},
+	"relations.type":     {"UserRelation", "type"},
+	"websites.type":      {"UserWebsite", "type"},
+}
+
+var acceptableValue = regexp.MustCompile("`([a-z_]+)`")
+
+type discoveryDoc struct {
+	Schemas map[string]struct {
+		Properties map[string]struct {
+			Description string `json:"description"`
+		} `json:"properties"`
+	} `json:"schemas"`
+}
+
+func main() {
+	var out string
+
+	flag.StringVar(&out, "out", "internal/provider/user_types.go", "file to write the values to")
+	flag.Parse()
+
+	resp, err := http.Get(discoveryURL)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		log.Fatalf("unexpected status fetching %s: %s", discoveryURL, resp.Status)
+	}
+
+	var doc discoveryDoc
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		log.Fatal(err)
+	}
+
+	attributes := make([]string, 0, len(userTypeFields))
+	for attribute := range userTypeFields {
+		attributes = append(attributes, attribute)
+	}
+	sort.Strings(attributes)
+
+	var b bytes.Buffer
+	b.WriteString(`// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by go run ./scripts/usertypes; DO NOT EDIT.
+
+package googleworkspace
+
+// userTypeValues are the acceptable values of the googleworkspace_user sub-block types, keyed by
+// attribute, as documented in the Admin SDK Directory API discovery document
+var userTypeValues = map[string][]string{
+`)
+
+	for _, attribute := range attributes {
+		field := userTypeFields[attribute]
+
+		property, ok := doc.Schemas[field[0]].Properties[field[1]]
+		if !ok {
+			log.Fatalf("%s.%s isn't part of the discovery document", field[0], field[1])
+		}
+
+		// only the values listed after "Acceptable values" are types, the rest of the
+		// description may reference other fields
+		idx := strings.Index(property.Description, "Acceptable values")
+		if idx < 0 {
+			log.Fatalf("%s.%s doesn't document its acceptable values: %q", field[0], field[1], property.Description)
+		}
+
+		var values []string
+		for _, match := range acceptableValue.FindAllStringSubmatch(property.Description[idx:], -1) {
+			values = append(values, fmt.Sprintf("%q", match[1]))
+		}
+		sort.Strings(values)
+
+		fmt.Fprintf(&b, "\t%q: {%s},\n", attribute, strings.Join(values, ", "))
+	}
+
+	b.WriteString("}\n")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(out, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("wrote the values of %d attributes to %s", len(attributes), out)
+}